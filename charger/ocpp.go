@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 	"strconv"
+	"sync"
 	"time"
 
 	"github.com/evcc-io/evcc/api"
@@ -22,9 +23,13 @@ type OCPP struct {
 	id                      string
 	connector               int
 	idtag                   string
-	phases                  int
-	current                 float64
+	remoteStart             bool
 	phaseSwitchingSupported bool
+
+	mu      sync.Mutex // guards charging state against concurrent reconnects
+	phases  int
+	current float64
+	enabled bool // charging enabled via profile limit
 }
 
 func init() {
@@ -40,8 +45,11 @@ func NewOCPPFromConfig(other map[string]interface{}) (api.Charger, error) {
 		Meter         bool
 		MeterInterval time.Duration
 		InitialReset  core.ResetType
+		RemoteStart   bool
+		Upstream      string
 	}{
-		Connector: 1,
+		Connector:   1,
+		RemoteStart: true,
 	}
 
 	if err := util.DecodeOther(other, &cc); err != nil {
@@ -57,52 +65,86 @@ func NewOCPPFromConfig(other map[string]interface{}) (api.Charger, error) {
 		return nil, fmt.Errorf("unknown configuration option detected for reset: %s", cc.InitialReset)
 	}
 
-	ocpp, err := NewOCPP(cc.StationId, cc.Connector, cc.IdTag, cc.Meter, cc.MeterInterval, cc.InitialReset)
+	c, err := NewOCPP(cc.StationId, cc.Connector, cc.IdTag, cc.Meter, cc.MeterInterval, cc.InitialReset, cc.RemoteStart, cc.Upstream)
 	if err != nil {
-		return ocpp, err
+		return c, err
 	}
 
 	var (
 		meter        func() (float64, error)
 		meterCurrent func() (float64, float64, float64, error)
 		chargeRater  func() (float64, error)
+		phases1p3p   func(int) error
 	)
 
 	if cc.Meter {
-		meter = ocpp.currentPower
-		meterCurrent = ocpp.currents
-		chargeRater = ocpp.chargedEnergy
+		meter = c.currentPower
+		meterCurrent = c.currents
+		chargeRater = c.chargedEnergy
+	}
+
+	if c.cp.Version() == ocpp.V201 && c.phaseSwitchingSupported {
+		phases1p3p = c.phases1p3p
 	}
 
-	return decorateOCPP(ocpp, meter, meterCurrent, chargeRater), nil
+	return decorateOCPP(c, meter, meterCurrent, chargeRater, phases1p3p), nil
 }
 
-//go:generate go run ../cmd/tools/decorate.go -f decorateOCPP -b *OCPP -r api.Charger -t "api.Meter,CurrentPower,func() (float64, error)" -t "api.MeterCurrent,Currents,func() (float64, float64, float64, error)" -t "api.ChargeRater,ChargedEnergy,func() (float64, error)"
+//go:generate go run ../cmd/tools/decorate.go -f decorateOCPP -b *OCPP -r api.Charger -t "api.Meter,CurrentPower,func() (float64, error)" -t "api.MeterCurrent,Currents,func() (float64, float64, float64, error)" -t "api.ChargeRater,ChargedEnergy,func() (float64, error)" -t "api.PhaseSwitcher,Phases1p3p,func(int) (error)"
 
 // NewOCPP creates OCPP charger
-func NewOCPP(id string, connector int, idtag string, hasMeter bool, meterInterval time.Duration, initialReset core.ResetType) (*OCPP, error) {
+func NewOCPP(id string, connector int, idtag string, hasMeter bool, meterInterval time.Duration, initialReset core.ResetType, remoteStart bool, upstream string) (_ *OCPP, err error) {
 	cp, err := ocpp.Instance().Register(id, hasMeter)
 	if err != nil {
 		return nil, err
 	}
 
+	// restore the chargepoint replaced on reload
+	defer func() {
+		if err != nil {
+			ocpp.Instance().Unregister(cp)
+		}
+	}()
+
+	// connect upstream before chargepoint boots
+	if upstream != "" {
+		if err := cp.Proxy(upstream); err != nil {
+			return nil, err
+		}
+	}
+
 	logstr := "-charger"
 	if id != "" {
 		logstr = fmt.Sprintf("-%s", id)
 	}
 
 	c := &OCPP{
-		log:       util.NewLogger(fmt.Sprintf("ocpp%s:%d", logstr, connector)),
-		cp:        cp,
-		id:        id,
-		connector: connector,
-		idtag:     idtag,
+		log:         util.NewLogger(fmt.Sprintf("ocpp%s:%d", logstr, connector)),
+		cp:          cp,
+		id:          id,
+		connector:   connector,
+		idtag:       idtag,
+		remoteStart: remoteStart,
 	}
 
 	if err := cp.Boot(); err != nil {
 		return nil, err
 	}
 
+	cp.SetReconnectHandler(c.reconnect)
+
+	if cp.Version() == ocpp.V201 {
+		if upstream != "" {
+			return nil, errors.New("proxy mode requires OCPP 1.6")
+		}
+
+		if err := c.setup201(hasMeter, meterInterval, initialReset); err != nil {
+			return nil, err
+		}
+
+		return c, nil
+	}
+
 	var (
 		rc                           = make(chan error, 1)
 		options                      []core.ConfigurationKey
@@ -210,8 +252,51 @@ func NewOCPP(id string, connector int, idtag string, hasMeter bool, meterInterva
 	return c, nil
 }
 
+// profileEnable returns true if charging is enabled by charging profile limit instead of transactions
+func (c *OCPP) profileEnable() bool {
+	return !c.remoteStart || c.cp.Version() == ocpp.V201
+}
+
+// Close unregisters the chargepoint and stops its upstream connection
+func (c *OCPP) Close() error {
+	ocpp.Instance().Unregister(c.cp)
+	return nil
+}
+
+// reconnect restores status and charging limit after the chargepoint has been offline
+func (c *OCPP) reconnect() {
+	c.log.DEBUG.Println("reconnected")
+
+	ocpp.Instance().TriggerStatusNotificationRequest(c.cp, c.connector)
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.current == 0 {
+		return
+	}
+
+	var err error
+	if c.profileEnable() {
+		err = c.enableProfile(c.enabled)
+	} else {
+		err = c.setLimit(c.current, c.phases)
+	}
+
+	if err != nil {
+		c.log.ERROR.Printf("restoring charging limit: %v", err)
+	}
+}
+
 // Enabled implements the api.Charger interface
 func (c *OCPP) Enabled() (bool, error) {
+	if c.profileEnable() {
+		c.mu.Lock()
+		defer c.mu.Unlock()
+
+		return c.enabled, nil
+	}
+
 	current, err := c.cp.Status()
 	if current == api.StatusC {
 		return true, err
@@ -234,6 +319,38 @@ func (c *OCPP) wait(err error, rc chan error) error {
 
 // Enable implements the api.Charger interface
 func (c *OCPP) Enable(enable bool) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.profileEnable() {
+		return c.enableProfile(enable)
+	}
+
+	return c.remoteStartStop(enable)
+}
+
+// enableProfile enables or disables charging by setting a zero current limit
+func (c *OCPP) enableProfile(enable bool) error {
+	var current float64
+	if enable {
+		current = c.current
+	}
+
+	err := c.setLimit(current, c.phases)
+	if err == nil {
+		c.enabled = enable
+	}
+
+	return err
+}
+
+// remoteStartStop starts or stops the transaction using the configured id tag
+func (c *OCPP) remoteStartStop(enable bool) error {
+	// nothing to do if transaction is already in requested state
+	if enable == c.cp.HasTransaction() {
+		return nil
+	}
+
 	var err error
 	rc := make(chan error, 1)
 
@@ -248,6 +365,11 @@ func (c *OCPP) Enable(enable bool) error {
 			rc <- err
 		}, c.idtag, func(request *core.RemoteStartTransactionRequest) {
 			request.ConnectorId = &c.connector
+
+			// start with current limit applied
+			if c.current > 0 {
+				request.ChargingProfile = getChargingProfile(txProfileID, types.ChargingProfilePurposeTxProfile, c.period(c.current, c.phases))
+			}
 		})
 	} else {
 		err = ocpp.Instance().RemoteStopTransaction(c.id, func(resp *core.RemoteStopTransactionConfirmation, err error) {
@@ -281,8 +403,23 @@ func (c *OCPP) setChargingProfile(connectorid int, profile *types.ChargingProfil
 	return c.wait(err, rc)
 }
 
-// setPeriod sets a single charging schedule period with given current and phases
-func (c *OCPP) setPeriod(current float64, phases int) error {
+// Charging profile ids
+const (
+	txDefaultProfileID = 1
+	txProfileID        = 2
+)
+
+// setLimit sets the current limit depending on protocol version
+func (c *OCPP) setLimit(current float64, phases int) error {
+	if c.cp.Version() == ocpp.V201 {
+		return c.setPeriod201(current, phases)
+	}
+
+	return c.setPeriod(current, phases)
+}
+
+// period creates a single charging schedule period with given current and phases
+func (c *OCPP) period(current float64, phases int) types.ChargingSchedulePeriod {
 	period := types.NewChargingSchedulePeriod(0, current)
 
 	c.log.TRACE.Printf("current phases: %d, current current: %f", phases, current)
@@ -290,8 +427,23 @@ func (c *OCPP) setPeriod(current float64, phases int) error {
 		period.NumberPhases = &phases
 	}
 
-	// connectorID: 0 - profile will be applied to all connectors
-	err := c.setChargingProfile(0, getMaxCharginProfile(period))
+	return period
+}
+
+// setPeriod sets a single charging schedule period with given current and phases.
+// The TxDefaultProfile applies to upcoming transactions, the TxProfile overrides it for the running transaction.
+func (c *OCPP) setPeriod(current float64, phases int) error {
+	period := c.period(current, phases)
+
+	err := c.setChargingProfile(c.connector, getChargingProfile(txDefaultProfileID, types.ChargingProfilePurposeTxDefaultProfile, period))
+
+	if err == nil && c.cp.HasTransaction() {
+		profile := getChargingProfile(txProfileID, types.ChargingProfilePurposeTxProfile, period)
+		profile.TransactionId = c.cp.TransactionID()
+
+		err = c.setChargingProfile(c.connector, profile)
+	}
+
 	if err != nil {
 		c.log.TRACE.Printf("failed to set charging profile: %s", err)
 	}
@@ -299,11 +451,11 @@ func (c *OCPP) setPeriod(current float64, phases int) error {
 	return err
 }
 
-func getMaxCharginProfile(period types.ChargingSchedulePeriod) *types.ChargingProfile {
+func getChargingProfile(id int, purpose types.ChargingProfilePurposeType, period types.ChargingSchedulePeriod) *types.ChargingProfile {
 	return &types.ChargingProfile{
-		ChargingProfileId:      1,
+		ChargingProfileId:      id,
 		StackLevel:             1,
-		ChargingProfilePurpose: types.ChargingProfilePurposeChargePointMaxProfile,
+		ChargingProfilePurpose: purpose,
 		ChargingProfileKind:    types.ChargingProfileKindAbsolute,
 		ChargingSchedule: &types.ChargingSchedule{
 			StartSchedule:          types.NewDateTime(time.Now().Add(-1 * time.Hour)),
@@ -320,7 +472,16 @@ func (c *OCPP) MaxCurrent(current int64) error {
 
 // MaxCurrentMillis implements the api.ChargerEx interface
 func (c *OCPP) MaxCurrentMillis(current float64) error {
-	err := c.setPeriod(current, c.phases)
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	// limit is applied when enabled
+	if c.profileEnable() && !c.enabled {
+		c.current = current
+		return nil
+	}
+
+	err := c.setLimit(current, c.phases)
 	if err == nil {
 		c.current = current
 	}
@@ -332,19 +493,28 @@ func (c *OCPP) Status() (api.ChargeStatus, error) {
 	return c.cp.Status()
 }
 
-// TODO: Phases1p3p implements the api.PhaseSwitcher interface
-// func (c *OCPP) Phases1p3p(phases int) error {
-// 	if !c.phaseSwitchingSupported {
-// 		return fmt.Errorf("phase switching is not supported by the charger")
-// 	}
+// phases1p3p implements the api.PhaseSwitcher interface
+// TODO: support OCPP 1.6
+func (c *OCPP) phases1p3p(phases int) error {
+	if !c.phaseSwitchingSupported {
+		return fmt.Errorf("phase switching is not supported by the charger")
+	}
 
-// 	err := c.setPeriod(c.current, phases)
-// 	if err == nil {
-// 		c.phases = phases
-// 	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
 
-// 	return err
-// }
+	var current float64
+	if c.enabled {
+		current = c.current
+	}
+
+	err := c.setLimit(current, phases)
+	if err == nil {
+		c.phases = phases
+	}
+
+	return err
+}
 
 // CurrentPower implements the api.Meter interface
 func (c *OCPP) currentPower() (float64, error) {
@@ -51,9 +51,15 @@ type smartChargingProfile struct {
 }
 
 type CP struct {
-	mu  sync.Mutex
-	log *util.Logger
-	id  string
+	mu      sync.Mutex
+	log     *util.Logger
+	id      string
+	version string // negotiated protocol version
+
+	connected   bool
+	onReconnect func()
+	proxy       *proxy
+	replaced    *CP // chargepoint registered before with the same id, guarded by CS
 
 	updated     time.Time
 	initialized *sync.Cond
@@ -72,6 +78,76 @@ type CP struct {
 	currentTransaction Transaction
 }
 
+// connect updates connection state and version, calling the reconnect handler if already booted
+func (cp *CP) connect(version string) {
+	cp.mu.Lock()
+	defer cp.mu.Unlock()
+
+	cp.version = version
+	cp.connected = true
+
+	if cp.boot != nil && cp.onReconnect != nil {
+		go cp.onReconnect()
+	}
+}
+
+func (cp *CP) disconnect() {
+	cp.mu.Lock()
+	defer cp.mu.Unlock()
+
+	cp.connected = false
+}
+
+// takeover copies connection and charging state from a chargepoint registered with the same id
+func (cp *CP) takeover(prev *CP) {
+	prev.mu.Lock()
+	defer prev.mu.Unlock()
+
+	cp.mu.Lock()
+	defer cp.mu.Unlock()
+
+	cp.version = prev.version
+	cp.connected = prev.connected
+	cp.updated = prev.updated
+	cp.boot = prev.boot
+	cp.status = prev.status
+	cp.meterUpdated = prev.meterUpdated
+	cp.currentTransaction = prev.currentTransaction
+
+	for k, v := range prev.measurements {
+		cp.measurements[k] = v
+	}
+
+	cp.initialized.Broadcast()
+}
+
+// close stops the upstream connection of an unregistered chargepoint
+func (cp *CP) close() {
+	cp.mu.Lock()
+	defer cp.mu.Unlock()
+
+	if cp.proxy != nil {
+		cp.proxy.stop()
+		cp.proxy = nil
+	}
+}
+
+// SetReconnectHandler sets a handler that is called when a booted chargepoint reconnects
+func (cp *CP) SetReconnectHandler(handler func()) {
+	cp.mu.Lock()
+	defer cp.mu.Unlock()
+
+	cp.onReconnect = handler
+}
+
+// Version returns the protocol version negotiated on connect
+func (cp *CP) Version() string {
+	cp.mu.Lock()
+	defer cp.mu.Unlock()
+
+	return cp.version
+}
+
 func (cp *CP) DetectCapabilities(opts []core.ConfigurationKey) error {
 	options := make(map[string]core.ConfigurationKey)
 	for _, opt := range opts {
@@ -203,6 +279,22 @@ func (cp *CP) TransactionID() int {
 	return cp.currentTransaction.ID
 }
 
+// HasTransaction returns true if a transaction is running
+func (cp *CP) HasTransaction() bool {
+	cp.mu.Lock()
+	defer cp.mu.Unlock()
+
+	return cp.currentTransaction.ID > 0 && cp.currentTransaction.End.IsZero()
+}
+
+// TransactionRef returns the current OCPP 2.0.1 transaction id
+func (cp *CP) TransactionRef() string {
+	cp.mu.Lock()
+	defer cp.mu.Unlock()
+
+	return cp.currentTransaction.Ref
+}
+
 func (cp *CP) Status() (api.ChargeStatus, error) {
 	cp.mu.Lock()
 	defer cp.mu.Unlock()
@@ -212,12 +304,12 @@ func (cp *CP) Status() (api.ChargeStatus, error) {
 	cp.log.TRACE.Printf("last status update from CP: %s", cp.updated.Format(time.RFC3339))
 	cp.log.TRACE.Printf("current transaction ID: %d", cp.currentTransaction.ID)
 
-	if time.Since(cp.updated) > timeout {
+	if !cp.connected || time.Since(cp.updated) > timeout {
 		return res, api.ErrTimeout
 	}
 
 	if cp.status.ErrorCode != core.NoError {
-		cp.log.DEBUG.Printf("chargepoint error: %s: %s", cp.status.ErrorCode, cp.status.Info)
+		cp.log.WARN.Printf("chargepoint error: %s: %s", cp.status.ErrorCode, cp.status.Info)
 	}
 
 	switch cp.status.Status {
@@ -232,9 +324,10 @@ func (cp *CP) Status() (api.ChargeStatus, error) {
 		res = api.StatusB
 	case core.ChargePointStatusCharging: // "Charging"
 		res = api.StatusC
-	case core.ChargePointStatusReserved, // "Reserved"
-		core.ChargePointStatusFaulted: // "Faulted"
-		return api.StatusF, fmt.Errorf("chargepoint status: %s", cp.status.ErrorCode)
+	case core.ChargePointStatusReserved: // "Reserved"
+		res = api.StatusF
+	case core.ChargePointStatusFaulted: // "Faulted"
+		res = faultStatus(cp.status.ErrorCode)
 	default:
 		return api.StatusNone, fmt.Errorf("invalid chargepoint status: %s", cp.status.Status)
 	}
@@ -242,6 +335,16 @@ func (cp *CP) Status() (api.ChargeStatus, error) {
 	return res, nil
 }
 
+// faultStatus maps chargepoint errors to vehicle (E) or charger (F) faults
+func faultStatus(code core.ChargePointErrorCode) api.ChargeStatus {
+	switch code {
+	case core.EVCommunicationError, core.GroundFailure, core.OverCurrentFailure:
+		return api.StatusE
+	default:
+		return api.StatusF
+	}
+}
+
 func (cp *CP) CurrentPower() (float64, error) {
 	cp.mu.Lock()
 	defer cp.mu.Unlock()
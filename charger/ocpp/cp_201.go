@@ -0,0 +1,304 @@
+package ocpp
+
+import (
+	"math"
+	"strconv"
+	"time"
+
+	"github.com/lorenzodonini/ocpp-go/ocpp1.6/core"
+	"github.com/lorenzodonini/ocpp-go/ocpp1.6/types"
+	"github.com/lorenzodonini/ocpp-go/ocpp2.0.1/authorization"
+	"github.com/lorenzodonini/ocpp-go/ocpp2.0.1/availability"
+	"github.com/lorenzodonini/ocpp-go/ocpp2.0.1/meter"
+	"github.com/lorenzodonini/ocpp-go/ocpp2.0.1/provisioning"
+	"github.com/lorenzodonini/ocpp-go/ocpp2.0.1/transactions"
+	types2 "github.com/lorenzodonini/ocpp-go/ocpp2.0.1/types"
+)
+
+// cp201 maps OCPP 2.0.1 messages onto the chargepoint's state
+type cp201 struct {
+	*CP
+}
+
+func (cp cp201) Authorize(request *authorization.AuthorizeRequest) (*authorization.AuthorizeResponse, error) {
+	cp.log.TRACE.Printf("%T: %+v", request, request)
+
+	res := &authorization.AuthorizeResponse{
+		IdTokenInfo: *types2.NewIdTokenInfo(types2.AuthorizationStatusAccepted),
+	}
+
+	return res, nil
+}
+
+func (cp cp201) BootNotification(request *provisioning.BootNotificationRequest) (*provisioning.BootNotificationResponse, error) {
+	cp.log.TRACE.Printf("%T: %+v", request, request)
+
+	if request != nil {
+		cp.mu.Lock()
+		defer cp.mu.Unlock()
+
+		cp.boot = &core.BootNotificationRequest{
+			ChargePointModel:        request.ChargingStation.Model,
+			ChargePointVendor:       request.ChargingStation.VendorName,
+			ChargePointSerialNumber: request.ChargingStation.SerialNumber,
+			FirmwareVersion:         request.ChargingStation.FirmwareVersion,
+		}
+		cp.initialized.Broadcast()
+	}
+
+	res := &provisioning.BootNotificationResponse{
+		CurrentTime: types2.NewDateTime(time.Now()),
+		Interval:    60, // TODO
+		Status:      provisioning.RegistrationStatusAccepted,
+	}
+
+	return res, nil
+}
+
+func (cp cp201) Heartbeat(request *availability.HeartbeatRequest) (*availability.HeartbeatResponse, error) {
+	cp.log.TRACE.Printf("%T: %+v", request, request)
+
+	cp.update()
+	res := &availability.HeartbeatResponse{
+		CurrentTime: *types2.NewDateTime(time.Now()),
+	}
+
+	return res, nil
+}
+
+// connectorStatus maps 2.0.1 connector status to 1.6 chargepoint status. Must be called while holding the lock.
+func (cp cp201) connectorStatus(status availability.ConnectorStatus) (core.ChargePointStatus, core.ChargePointErrorCode) {
+	switch status {
+	case availability.ConnectorStatusAvailable:
+		return core.ChargePointStatusAvailable, core.NoError
+	case availability.ConnectorStatusOccupied:
+		// occupied does not tell if charging, keep transaction state if already known
+		if cp.status != nil {
+			switch cp.status.Status {
+			case core.ChargePointStatusPreparing, core.ChargePointStatusCharging,
+				core.ChargePointStatusSuspendedEV, core.ChargePointStatusSuspendedEVSE,
+				core.ChargePointStatusFinishing:
+				return cp.status.Status, core.NoError
+			}
+		}
+		return core.ChargePointStatusPreparing, core.NoError
+	case availability.ConnectorStatusReserved:
+		return core.ChargePointStatusReserved, core.NoError
+	case availability.ConnectorStatusUnavailable:
+		return core.ChargePointStatusUnavailable, core.NoError
+	default:
+		return core.ChargePointStatusFaulted, core.OtherError
+	}
+}
+
+func (cp cp201) StatusNotification(request *availability.StatusNotificationRequest) (*availability.StatusNotificationResponse, error) {
+	cp.log.TRACE.Printf("%T: %+v", request, request)
+
+	if request != nil {
+		cp.mu.Lock()
+
+		status, errorCode := cp.connectorStatus(request.ConnectorStatus)
+		cp.updateStatus(&core.StatusNotificationRequest{
+			ConnectorId: request.EvseID,
+			ErrorCode:   errorCode,
+			Status:      status,
+			Timestamp:   convertTimestamp(request.Timestamp),
+		})
+
+		cp.mu.Unlock()
+	}
+
+	return new(availability.StatusNotificationResponse), nil
+}
+
+// chargingStatus maps 2.0.1 transaction charging state to 1.6 chargepoint status
+func chargingStatus(state transactions.ChargingState) (core.ChargePointStatus, bool) {
+	switch state {
+	case transactions.ChargingStateCharging:
+		return core.ChargePointStatusCharging, true
+	case transactions.ChargingStateEVConnected:
+		return core.ChargePointStatusPreparing, true
+	case transactions.ChargingStateSuspendedEV:
+		return core.ChargePointStatusSuspendedEV, true
+	case transactions.ChargingStateSuspendedEVSE:
+		return core.ChargePointStatusSuspendedEVSE, true
+	case transactions.ChargingStateIdle:
+		return core.ChargePointStatusAvailable, true
+	default:
+		return "", false
+	}
+}
+
+func (cp cp201) TransactionEvent(request *transactions.TransactionEventRequest) (*transactions.TransactionEventResponse, error) {
+	cp.log.TRACE.Printf("%T: %+v", request, request)
+
+	res := new(transactions.TransactionEventResponse)
+	if request == nil {
+		return res, nil
+	}
+
+	var idTag string
+	if request.IDToken != nil {
+		idTag = request.IDToken.IdToken
+		res.IDTokenInfo = types2.NewIdTokenInfo(types2.AuthorizationStatusAccepted)
+	}
+
+	timestamp := time.Now()
+	if request.Timestamp != nil {
+		timestamp = request.Timestamp.Time
+	}
+
+	cp.mu.Lock()
+	defer cp.mu.Unlock()
+
+	switch request.EventType {
+	case transactions.TransactionEventStarted:
+		// only respect transactions in the last hour
+		if time.Since(timestamp) < transactionExpiry {
+			cp.currentTransaction = NewTransaction(cp.currentTransaction.ID+1, idTag, timestamp, 0)
+			cp.currentTransaction.Ref = request.TransactionInfo.TransactionID
+
+			if energy, ok := energyRegister(request.MeterValue); ok {
+				cp.currentTransaction.MeterValueStart = int64(energy)
+			}
+		}
+
+	case transactions.TransactionEventEnded:
+		if request.TransactionInfo.TransactionID == cp.currentTransaction.Ref {
+			meterStop := cp.currentTransaction.MeterValueStart + cp.currentTransaction.Charged
+			if energy, ok := energyRegister(request.MeterValue); ok {
+				meterStop = int64(energy)
+			}
+
+			cp.currentTransaction.Finish(idTag, timestamp, int(meterStop))
+		}
+	}
+
+	cp.setMeterValues201(request.MeterValue)
+
+	if status, ok := chargingStatus(request.TransactionInfo.ChargingState); ok {
+		errorCode := core.NoError
+		if cp.status != nil && cp.status.Status == core.ChargePointStatusFaulted {
+			errorCode = cp.status.ErrorCode
+		}
+
+		cp.updateStatus(&core.StatusNotificationRequest{
+			ConnectorId: evseID(request.Evse),
+			ErrorCode:   errorCode,
+			Status:      status,
+			Timestamp:   types.NewDateTime(timestamp),
+		})
+	}
+
+	return res, nil
+}
+
+func (cp cp201) MeterValues(request *meter.MeterValuesRequest) (*meter.MeterValuesResponse, error) {
+	cp.log.TRACE.Printf("%T: %+v", request, request)
+
+	if request != nil {
+		cp.mu.Lock()
+		cp.setMeterValues201(request.MeterValue)
+		cp.mu.Unlock()
+	}
+
+	return new(meter.MeterValuesResponse), nil
+}
+
+// setMeterValues201 converts 2.0.1 meter values and updates charged energy. Must be called while holding the lock.
+func (cp cp201) setMeterValues201(meterValues []types2.MeterValue) {
+	if len(meterValues) == 0 {
+		return
+	}
+
+	request := &core.MeterValuesRequest{
+		MeterValue: make([]types.MeterValue, 0, len(meterValues)),
+	}
+
+	for _, meterValue := range meterValues {
+		mv := types.MeterValue{
+			Timestamp:    types.NewDateTime(meterValue.Timestamp.Time),
+			SampledValue: make([]types.SampledValue, 0, len(meterValue.SampledValue)),
+		}
+
+		for _, sample := range meterValue.SampledValue {
+			mv.SampledValue = append(mv.SampledValue, types.SampledValue{
+				Value:     strconv.FormatFloat(sampleValue(sample), 'f', -1, 64),
+				Context:   types.ReadingContext(sample.Context),
+				Measurand: types.Measurand(sampleMeasurand(sample)),
+				Phase:     types.Phase(sample.Phase),
+				Location:  types.Location(sample.Location),
+			})
+		}
+
+		request.MeterValue = append(request.MeterValue, mv)
+	}
+
+	cp.setMeterValues(request)
+
+	if energy, ok := cp.measurements[string(types.MeasurandEnergyActiveImportRegister)]; ok {
+		v, _ := strconv.ParseFloat(energy.Value, 64)
+		cp.currentTransaction.Charged = int64(v) - cp.currentTransaction.MeterValueStart
+	}
+}
+
+// sampleMeasurand applies the 2.0.1 default measurand
+func sampleMeasurand(sample types2.SampledValue) types2.Measurand {
+	if sample.Measurand == "" {
+		return types2.MeasurandEnergyActiveImportRegister
+	}
+	return sample.Measurand
+}
+
+// sampleValue applies the unit multiplier
+func sampleValue(sample types2.SampledValue) float64 {
+	if sample.UnitOfMeasure != nil && sample.UnitOfMeasure.Multiplier != nil {
+		return sample.Value * math.Pow10(*sample.UnitOfMeasure.Multiplier)
+	}
+	return sample.Value
+}
+
+// energyRegister returns the last energy register reading
+func energyRegister(meterValues []types2.MeterValue) (float64, bool) {
+	var (
+		res float64
+		ok  bool
+	)
+
+	for _, meterValue := range meterValues {
+		for _, sample := range meterValue.SampledValue {
+			if sampleMeasurand(sample) == types2.MeasurandEnergyActiveImportRegister && sample.Phase == "" {
+				res, ok = sampleValue(sample), true
+			}
+		}
+	}
+
+	return res, ok
+}
+
+func evseID(evse *types2.EVSE) int {
+	if evse == nil {
+		return 0
+	}
+	return evse.ID
+}
+
+func convertTimestamp(ts *types2.DateTime) *types.DateTime {
+	if ts == nil {
+		return nil
+	}
+	return types.NewDateTime(ts.Time)
+}
+
+// Device model components and variables
+const (
+	ComponentEVSE                = "EVSE"
+	ComponentSampledDataCtrlr    = "SampledDataCtrlr"
+	ComponentSmartChargingCtrlr  = "SmartChargingCtrlr"
+	VariableAvailable            = "Available"
+	VariableEnabled              = "Enabled"
+	VariablePhases3to1           = "Phases3to1"
+	VariableTxUpdatedInterval    = "TxUpdatedInterval"
+	VariableTxUpdatedMeasurands  = "TxUpdatedMeasurands"
+	ValuePreferedTxUpdatedValues = "Current.Import,Current.Offered,Energy.Active.Import.Register,Power.Active.Import,Temperature"
+)
@@ -24,7 +24,7 @@ func (cp *CP) Authorize(request *core.AuthorizeRequest) (*core.AuthorizeConfirma
 		},
 	}
 
-	return res, nil
+	return upstreamResponse(cp, request, res), nil
 }
 
 func (cp *CP) BootNotification(request *core.BootNotificationRequest) (*core.BootNotificationConfirmation, error) {
@@ -32,10 +32,9 @@ func (cp *CP) BootNotification(request *core.BootNotificationRequest) (*core.Boo
 
 	if request != nil {
 		cp.mu.Lock()
-		defer cp.mu.Unlock()
-
 		cp.boot = request
 		cp.initialized.Broadcast()
+		cp.mu.Unlock()
 	}
 
 	res := &core.BootNotificationConfirmation{
@@ -44,7 +43,7 @@ func (cp *CP) BootNotification(request *core.BootNotificationRequest) (*core.Boo
 		Status:      core.RegistrationStatusAccepted,
 	}
 
-	return res, nil
+	return upstreamResponse(cp, request, res), nil
 }
 
 // timestampValid returns false if status timestamps are outdated
@@ -68,19 +67,23 @@ func (cp *CP) StatusNotification(request *core.StatusNotificationRequest) (*core
 
 	if request != nil {
 		cp.mu.Lock()
-		defer cp.mu.Unlock()
-
-		if cp.status == nil {
-			cp.status = request
-			cp.initialized.Broadcast()
-		} else if request.Timestamp == nil || cp.timestampValid(request.Timestamp.Time) {
-			cp.status = request
-		} else {
-			cp.log.TRACE.Printf("ignoring status: %s < %s", request.Timestamp.Time, cp.status.Timestamp)
-		}
+		cp.updateStatus(request)
+		cp.mu.Unlock()
 	}
 
-	return new(core.StatusNotificationConfirmation), nil
+	return upstreamResponse(cp, request, new(core.StatusNotificationConfirmation)), nil
+}
+
+// updateStatus applies the status unless it is outdated. Must be called while holding the lock.
+func (cp *CP) updateStatus(request *core.StatusNotificationRequest) {
+	if cp.status == nil {
+		cp.status = request
+		cp.initialized.Broadcast()
+	} else if request.Timestamp == nil || cp.timestampValid(request.Timestamp.Time) {
+		cp.status = request
+	} else {
+		cp.log.TRACE.Printf("ignoring status: %s < %s", request.Timestamp.Time, cp.status.Timestamp)
+	}
 }
 
 func (cp *CP) DataTransfer(request *core.DataTransferRequest) (*core.DataTransferConfirmation, error) {
@@ -90,7 +93,7 @@ func (cp *CP) DataTransfer(request *core.DataTransferRequest) (*core.DataTransfe
 		Status: core.DataTransferStatusRejected,
 	}
 
-	return res, nil
+	return upstreamResponse(cp, request, res), nil
 }
 
 func (cp *CP) update() {
@@ -111,7 +114,7 @@ func (cp *CP) Heartbeat(request *core.HeartbeatRequest) (*core.HeartbeatConfirma
 		Instance().TriggerMeterValueRequest(cp)
 	}
 
-	return res, nil
+	return upstreamResponse(cp, request, res), nil
 }
 
 func (cp *CP) MeterValues(request *core.MeterValuesRequest) (*core.MeterValuesConfirmation, error) {
@@ -132,7 +135,7 @@ func (cp *CP) MeterValues(request *core.MeterValuesRequest) (*core.MeterValuesCo
 		cp.mu.Unlock()
 	}
 
-	return new(core.MeterValuesConfirmation), nil
+	return upstreamResponse(cp, request, new(core.MeterValuesConfirmation)), nil
 }
 
 func getSampleKey(s types.SampledValue) string {
@@ -163,11 +166,23 @@ func (cp *CP) StartTransaction(request *core.StartTransactionRequest) (*core.Sta
 		},
 	}
 
+	cp.mu.Lock()
+	id := cp.currentTransaction.ID + 1
+	cp.mu.Unlock()
+
+	// transaction id is assigned by upstream central system if proxying.
+	// While upstream is offline, the local id is mapped to the upstream id once delivered.
+	proxied, _ := cp.forwardTransaction(request, id).(*core.StartTransactionConfirmation)
+
 	// create new transaction
 	if request != nil {
 		if time.Since(request.Timestamp.Time) < transactionExpiry { // only respect transactions in the last hour
 			cp.mu.Lock()
-			cp.currentTransaction = NewTransaction(cp.currentTransaction.ID+1, request.IdTag, request.Timestamp.Time, request.MeterStart)
+			if proxied != nil {
+				id = proxied.TransactionId
+			}
+
+			cp.currentTransaction = NewTransaction(id, request.IdTag, request.Timestamp.Time, request.MeterStart)
 
 			cp.mu.Unlock()
 
@@ -199,6 +214,10 @@ func (cp *CP) StartTransaction(request *core.StartTransactionRequest) (*core.Sta
 		}
 	}
 
+	if proxied != nil {
+		return proxied, nil
+	}
+
 	return res, nil
 }
 
@@ -230,17 +249,17 @@ func (cp *CP) StopTransaction(request *core.StopTransactionRequest) (*core.StopT
 		Instance().TriggerMeterValueRequest(cp)
 	}
 
-	return res, nil
+	return upstreamResponse(cp, request, res), nil
 }
 
 func (cp *CP) DiagnosticStatusNotification(request *firmware.DiagnosticsStatusNotificationRequest) (*firmware.DiagnosticsStatusNotificationConfirmation, error) {
 	cp.log.TRACE.Printf("%T: %+v", request, request)
 
-	return &firmware.DiagnosticsStatusNotificationConfirmation{}, nil
+	return upstreamResponse(cp, request, &firmware.DiagnosticsStatusNotificationConfirmation{}), nil
 }
 
 func (cp *CP) FirmwareStatusNotification(request *firmware.FirmwareStatusNotificationRequest) (*firmware.FirmwareStatusNotificationConfirmation, error) {
 	cp.log.TRACE.Printf("%T: %+v", request, request)
 
-	return &firmware.FirmwareStatusNotificationConfirmation{}, nil
+	return upstreamResponse(cp, request, &firmware.FirmwareStatusNotificationConfirmation{}), nil
 }
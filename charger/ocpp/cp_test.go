@@ -0,0 +1,55 @@
+package ocpp
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/evcc-io/evcc/api"
+	"github.com/evcc-io/evcc/util"
+	"github.com/lorenzodonini/ocpp-go/ocpp1.6/core"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStatus(t *testing.T) {
+	cp := &CP{
+		log:       util.NewLogger("foo"),
+		connected: true,
+		updated:   time.Now(),
+	}
+	cp.initialized = sync.NewCond(&cp.mu)
+
+	tc := []struct {
+		status core.ChargePointStatus
+		code   core.ChargePointErrorCode
+		res    api.ChargeStatus
+	}{
+		{core.ChargePointStatusAvailable, core.NoError, api.StatusA},
+		{core.ChargePointStatusSuspendedEVSE, core.NoError, api.StatusB},
+		{core.ChargePointStatusCharging, core.HighTemperature, api.StatusC},
+		{core.ChargePointStatusFaulted, core.GroundFailure, api.StatusE},
+		{core.ChargePointStatusFaulted, core.EVCommunicationError, api.StatusE},
+		{core.ChargePointStatusFaulted, core.InternalError, api.StatusF},
+		{core.ChargePointStatusFaulted, core.PowerMeterFailure, api.StatusF},
+	}
+
+	for _, tc := range tc {
+		t.Logf("%+v", tc)
+
+		_, err := cp.StatusNotification(&core.StatusNotificationRequest{
+			ConnectorId: 1,
+			Status:      tc.status,
+			ErrorCode:   tc.code,
+		})
+		require.NoError(t, err)
+
+		status, err := cp.Status()
+		require.NoError(t, err)
+		require.Equal(t, tc.res, status)
+	}
+
+	cp.disconnect()
+
+	_, err := cp.Status()
+	require.Equal(t, api.ErrTimeout, err)
+}
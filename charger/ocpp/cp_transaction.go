@@ -4,6 +4,7 @@ import "time"
 
 type Transaction struct {
 	ID              int       `yaml:"id"`
+	Ref             string    `yaml:"ref,omitempty"` // OCPP 2.0.1 transaction id
 	IDTag           string    `yaml:"idTag"`
 	Start           time.Time `yaml:"startTimestamp"`
 	End             time.Time `yaml:"endTimestamp,omitempty"`
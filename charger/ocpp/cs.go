@@ -8,15 +8,26 @@ import (
 	"github.com/evcc-io/evcc/util"
 	ocpp16 "github.com/lorenzodonini/ocpp-go/ocpp1.6"
 	"github.com/lorenzodonini/ocpp-go/ocpp1.6/types"
+	ocpp2 "github.com/lorenzodonini/ocpp-go/ocpp2.0.1"
+	types2 "github.com/lorenzodonini/ocpp-go/ocpp2.0.1/types"
+)
+
+// Supported protocol versions as negotiated by websocket subprotocol
+const (
+	V16  = types.V16Subprotocol
+	V201 = types2.V201Subprotocol
 )
 
 type CS struct {
 	mu  sync.Mutex
 	log *util.Logger
 	ocpp16.CentralSystem
-	cps map[string]*CP
+	CSMS ocpp2.CSMS
+	cps  map[string]*CP
 }
 
+// Register registers a chargepoint by station id. A chargepoint already registered with
+// the same station id, e.g. on configuration reload, is replaced and its connection state is taken over.
 func (cs *CS) Register(id string, meterSupported bool) (*CP, error) {
 	cp := &CP{
 		id:             id,
@@ -30,8 +41,13 @@ func (cs *CS) Register(id string, meterSupported bool) (*CP, error) {
 	cs.mu.Lock()
 	defer cs.mu.Unlock()
 
-	if _, ok := cs.cps[id]; ok && id == "" {
-		return nil, errors.New("cannot have >1 chargepoint with empty station id")
+	if prev, ok := cs.cps[id]; ok {
+		if id == "" {
+			return nil, errors.New("cannot have >1 chargepoint with empty station id")
+		}
+
+		cp.takeover(prev)
+		cp.replaced = prev
 	}
 
 	cs.cps[id] = cp
@@ -39,6 +55,32 @@ func (cs *CS) Register(id string, meterSupported bool) (*CP, error) {
 	return cp, nil
 }
 
+// Unregister removes the chargepoint and stops its upstream connection.
+// The chargepoint it replaced is registered again, e.g. if a configuration reload failed.
+func (cs *CS) Unregister(cp *CP) {
+	cs.mu.Lock()
+	defer cs.mu.Unlock()
+
+	if cs.cps[cp.id] == cp {
+		if prev := cp.replaced; prev != nil {
+			prev.takeover(cp)
+			cs.cps[cp.id] = prev
+		} else {
+			delete(cs.cps, cp.id)
+		}
+	}
+
+	// replaced chargepoint can't be registered again
+	for _, c := range cs.cps {
+		if c.replaced == cp {
+			c.replaced = nil
+		}
+	}
+
+	cp.replaced = nil
+	cp.close()
+}
+
 // errorHandler logs error channel
 func (cs *CS) errorHandler(errC <-chan error) {
 	for err := range errC {
@@ -54,39 +96,54 @@ func (cs *CS) chargepointByID(id string) (*CP, error) {
 	return cp, nil
 }
 
-func (cs *CS) NewChargePoint(chargePoint ocpp16.ChargePointConnection) {
+// connect registers a connecting chargepoint together with its negotiated protocol version
+func (cs *CS) connect(id, version string) {
 	cs.mu.Lock()
 	defer cs.mu.Unlock()
 
-	if _, err := cs.chargepointByID(chargePoint.ID()); err != nil {
-		if auto, ok := cs.cps[""]; ok {
-			cs.log.INFO.Printf("unknown chargepoint connected, registering: %s", chargePoint.ID())
-
-			// update id
-			auto.id = chargePoint.ID()
-			cs.cps[chargePoint.ID()] = auto
-			delete(cs.cps, "")
-
+	cp, err := cs.chargepointByID(id)
+	if err != nil {
+		auto, ok := cs.cps[""]
+		if !ok {
+			cs.log.WARN.Printf("unknown chargepoint connected, ignored: %s", id)
 			return
 		}
 
-		cs.log.WARN.Printf("unknown chargepoint connected, ignored: %s", chargePoint.ID())
+		cs.log.INFO.Printf("unknown chargepoint connected, registering: %s", id)
+
+		// update id
+		auto.id = id
+		cs.cps[id] = auto
+		delete(cs.cps, "")
+
+		cp = auto
 	} else {
-		cs.log.DEBUG.Printf("chargepoint connected: %s", chargePoint.ID())
+		cs.log.DEBUG.Printf("chargepoint connected: %s (%s)", id, version)
 	}
+
+	cp.connect(version)
 }
 
-func (cs *CS) ChargePointDisconnected(chargePoint ocpp16.ChargePointConnection) {
+func (cs *CS) disconnect(id string) {
 	cs.mu.Lock()
 	defer cs.mu.Unlock()
 
-	if _, err := cs.chargepointByID(chargePoint.ID()); err != nil {
+	if cp, err := cs.chargepointByID(id); err != nil {
 		cs.log.ERROR.Printf("chargepoint disconnected: %v", err)
 	} else {
-		cs.log.DEBUG.Printf("chargepoint disconnected: %s", chargePoint.ID())
+		cs.log.DEBUG.Printf("chargepoint disconnected: %s", id)
+		cp.disconnect()
 	}
 }
 
+func (cs *CS) NewChargePoint(chargePoint ocpp16.ChargePointConnection) {
+	cs.connect(chargePoint.ID(), V16)
+}
+
+func (cs *CS) ChargePointDisconnected(chargePoint ocpp16.ChargePointConnection) {
+	cs.disconnect(chargePoint.ID())
+}
+
 func (cs *CS) Debug(args ...interface{}) {
 	cs.log.TRACE.Println(args...)
 }
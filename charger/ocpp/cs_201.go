@@ -0,0 +1,133 @@
+package ocpp
+
+import (
+	ocpp2 "github.com/lorenzodonini/ocpp-go/ocpp2.0.1"
+	"github.com/lorenzodonini/ocpp-go/ocpp2.0.1/authorization"
+	"github.com/lorenzodonini/ocpp-go/ocpp2.0.1/availability"
+	"github.com/lorenzodonini/ocpp-go/ocpp2.0.1/data"
+	"github.com/lorenzodonini/ocpp-go/ocpp2.0.1/diagnostics"
+	"github.com/lorenzodonini/ocpp-go/ocpp2.0.1/firmware"
+	"github.com/lorenzodonini/ocpp-go/ocpp2.0.1/meter"
+	"github.com/lorenzodonini/ocpp-go/ocpp2.0.1/provisioning"
+	"github.com/lorenzodonini/ocpp-go/ocpp2.0.1/transactions"
+)
+
+// csms handles OCPP 2.0.1 charging stations. Handler names overlap with 1.6, hence the separate type.
+type csms struct {
+	*CS
+}
+
+func (cs *csms) chargepointByID(id string) (cp201, error) {
+	cp, err := cs.CS.chargepointByID(id)
+	return cp201{cp}, err
+}
+
+func (cs *csms) NewChargingStation(chargingStation ocpp2.ChargingStationConnection) {
+	cs.connect(chargingStation.ID(), V201)
+}
+
+func (cs *csms) ChargingStationDisconnected(chargingStation ocpp2.ChargingStationConnection) {
+	cs.disconnect(chargingStation.ID())
+}
+
+func (cs *csms) OnAuthorize(chargingStationID string, request *authorization.AuthorizeRequest) (*authorization.AuthorizeResponse, error) {
+	cp, err := cs.chargepointByID(chargingStationID)
+	if err != nil {
+		return nil, err
+	}
+
+	return cp.Authorize(request)
+}
+
+func (cs *csms) OnBootNotification(chargingStationID string, request *provisioning.BootNotificationRequest) (*provisioning.BootNotificationResponse, error) {
+	cp, err := cs.chargepointByID(chargingStationID)
+	if err != nil {
+		return nil, err
+	}
+
+	return cp.BootNotification(request)
+}
+
+func (cs *csms) OnNotifyReport(chargingStationID string, request *provisioning.NotifyReportRequest) (*provisioning.NotifyReportResponse, error) {
+	cs.log.TRACE.Printf("%T: %+v", request, request)
+
+	return new(provisioning.NotifyReportResponse), nil
+}
+
+func (cs *csms) OnHeartbeat(chargingStationID string, request *availability.HeartbeatRequest) (*availability.HeartbeatResponse, error) {
+	cp, err := cs.chargepointByID(chargingStationID)
+	if err != nil {
+		return nil, err
+	}
+
+	return cp.Heartbeat(request)
+}
+
+func (cs *csms) OnStatusNotification(chargingStationID string, request *availability.StatusNotificationRequest) (*availability.StatusNotificationResponse, error) {
+	cp, err := cs.chargepointByID(chargingStationID)
+	if err != nil {
+		return nil, err
+	}
+
+	return cp.StatusNotification(request)
+}
+
+func (cs *csms) OnTransactionEvent(chargingStationID string, request *transactions.TransactionEventRequest) (*transactions.TransactionEventResponse, error) {
+	cp, err := cs.chargepointByID(chargingStationID)
+	if err != nil {
+		return nil, err
+	}
+
+	return cp.TransactionEvent(request)
+}
+
+func (cs *csms) OnMeterValues(chargingStationID string, request *meter.MeterValuesRequest) (*meter.MeterValuesResponse, error) {
+	cp, err := cs.chargepointByID(chargingStationID)
+	if err != nil {
+		return nil, err
+	}
+
+	return cp.MeterValues(request)
+}
+
+func (cs *csms) OnDataTransfer(chargingStationID string, request *data.DataTransferRequest) (*data.DataTransferResponse, error) {
+	cs.log.TRACE.Printf("%T: %+v", request, request)
+
+	return &data.DataTransferResponse{Status: data.DataTransferStatusRejected}, nil
+}
+
+func (cs *csms) OnFirmwareStatusNotification(chargingStationID string, request *firmware.FirmwareStatusNotificationRequest) (*firmware.FirmwareStatusNotificationResponse, error) {
+	cs.log.TRACE.Printf("%T: %+v", request, request)
+
+	return new(firmware.FirmwareStatusNotificationResponse), nil
+}
+
+func (cs *csms) OnPublishFirmwareStatusNotification(chargingStationID string, request *firmware.PublishFirmwareStatusNotificationRequest) (*firmware.PublishFirmwareStatusNotificationResponse, error) {
+	cs.log.TRACE.Printf("%T: %+v", request, request)
+
+	return new(firmware.PublishFirmwareStatusNotificationResponse), nil
+}
+
+func (cs *csms) OnLogStatusNotification(chargingStationID string, request *diagnostics.LogStatusNotificationRequest) (*diagnostics.LogStatusNotificationResponse, error) {
+	cs.log.TRACE.Printf("%T: %+v", request, request)
+
+	return new(diagnostics.LogStatusNotificationResponse), nil
+}
+
+func (cs *csms) OnNotifyCustomerInformation(chargingStationID string, request *diagnostics.NotifyCustomerInformationRequest) (*diagnostics.NotifyCustomerInformationResponse, error) {
+	cs.log.TRACE.Printf("%T: %+v", request, request)
+
+	return new(diagnostics.NotifyCustomerInformationResponse), nil
+}
+
+func (cs *csms) OnNotifyEvent(chargingStationID string, request *diagnostics.NotifyEventRequest) (*diagnostics.NotifyEventResponse, error) {
+	cs.log.TRACE.Printf("%T: %+v", request, request)
+
+	return new(diagnostics.NotifyEventResponse), nil
+}
+
+func (cs *csms) OnNotifyMonitoringReport(chargingStationID string, request *diagnostics.NotifyMonitoringReportRequest) (*diagnostics.NotifyMonitoringReportResponse, error) {
+	cs.log.TRACE.Printf("%T: %+v", request, request)
+
+	return new(diagnostics.NotifyMonitoringReportResponse), nil
+}
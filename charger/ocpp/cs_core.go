@@ -4,6 +4,9 @@ import (
 	core "github.com/lorenzodonini/ocpp-go/ocpp1.6/core"
 	"github.com/lorenzodonini/ocpp-go/ocpp1.6/firmware"
 	"github.com/lorenzodonini/ocpp-go/ocpp1.6/remotetrigger"
+	"github.com/lorenzodonini/ocpp-go/ocpp2.0.1/provisioning"
+	"github.com/lorenzodonini/ocpp-go/ocpp2.0.1/remotecontrol"
+	types2 "github.com/lorenzodonini/ocpp-go/ocpp2.0.1/types"
 )
 
 func (cs *CS) OnAuthorize(chargePointId string, request *core.AuthorizeRequest) (*core.AuthorizeConfirmation, error) {
@@ -43,6 +46,18 @@ func (cs *CS) OnHeartbeat(chargePointId string, request *core.HeartbeatRequest)
 }
 
 func (cs *CS) TriggerMeterValueRequest(cp *CP) {
+	if cp.Version() == V201 {
+		callback := func(request *remotecontrol.TriggerMessageResponse, err error) {
+			cs.log.TRACE.Printf("TriggerMessageRequest %T: %+v", request, request)
+		}
+
+		if err := cs.CSMS.TriggerMessage(cp.id, callback, remotecontrol.MessageTriggerMeterValues); err != nil {
+			cs.log.DEBUG.Printf("failed sending TriggerMessageRequest: %s", err)
+		}
+
+		return
+	}
+
 	callback := func(request *remotetrigger.TriggerMessageConfirmation, err error) {
 		cs.log.TRACE.Printf("TriggerMessageRequest %T: %+v", request, request)
 	}
@@ -52,6 +67,34 @@ func (cs *CS) TriggerMeterValueRequest(cp *CP) {
 	}
 }
 
+// TriggerStatusNotificationRequest requests the connector's status
+func (cs *CS) TriggerStatusNotificationRequest(cp *CP, connector int) {
+	if cp.Version() == V201 {
+		callback := func(request *remotecontrol.TriggerMessageResponse, err error) {
+			cs.log.TRACE.Printf("TriggerMessageRequest %T: %+v", request, request)
+		}
+
+		evseConnector := 1
+		if err := cs.CSMS.TriggerMessage(cp.id, callback, remotecontrol.MessageTriggerStatusNotification, func(request *remotecontrol.TriggerMessageRequest) {
+			request.Evse = &types2.EVSE{ID: connector, ConnectorID: &evseConnector}
+		}); err != nil {
+			cs.log.DEBUG.Printf("failed sending TriggerMessageRequest: %s", err)
+		}
+
+		return
+	}
+
+	callback := func(request *remotetrigger.TriggerMessageConfirmation, err error) {
+		cs.log.TRACE.Printf("TriggerMessageRequest %T: %+v", request, request)
+	}
+
+	if err := cs.TriggerMessage(cp.id, callback, core.StatusNotificationFeatureName, func(request *remotetrigger.TriggerMessageRequest) {
+		request.ConnectorId = &connector
+	}); err != nil {
+		cs.log.DEBUG.Printf("failed sending TriggerMessageRequest: %s", err)
+	}
+}
+
 func (cs *CS) OnMeterValues(chargePointId string, request *core.MeterValuesRequest) (*core.MeterValuesConfirmation, error) {
 	cp, err := cs.chargepointByID(chargePointId)
 	if err != nil {
@@ -120,6 +163,23 @@ func (cs *CS) OnFirmwareStatusNotification(chargePointId string, request *firmwa
 }
 
 func (cs *CS) TriggerResetRequest(cp *CP, resetType core.ResetType) {
+	if cp.Version() == V201 {
+		callback := func(request *provisioning.ResetResponse, err error) {
+			cs.log.TRACE.Printf("TriggerResetRequest %T: %+v", request, request)
+		}
+
+		typ := provisioning.ResetTypeOnIdle
+		if resetType == core.ResetTypeHard {
+			typ = provisioning.ResetTypeImmediate
+		}
+
+		if err := cs.CSMS.Reset(cp.id, callback, typ); err != nil {
+			cs.log.DEBUG.Printf("failed sending TriggerResetRequest: %s", err)
+		}
+
+		return
+	}
+
 	callback := func(request *core.ResetConfirmation, err error) {
 		cs.log.TRACE.Printf("TriggerResetRequest %T: %+v", request, request)
 	}
@@ -0,0 +1,145 @@
+package ocpp
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/evcc-io/evcc/api"
+	ocpp16 "github.com/lorenzodonini/ocpp-go/ocpp1.6"
+	"github.com/lorenzodonini/ocpp-go/ocpp1.6/core"
+	ocpp2 "github.com/lorenzodonini/ocpp-go/ocpp2.0.1"
+	"github.com/lorenzodonini/ocpp-go/ocpp2.0.1/availability"
+	"github.com/lorenzodonini/ocpp-go/ocpp2.0.1/provisioning"
+	"github.com/lorenzodonini/ocpp-go/ocpp2.0.1/transactions"
+	"github.com/lorenzodonini/ocpp-go/ocpp2.0.1/types"
+	"github.com/stretchr/testify/require"
+)
+
+var csUrl = fmt.Sprintf("ws://localhost:%d", Port)
+
+func TestConnect16(t *testing.T) {
+	cp, err := Instance().Register("test-16", false)
+	require.NoError(t, err)
+
+	station := ocpp16.NewChargePoint("test-16", nil, nil)
+	require.NoError(t, station.Start(csUrl))
+	defer station.Stop()
+
+	_, err = station.BootNotification("model", "vendor")
+	require.NoError(t, err)
+
+	_, err = station.StatusNotification(1, core.NoError, core.ChargePointStatusPreparing)
+	require.NoError(t, err)
+
+	require.NoError(t, cp.Boot())
+	require.Equal(t, V16, cp.Version())
+
+	status, err := cp.Status()
+	require.NoError(t, err)
+	require.Equal(t, api.StatusB, status)
+}
+
+func TestConnect201(t *testing.T) {
+	cp, err := Instance().Register("test-201", true)
+	require.NoError(t, err)
+
+	station := ocpp2.NewChargingStation("test-201", nil, nil)
+	require.NoError(t, station.Start(csUrl))
+	defer station.Stop()
+
+	_, err = station.BootNotification(provisioning.BootReasonPowerUp, "model", "vendor")
+	require.NoError(t, err)
+
+	_, err = station.StatusNotification(types.NewDateTime(time.Now()), availability.ConnectorStatusOccupied, 1, 1)
+	require.NoError(t, err)
+
+	require.NoError(t, cp.Boot())
+	require.Equal(t, V201, cp.Version())
+
+	status, err := cp.Status()
+	require.NoError(t, err)
+	require.Equal(t, api.StatusB, status)
+
+	meterValues := func(energy, power float64) func(*transactions.TransactionEventRequest) {
+		return func(request *transactions.TransactionEventRequest) {
+			request.MeterValue = []types.MeterValue{{
+				Timestamp: *types.NewDateTime(time.Now()),
+				SampledValue: []types.SampledValue{
+					{Value: energy},
+					{Value: power, Measurand: types.MeasurandPowerActiveImport},
+				},
+			}}
+		}
+	}
+
+	// start charging
+	_, err = station.TransactionEvent(transactions.TransactionEventStarted, types.NewDateTime(time.Now()),
+		transactions.TriggerReasonChargingStateChanged, 0,
+		transactions.Transaction{TransactionID: "tx", ChargingState: transactions.ChargingStateCharging},
+		meterValues(1000, 3600),
+	)
+	require.NoError(t, err)
+
+	status, err = cp.Status()
+	require.NoError(t, err)
+	require.Equal(t, api.StatusC, status)
+	require.Equal(t, "tx", cp.TransactionRef())
+
+	power, err := cp.CurrentPower()
+	require.NoError(t, err)
+	require.Equal(t, 3600.0, power)
+
+	// stop charging
+	_, err = station.TransactionEvent(transactions.TransactionEventEnded, types.NewDateTime(time.Now()),
+		transactions.TriggerReasonEVDeparted, 1,
+		transactions.Transaction{TransactionID: "tx", ChargingState: transactions.ChargingStateIdle},
+		meterValues(3000, 1),
+	)
+	require.NoError(t, err)
+
+	status, err = cp.Status()
+	require.NoError(t, err)
+	require.Equal(t, api.StatusA, status)
+
+	energy, err := cp.ChargedEnergy()
+	require.NoError(t, err)
+	require.Equal(t, 2.0, energy)
+}
+
+func TestRegisterReplaced(t *testing.T) {
+	cs := Instance()
+
+	prev, err := cs.Register("test-reload", false)
+	require.NoError(t, err)
+
+	// upstream is never connected
+	require.NoError(t, prev.Proxy("ws://localhost:1"))
+
+	prev.mu.Lock()
+	prev.connected = true
+	prev.status = &core.StatusNotificationRequest{Status: core.ChargePointStatusCharging}
+	prev.mu.Unlock()
+
+	// replacing chargepoint takes over the state
+	cp, err := cs.Register("test-reload", false)
+	require.NoError(t, err)
+	require.True(t, cp.connected)
+	require.Equal(t, prev.status, cp.status)
+
+	// failed reload restores the replaced chargepoint
+	cs.Unregister(cp)
+	require.Equal(t, prev, cs.cps["test-reload"])
+
+	// successful reload closes the replaced chargepoint
+	cp, err = cs.Register("test-reload", false)
+	require.NoError(t, err)
+
+	cs.Unregister(prev)
+	require.Equal(t, cp, cs.cps["test-reload"])
+	require.Nil(t, cp.replaced)
+	require.Nil(t, prev.proxy)
+
+	cs.Unregister(cp)
+	require.NotContains(t, cs.cps, "test-reload")
+}
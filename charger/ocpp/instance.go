@@ -1,23 +1,33 @@
 package ocpp
 
 import (
+	"fmt"
+	"net/http"
 	"time"
 
 	"github.com/evcc-io/evcc/util"
 	ocpp16 "github.com/lorenzodonini/ocpp-go/ocpp1.6"
+	ocpp2 "github.com/lorenzodonini/ocpp-go/ocpp2.0.1"
 	"github.com/lorenzodonini/ocpp-go/ocppj"
+	"github.com/lorenzodonini/ocpp-go/ws"
 )
 
+// Port is the OCPP central system port
+const Port = 8887
+
 var instance *CS
 
 func Instance() *CS {
 	if instance == nil {
-		cs := ocpp16.NewCentralSystem(nil, nil)
+		ws16, ws201 := ws.NewServer(), ws.NewServer()
+		cs := ocpp16.NewCentralSystem(nil, ws16)
+		cs201 := ocpp2.NewCSMS(nil, ws201)
 
 		instance = &CS{
 			log:           util.NewLogger("ocpp"),
 			cps:           make(map[string]*CP),
 			CentralSystem: cs,
+			CSMS:          cs201,
 		}
 
 		ocppj.SetLogger(instance)
@@ -27,11 +37,53 @@ func Instance() *CS {
 		cs.SetChargePointDisconnectedHandler(instance.ChargePointDisconnected)
 		cs.SetFirmwareManagementHandler(instance)
 
-		go Instance().errorHandler(cs.Errors())
-		go cs.Start(8887, "/{ws}")
+		handler := &csms{instance}
+		cs201.SetAuthorizationHandler(handler)
+		cs201.SetAvailabilityHandler(handler)
+		cs201.SetDataHandler(handler)
+		cs201.SetDiagnosticsHandler(handler)
+		cs201.SetFirmwareHandler(handler)
+		cs201.SetMeterHandler(handler)
+		cs201.SetProvisioningHandler(handler)
+		cs201.SetTransactionsHandler(handler)
+		cs201.SetNewChargingStationHandler(handler.NewChargingStation)
+		cs201.SetChargingStationDisconnectedHandler(handler.ChargingStationDisconnected)
+
+		go instance.errorHandler(cs.Errors())
+		go instance.errorHandler(cs201.Errors())
+
+		if err := instance.start(cs, cs201); err != nil {
+			instance.log.ERROR.Println(err)
+		}
 
 		time.Sleep(time.Second)
 	}
 
 	return instance
 }
+
+// start runs both protocol versions on system-assigned ports behind a version mux on the public port
+func (cs *CS) start(cs16 ocpp16.CentralSystem, cs201 ocpp2.CSMS) error {
+	port16, err := listen(func(port int) { cs16.Start(port, "/{ws}") })
+	if err != nil {
+		return fmt.Errorf("ocpp 1.6: %w", err)
+	}
+
+	port201, err := listen(func(port int) { cs201.Start(port, "/{ws}") })
+	if err != nil {
+		return fmt.Errorf("ocpp 2.0.1: %w", err)
+	}
+
+	mux, err := newVersionMux(map[string]int{V16: port16, V201: port201})
+	if err != nil {
+		return err
+	}
+
+	go func() {
+		if err := http.ListenAndServe(fmt.Sprintf(":%d", Port), mux); err != nil {
+			cs.log.ERROR.Println(err)
+		}
+	}()
+
+	return nil
+}
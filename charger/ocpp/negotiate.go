@@ -0,0 +1,73 @@
+package ocpp
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/httputil"
+	"net/url"
+	"time"
+
+	"github.com/gorilla/websocket"
+)
+
+// listen runs start on a free local port and returns the port once the server accepts connections.
+// The server opens its own listener, readiness is detected by connecting instead of reading server state.
+func listen(start func(port int)) (int, error) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return 0, err
+	}
+
+	port := ln.Addr().(*net.TCPAddr).Port
+	if err := ln.Close(); err != nil {
+		return 0, err
+	}
+
+	go start(port)
+
+	for timeout := time.After(5 * time.Second); ; {
+		if conn, err := net.DialTimeout("tcp", fmt.Sprintf("127.0.0.1:%d", port), time.Second); err == nil {
+			return port, conn.Close()
+		}
+
+		select {
+		case <-timeout:
+			return 0, errors.New("timeout waiting for listener")
+		case <-time.After(10 * time.Millisecond):
+		}
+	}
+}
+
+// versionMux forwards websocket connections to the backend matching the requested OCPP subprotocol
+type versionMux map[string]http.Handler
+
+// newVersionMux creates a version mux for the given subprotocol to local port mapping
+func newVersionMux(ports map[string]int) (versionMux, error) {
+	mux := make(versionMux)
+
+	for version, port := range ports {
+		u, err := url.Parse(fmt.Sprintf("http://localhost:%d", port))
+		if err != nil {
+			return nil, err
+		}
+
+		mux[version] = httputil.NewSingleHostReverseProxy(u)
+	}
+
+	return mux, nil
+}
+
+func (mux versionMux) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	// first supported subprotocol wins, default to 1.6
+	handler := mux[V16]
+	for _, proto := range websocket.Subprotocols(r) {
+		if h, ok := mux[proto]; ok {
+			handler = h
+			break
+		}
+	}
+
+	handler.ServeHTTP(w, r)
+}
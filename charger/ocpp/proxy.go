@@ -0,0 +1,439 @@
+package ocpp
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/evcc-io/evcc/api"
+	"github.com/evcc-io/evcc/util"
+	"github.com/lorenzodonini/ocpp-go/ocpp"
+	ocpp16 "github.com/lorenzodonini/ocpp-go/ocpp1.6"
+	"github.com/lorenzodonini/ocpp-go/ocpp1.6/core"
+	"github.com/lorenzodonini/ocpp-go/ocpp1.6/firmware"
+	"github.com/lorenzodonini/ocpp-go/ocpp1.6/localauth"
+	"github.com/lorenzodonini/ocpp-go/ocpp1.6/remotetrigger"
+	"github.com/lorenzodonini/ocpp-go/ocpp1.6/reservation"
+	"github.com/lorenzodonini/ocpp-go/ocpp1.6/smartcharging"
+	"github.com/lorenzodonini/ocpp-go/ocpp1.6/types"
+	"github.com/lorenzodonini/ocpp-go/ws"
+)
+
+const (
+	proxyTimeout = 10 * time.Second
+	proxyRetry   = time.Minute
+	proxyReplay  = 10 * time.Second
+)
+
+// proxy forwards messages between a chargepoint and an upstream central system.
+// Smart charging remains under evcc's control.
+type proxy struct {
+	log      *util.Logger
+	cp       *CP
+	client   ws.WsClient
+	upstream ocpp16.ChargePoint
+	done     chan struct{}
+
+	mu      sync.Mutex
+	started bool // upstream can only be stopped once started
+
+	txMu  sync.Mutex
+	queue []pending   // transaction messages not yet delivered to upstream, in order
+	txIDs map[int]int // upstream transaction ids of transactions started while upstream was offline
+}
+
+// pending is a transaction message awaiting delivery to upstream
+type pending struct {
+	request ocpp.Request
+	txID    int // local transaction id of StartTransaction requests
+}
+
+// Proxy connects the chargepoint to an upstream central system at uri
+func (cp *CP) Proxy(uri string) error {
+	if cp.id == "" {
+		return errors.New("proxy mode requires station id")
+	}
+
+	client := ws.NewClient()
+
+	p := &proxy{
+		log:      util.NewLogger("ocpp-proxy"),
+		cp:       cp,
+		client:   client,
+		upstream: ocpp16.NewChargePoint(cp.id, nil, client),
+		done:     make(chan struct{}),
+		txIDs:    make(map[int]int),
+	}
+
+	p.upstream.SetCoreHandler(p)
+	p.upstream.SetFirmwareManagementHandler(p)
+	p.upstream.SetLocalAuthListHandler(p)
+	p.upstream.SetRemoteTriggerHandler(p)
+	p.upstream.SetReservationHandler(p)
+	p.upstream.SetSmartChargingHandler(p)
+
+	go func() {
+		for err := range p.upstream.Errors() {
+			p.log.ERROR.Println(err)
+		}
+	}()
+
+	cp.mu.Lock()
+	cp.proxy = p
+	cp.mu.Unlock()
+
+	if err := p.start(uri); err != nil {
+		p.log.WARN.Printf("upstream connection failed, retrying: %v", err)
+		go p.retry(uri)
+	}
+
+	go p.replay()
+
+	return nil
+}
+
+// replay delivers transaction messages queued while upstream was offline once reconnected
+func (p *proxy) replay() {
+	ticker := time.NewTicker(proxyReplay)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+		case <-p.done:
+			return
+		}
+
+		if !p.client.IsConnected() {
+			continue
+		}
+
+		p.txMu.Lock()
+		if len(p.queue) > 0 {
+			p.flush()
+		}
+		p.txMu.Unlock()
+	}
+}
+
+// transaction sends a transaction message upstream after all previously queued messages.
+// The message is queued if upstream is offline. Returns nil if not delivered.
+func (p *proxy) transaction(request ocpp.Request, txID int) ocpp.Response {
+	p.txMu.Lock()
+	defer p.txMu.Unlock()
+
+	p.queue = append(p.queue, pending{request: request, txID: txID})
+
+	res := p.flush()
+
+	// chargepoint uses the upstream transaction id if the transaction started online
+	if _, ok := res.(*core.StartTransactionConfirmation); ok {
+		delete(p.txIDs, txID)
+	}
+
+	return res
+}
+
+// flush sends the queued messages in order until upstream fails and returns the last response.
+// Returns nil if any message remains queued.
+func (p *proxy) flush() ocpp.Response {
+	var res ocpp.Response
+
+	for len(p.queue) > 0 {
+		var err error
+		if res, err = p.send(p.queue[0]); err != nil {
+			p.log.WARN.Printf("%s: %v, queued %d messages", p.queue[0].request.GetFeatureName(), err, len(p.queue))
+			return nil
+		}
+
+		p.queue = p.queue[1:]
+	}
+
+	return res
+}
+
+// send forwards the message using the upstream transaction ids
+func (p *proxy) send(pe pending) (ocpp.Response, error) {
+	request := pe.request
+
+	switch req := request.(type) {
+	case *core.StopTransactionRequest:
+		if id, ok := p.txIDs[req.TransactionId]; ok {
+			r := *req
+			r.TransactionId = id
+			request = &r
+		}
+
+	case *core.MeterValuesRequest:
+		if req.TransactionId != nil {
+			if id, ok := p.txIDs[*req.TransactionId]; ok {
+				r := *req
+				r.TransactionId = &id
+				request = &r
+			}
+		}
+	}
+
+	res, err := p.forward(request)
+	if err != nil {
+		return nil, err
+	}
+
+	switch conf := res.(type) {
+	case *core.StartTransactionConfirmation:
+		p.txIDs[pe.txID] = conf.TransactionId
+
+	case *core.StopTransactionConfirmation:
+		if req, ok := pe.request.(*core.StopTransactionRequest); ok {
+			delete(p.txIDs, req.TransactionId)
+		}
+	}
+
+	return res, nil
+}
+
+// retry connects to upstream and announces the chargepoint once connected
+func (p *proxy) retry(uri string) {
+	ticker := time.NewTicker(proxyRetry)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+		case <-p.done:
+			return
+		}
+
+		if err := p.start(uri); err != nil {
+			p.log.DEBUG.Printf("upstream connection failed: %v", err)
+			continue
+		}
+
+		p.log.INFO.Println("upstream connected")
+
+		for _, feature := range []remotetrigger.MessageTrigger{core.BootNotificationFeatureName, core.StatusNotificationFeatureName} {
+			if _, err := p.downstream(remotetrigger.NewTriggerMessageRequest(feature)); err != nil {
+				p.log.WARN.Printf("trigger %s: %v", feature, err)
+			}
+		}
+
+		return
+	}
+}
+
+// start connects to upstream unless stopped
+func (p *proxy) start(uri string) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	select {
+	case <-p.done:
+		return errors.New("stopped")
+	default:
+	}
+
+	err := p.upstream.Start(uri)
+	p.started = err == nil
+
+	return err
+}
+
+// stop disconnects from upstream
+func (p *proxy) stop() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	close(p.done)
+
+	if p.started {
+		p.upstream.Stop()
+	}
+}
+
+// forward sends a chargepoint request to upstream
+func (p *proxy) forward(request ocpp.Request) (ocpp.Response, error) {
+	if !p.client.IsConnected() {
+		return nil, errors.New("upstream not connected")
+	}
+
+	return p.wait(func(cb func(ocpp.Response, error)) error {
+		return p.upstream.SendRequestAsync(request, cb)
+	})
+}
+
+// downstream sends an upstream request to the chargepoint
+func (p *proxy) downstream(request ocpp.Request) (ocpp.Response, error) {
+	return p.wait(func(cb func(ocpp.Response, error)) error {
+		return Instance().SendRequestAsync(p.cp.id, request, cb)
+	})
+}
+
+func (p *proxy) wait(send func(func(ocpp.Response, error)) error) (ocpp.Response, error) {
+	type result struct {
+		res ocpp.Response
+		err error
+	}
+
+	rc := make(chan result, 1)
+	if err := send(func(res ocpp.Response, err error) {
+		rc <- result{res, err}
+	}); err != nil {
+		return nil, err
+	}
+
+	select {
+	case r := <-rc:
+		return r.res, r.err
+	case <-time.After(proxyTimeout):
+		return nil, api.ErrTimeout
+	}
+}
+
+// upstreamResponse returns the upstream response to request if proxying, or res otherwise
+func upstreamResponse[T ocpp.Response](cp *CP, request ocpp.Request, res T) T {
+	if conf, ok := cp.forward(request).(T); ok {
+		return conf
+	}
+	return res
+}
+
+// forward sends the request upstream if proxying. Returns nil if not proxying or upstream failed.
+// Transaction messages are queued while upstream is offline.
+func (cp *CP) forward(request ocpp.Request) ocpp.Response {
+	return cp.forwardTransaction(request, 0)
+}
+
+// forwardTransaction is forward for the StartTransaction request of the local transaction txID
+func (cp *CP) forwardTransaction(request ocpp.Request, txID int) ocpp.Response {
+	cp.mu.Lock()
+	p := cp.proxy
+	cp.mu.Unlock()
+
+	if p == nil || request == nil {
+		return nil
+	}
+
+	switch request.(type) {
+	case *core.StartTransactionRequest, *core.StopTransactionRequest, *core.MeterValuesRequest:
+		return p.transaction(request, txID)
+	}
+
+	res, err := p.forward(request)
+	if err != nil {
+		p.log.WARN.Printf("%s: %v", request.GetFeatureName(), err)
+		return nil
+	}
+
+	return res
+}
+
+// downstreamResponse forwards an upstream request to the chargepoint and returns its typed response
+func downstreamResponse[T ocpp.Response](p *proxy, request ocpp.Request) (T, error) {
+	p.log.TRACE.Printf("%T: %+v", request, request)
+
+	var conf T
+
+	res, err := p.downstream(request)
+	if err != nil {
+		return conf, err
+	}
+
+	conf, ok := res.(T)
+	if !ok {
+		return conf, fmt.Errorf("invalid response: %T", res)
+	}
+
+	return conf, nil
+}
+
+func (p *proxy) OnChangeAvailability(request *core.ChangeAvailabilityRequest) (*core.ChangeAvailabilityConfirmation, error) {
+	return downstreamResponse[*core.ChangeAvailabilityConfirmation](p, request)
+}
+
+func (p *proxy) OnChangeConfiguration(request *core.ChangeConfigurationRequest) (*core.ChangeConfigurationConfirmation, error) {
+	return downstreamResponse[*core.ChangeConfigurationConfirmation](p, request)
+}
+
+func (p *proxy) OnClearCache(request *core.ClearCacheRequest) (*core.ClearCacheConfirmation, error) {
+	return downstreamResponse[*core.ClearCacheConfirmation](p, request)
+}
+
+func (p *proxy) OnDataTransfer(request *core.DataTransferRequest) (*core.DataTransferConfirmation, error) {
+	return downstreamResponse[*core.DataTransferConfirmation](p, request)
+}
+
+func (p *proxy) OnGetConfiguration(request *core.GetConfigurationRequest) (*core.GetConfigurationConfirmation, error) {
+	return downstreamResponse[*core.GetConfigurationConfirmation](p, request)
+}
+
+func (p *proxy) OnRemoteStartTransaction(request *core.RemoteStartTransactionRequest) (*core.RemoteStartTransactionConfirmation, error) {
+	// smart charging is controlled by evcc
+	request.ChargingProfile = nil
+
+	return downstreamResponse[*core.RemoteStartTransactionConfirmation](p, request)
+}
+
+func (p *proxy) OnRemoteStopTransaction(request *core.RemoteStopTransactionRequest) (*core.RemoteStopTransactionConfirmation, error) {
+	return downstreamResponse[*core.RemoteStopTransactionConfirmation](p, request)
+}
+
+func (p *proxy) OnReset(request *core.ResetRequest) (*core.ResetConfirmation, error) {
+	return downstreamResponse[*core.ResetConfirmation](p, request)
+}
+
+func (p *proxy) OnUnlockConnector(request *core.UnlockConnectorRequest) (*core.UnlockConnectorConfirmation, error) {
+	return downstreamResponse[*core.UnlockConnectorConfirmation](p, request)
+}
+
+func (p *proxy) OnGetDiagnostics(request *firmware.GetDiagnosticsRequest) (*firmware.GetDiagnosticsConfirmation, error) {
+	return downstreamResponse[*firmware.GetDiagnosticsConfirmation](p, request)
+}
+
+func (p *proxy) OnUpdateFirmware(request *firmware.UpdateFirmwareRequest) (*firmware.UpdateFirmwareConfirmation, error) {
+	return downstreamResponse[*firmware.UpdateFirmwareConfirmation](p, request)
+}
+
+func (p *proxy) OnGetLocalListVersion(request *localauth.GetLocalListVersionRequest) (*localauth.GetLocalListVersionConfirmation, error) {
+	return downstreamResponse[*localauth.GetLocalListVersionConfirmation](p, request)
+}
+
+func (p *proxy) OnSendLocalList(request *localauth.SendLocalListRequest) (*localauth.SendLocalListConfirmation, error) {
+	return downstreamResponse[*localauth.SendLocalListConfirmation](p, request)
+}
+
+func (p *proxy) OnTriggerMessage(request *remotetrigger.TriggerMessageRequest) (*remotetrigger.TriggerMessageConfirmation, error) {
+	return downstreamResponse[*remotetrigger.TriggerMessageConfirmation](p, request)
+}
+
+func (p *proxy) OnReserveNow(request *reservation.ReserveNowRequest) (*reservation.ReserveNowConfirmation, error) {
+	return downstreamResponse[*reservation.ReserveNowConfirmation](p, request)
+}
+
+func (p *proxy) OnCancelReservation(request *reservation.CancelReservationRequest) (*reservation.CancelReservationConfirmation, error) {
+	return downstreamResponse[*reservation.CancelReservationConfirmation](p, request)
+}
+
+// OnSetChargingProfile only forwards charge point max profiles which limit evcc's transaction profiles
+func (p *proxy) OnSetChargingProfile(request *smartcharging.SetChargingProfileRequest) (*smartcharging.SetChargingProfileConfirmation, error) {
+	if request.ChargingProfile == nil || request.ChargingProfile.ChargingProfilePurpose != types.ChargingProfilePurposeChargePointMaxProfile {
+		p.log.DEBUG.Printf("rejecting upstream charging profile: %+v", request.ChargingProfile)
+		return smartcharging.NewSetChargingProfileConfirmation(smartcharging.ChargingProfileStatusRejected), nil
+	}
+
+	return downstreamResponse[*smartcharging.SetChargingProfileConfirmation](p, request)
+}
+
+// OnClearChargingProfile only forwards charge point max profiles
+func (p *proxy) OnClearChargingProfile(request *smartcharging.ClearChargingProfileRequest) (*smartcharging.ClearChargingProfileConfirmation, error) {
+	if request.ChargingProfilePurpose != types.ChargingProfilePurposeChargePointMaxProfile {
+		p.log.DEBUG.Printf("rejecting upstream charging profile removal: %+v", request)
+		return smartcharging.NewClearChargingProfileConfirmation(smartcharging.ClearChargingProfileStatusUnknown), nil
+	}
+
+	return downstreamResponse[*smartcharging.ClearChargingProfileConfirmation](p, request)
+}
+
+func (p *proxy) OnGetCompositeSchedule(request *smartcharging.GetCompositeScheduleRequest) (*smartcharging.GetCompositeScheduleConfirmation, error) {
+	return downstreamResponse[*smartcharging.GetCompositeScheduleConfirmation](p, request)
+}
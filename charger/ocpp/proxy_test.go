@@ -0,0 +1,178 @@
+package ocpp
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/evcc-io/evcc/util"
+	"github.com/lorenzodonini/ocpp-go/ocpp"
+	ocpp16 "github.com/lorenzodonini/ocpp-go/ocpp1.6"
+	"github.com/lorenzodonini/ocpp-go/ocpp1.6/core"
+	"github.com/lorenzodonini/ocpp-go/ocpp1.6/types"
+	"github.com/lorenzodonini/ocpp-go/ws"
+	"github.com/stretchr/testify/require"
+)
+
+// upstream is a minimal central system recording received requests
+type upstream struct {
+	mu       sync.Mutex
+	requests []string
+}
+
+func (u *upstream) record(request interface{}) {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	u.requests = append(u.requests, fmt.Sprintf("%T", request))
+}
+
+func (u *upstream) received() []string {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	return append([]string{}, u.requests...)
+}
+
+func (u *upstream) OnAuthorize(id string, request *core.AuthorizeRequest) (*core.AuthorizeConfirmation, error) {
+	u.record(request)
+	return core.NewAuthorizationConfirmation(types.NewIdTagInfo(types.AuthorizationStatusAccepted)), nil
+}
+
+func (u *upstream) OnBootNotification(id string, request *core.BootNotificationRequest) (*core.BootNotificationConfirmation, error) {
+	u.record(request)
+	return core.NewBootNotificationConfirmation(types.NewDateTime(time.Now()), 300, core.RegistrationStatusAccepted), nil
+}
+
+func (u *upstream) OnDataTransfer(id string, request *core.DataTransferRequest) (*core.DataTransferConfirmation, error) {
+	u.record(request)
+	return core.NewDataTransferConfirmation(core.DataTransferStatusRejected), nil
+}
+
+func (u *upstream) OnHeartbeat(id string, request *core.HeartbeatRequest) (*core.HeartbeatConfirmation, error) {
+	u.record(request)
+	return core.NewHeartbeatConfirmation(types.NewDateTime(time.Now())), nil
+}
+
+func (u *upstream) OnMeterValues(id string, request *core.MeterValuesRequest) (*core.MeterValuesConfirmation, error) {
+	u.record(request)
+	return core.NewMeterValuesConfirmation(), nil
+}
+
+func (u *upstream) OnStatusNotification(id string, request *core.StatusNotificationRequest) (*core.StatusNotificationConfirmation, error) {
+	u.record(request)
+	return core.NewStatusNotificationConfirmation(), nil
+}
+
+func (u *upstream) OnStartTransaction(id string, request *core.StartTransactionRequest) (*core.StartTransactionConfirmation, error) {
+	u.record(request)
+	return core.NewStartTransactionConfirmation(types.NewIdTagInfo(types.AuthorizationStatusAccepted), 42), nil
+}
+
+func (u *upstream) OnStopTransaction(id string, request *core.StopTransactionRequest) (*core.StopTransactionConfirmation, error) {
+	u.record(request)
+	return core.NewStopTransactionConfirmation(), nil
+}
+
+func TestProxy(t *testing.T) {
+	backend := new(upstream)
+	server := ws.NewServer()
+	cs := ocpp16.NewCentralSystem(nil, server)
+	cs.SetCoreHandler(backend)
+
+	port, err := listen(func(port int) { cs.Start(port, "/{ws}") })
+	require.NoError(t, err)
+
+	cp, err := Instance().Register("test-proxy", false)
+	require.NoError(t, err)
+	require.NoError(t, cp.Proxy(fmt.Sprintf("ws://localhost:%d", port)))
+
+	station := ocpp16.NewChargePoint("test-proxy", nil, nil)
+	require.NoError(t, station.Start(csUrl))
+	defer station.Stop()
+
+	boot, err := station.BootNotification("model", "vendor")
+	require.NoError(t, err)
+	require.Equal(t, 300, boot.Interval, "upstream response")
+
+	_, err = station.StatusNotification(1, core.NoError, core.ChargePointStatusPreparing)
+	require.NoError(t, err)
+	require.NoError(t, cp.Boot())
+
+	start, err := station.StartTransaction(1, "tag", 1000, types.NewDateTime(time.Now()))
+	require.NoError(t, err)
+	require.Equal(t, 42, start.TransactionId, "upstream transaction id")
+	require.Equal(t, 42, cp.TransactionID())
+
+	_, err = station.MeterValues(1, []types.MeterValue{{
+		Timestamp:    types.NewDateTime(time.Now()),
+		SampledValue: []types.SampledValue{{Value: "2000", Measurand: types.MeasurandEnergyActiveImportRegister}},
+	}})
+	require.NoError(t, err)
+
+	energy, err := cp.ChargedEnergy()
+	require.NoError(t, err)
+	require.Equal(t, 1.0, energy)
+
+	require.Equal(t, []string{
+		"*core.BootNotificationRequest",
+		"*core.StatusNotificationRequest",
+		"*core.StartTransactionRequest",
+		"*core.MeterValuesRequest",
+	}, backend.received())
+}
+
+// connection is an upstream connection that can be taken offline
+type connection struct {
+	ws.WsClient
+	online bool
+}
+
+func (c *connection) IsConnected() bool {
+	return c.online
+}
+
+// offlineUpstream records the requests sent to upstream
+type offlineUpstream struct {
+	ocpp16.ChargePoint
+	requests []ocpp.Request
+}
+
+func (u *offlineUpstream) SendRequestAsync(request ocpp.Request, cb func(ocpp.Response, error)) error {
+	u.requests = append(u.requests, request)
+
+	switch request.(type) {
+	case *core.StartTransactionRequest:
+		cb(core.NewStartTransactionConfirmation(types.NewIdTagInfo(types.AuthorizationStatusAccepted), 42), nil)
+	case *core.StopTransactionRequest:
+		cb(core.NewStopTransactionConfirmation(), nil)
+	default:
+		cb(core.NewMeterValuesConfirmation(), nil)
+	}
+
+	return nil
+}
+
+func TestProxyOffline(t *testing.T) {
+	conn, u := new(connection), new(offlineUpstream)
+	p := &proxy{log: util.NewLogger("foo"), client: conn, upstream: u, txIDs: make(map[int]int)}
+
+	// transaction messages are queued while offline
+	require.Nil(t, p.transaction(core.NewStartTransactionRequest(1, "tag", 1000, types.NewDateTime(time.Now())), 7))
+
+	txID := 7
+	require.Nil(t, p.transaction(&core.MeterValuesRequest{ConnectorId: 1, TransactionId: &txID}, 0))
+	require.Len(t, p.queue, 2)
+
+	// queued messages are replayed in order with the upstream transaction id
+	conn.online = true
+	res := p.transaction(core.NewStopTransactionRequest(2000, types.NewDateTime(time.Now()), 7), 0)
+	require.IsType(t, new(core.StopTransactionConfirmation), res)
+	require.Empty(t, p.queue)
+	require.Empty(t, p.txIDs)
+
+	require.Len(t, u.requests, 3)
+	require.IsType(t, new(core.StartTransactionRequest), u.requests[0])
+	require.Equal(t, 42, *u.requests[1].(*core.MeterValuesRequest).TransactionId)
+	require.Equal(t, 42, u.requests[2].(*core.StopTransactionRequest).TransactionId)
+	require.Equal(t, 7, txID, "chargepoint request unchanged")
+}
@@ -0,0 +1,186 @@
+package charger
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/evcc-io/evcc/charger/ocpp"
+	"github.com/lorenzodonini/ocpp-go/ocpp1.6/core"
+	"github.com/lorenzodonini/ocpp-go/ocpp2.0.1/provisioning"
+	"github.com/lorenzodonini/ocpp-go/ocpp2.0.1/smartcharging"
+	"github.com/lorenzodonini/ocpp-go/ocpp2.0.1/types"
+)
+
+// variableKey identifies a device model variable
+func variableKey(component types.Component, variable types.Variable) string {
+	key := component.Name + "." + variable.Name
+	if component.EVSE != nil {
+		key = fmt.Sprintf("%s.%d", key, component.EVSE.ID)
+	}
+	return key
+}
+
+// getVariables queries the charging station's device model
+func (c *OCPP) getVariables(data ...provisioning.GetVariableData) (map[string]provisioning.GetVariableResult, error) {
+	res := make(map[string]provisioning.GetVariableResult)
+
+	rc := make(chan error, 1)
+	err := ocpp.Instance().CSMS.GetVariables(c.id, func(resp *provisioning.GetVariablesResponse, err error) {
+		c.log.TRACE.Printf("GetVariables %T: %+v", resp, resp)
+
+		if err == nil && resp != nil {
+			for _, r := range resp.GetVariableResult {
+				res[variableKey(r.Component, r.Variable)] = r
+			}
+		}
+
+		rc <- err
+	}, data)
+
+	return res, c.wait(err, rc)
+}
+
+// setVariables changes the charging station's device model
+func (c *OCPP) setVariables(data ...provisioning.SetVariableData) error {
+	rc := make(chan error, 1)
+	err := ocpp.Instance().CSMS.SetVariables(c.id, func(resp *provisioning.SetVariablesResponse, err error) {
+		c.log.TRACE.Printf("SetVariables %T: %+v", resp, resp)
+
+		if err == nil && resp != nil {
+			for _, r := range resp.SetVariableResult {
+				if r.AttributeStatus != provisioning.SetVariableStatusAccepted {
+					err = fmt.Errorf("%s: %s", variableKey(r.Component, r.Variable), r.AttributeStatus)
+					break
+				}
+			}
+		}
+
+		rc <- err
+	}, data)
+
+	return c.wait(err, rc)
+}
+
+// setup201 configures an OCPP 2.0.1 charging station using its device model
+func (c *OCPP) setup201(hasMeter bool, meterInterval time.Duration, initialReset core.ResetType) error {
+	var (
+		evse          = types.Component{Name: ocpp.ComponentEVSE, EVSE: &types.EVSE{ID: c.connector}}
+		smartCharging = types.Component{Name: ocpp.ComponentSmartChargingCtrlr}
+		sampledData   = types.Component{Name: ocpp.ComponentSampledDataCtrlr}
+
+		available = types.Variable{Name: ocpp.VariableAvailable}
+		enabled   = types.Variable{Name: ocpp.VariableEnabled}
+		phases    = types.Variable{Name: ocpp.VariablePhases3to1}
+	)
+
+	vars, err := c.getVariables(
+		provisioning.GetVariableData{Component: evse, Variable: available},
+		provisioning.GetVariableData{Component: smartCharging, Variable: enabled},
+		provisioning.GetVariableData{Component: smartCharging, Variable: phases},
+	)
+	if err != nil {
+		return err
+	}
+
+	// check configured connector
+	if res, ok := vars[variableKey(evse, available)]; !ok || res.AttributeStatus != provisioning.GetVariableStatusAccepted {
+		return fmt.Errorf("configured connector is not available: %d", c.connector)
+	}
+
+	if res, ok := vars[variableKey(smartCharging, enabled)]; ok && res.AttributeStatus == provisioning.GetVariableStatusAccepted {
+		if b, err := strconv.ParseBool(res.AttributeValue); err == nil && !b {
+			c.log.WARN.Println("smart charging is disabled")
+		}
+	}
+
+	if res, ok := vars[variableKey(smartCharging, phases)]; ok && res.AttributeStatus == provisioning.GetVariableStatusAccepted {
+		b, err := strconv.ParseBool(res.AttributeValue)
+		if err != nil {
+			return fmt.Errorf("invalid value for %s: %w", variableKey(smartCharging, phases), err)
+		}
+
+		c.phaseSwitchingSupported = b
+	}
+
+	if hasMeter {
+		if err := c.setVariables(
+			provisioning.SetVariableData{
+				Component:      sampledData,
+				Variable:       types.Variable{Name: ocpp.VariableTxUpdatedMeasurands},
+				AttributeValue: ocpp.ValuePreferedTxUpdatedValues,
+			},
+			provisioning.SetVariableData{
+				Component:      sampledData,
+				Variable:       types.Variable{Name: ocpp.VariableTxUpdatedInterval},
+				AttributeValue: strconv.Itoa(int(meterInterval.Seconds())),
+			},
+		); err != nil {
+			return fmt.Errorf("meter configuration rejected: %w", err)
+		}
+
+		// get initial meter values
+		ocpp.Instance().TriggerMeterValueRequest(c.cp)
+	}
+
+	if initialReset != "" {
+		ocpp.Instance().TriggerResetRequest(c.cp, initialReset)
+	}
+
+	return nil
+}
+
+// setPeriod201 sets a single charging schedule period with given current and phases.
+// The TxDefaultProfile applies to upcoming transactions, the TxProfile overrides it for the running transaction.
+func (c *OCPP) setPeriod201(current float64, phases int) error {
+	period := types.NewChargingSchedulePeriod(0, current)
+	if phases > 0 {
+		period.NumberPhases = &phases
+	}
+
+	err := c.setChargingProfile201(getChargingProfile201(txDefaultProfileID, types.ChargingProfilePurposeTxDefaultProfile, period))
+
+	if err == nil && c.cp.HasTransaction() {
+		profile := getChargingProfile201(txProfileID, types.ChargingProfilePurposeTxProfile, period)
+		profile.TransactionID = c.cp.TransactionRef()
+
+		err = c.setChargingProfile201(profile)
+	}
+
+	if err != nil {
+		c.log.TRACE.Printf("failed to set charging profile: %s", err)
+	}
+
+	return err
+}
+
+func (c *OCPP) setChargingProfile201(profile *types.ChargingProfile) error {
+	c.log.TRACE.Printf("SetChargingProfileRequest %T: %+v", profile, profile)
+
+	rc := make(chan error, 1)
+	err := ocpp.Instance().CSMS.SetChargingProfile(c.id, func(resp *smartcharging.SetChargingProfileResponse, err error) {
+		c.log.TRACE.Printf("SetChargingProfileResponse %T: %+v", resp, resp)
+		if err == nil && resp != nil && resp.Status != smartcharging.ChargingProfileStatusAccepted {
+			err = errors.New(string(resp.Status))
+		}
+
+		rc <- err
+	}, c.connector, profile)
+
+	return c.wait(err, rc)
+}
+
+func getChargingProfile201(id int, purpose types.ChargingProfilePurposeType, period types.ChargingSchedulePeriod) *types.ChargingProfile {
+	return &types.ChargingProfile{
+		ID:                     id,
+		StackLevel:             1,
+		ChargingProfilePurpose: purpose,
+		ChargingProfileKind:    types.ChargingProfileKindAbsolute,
+		ChargingSchedule: []types.ChargingSchedule{{
+			StartSchedule:          types.NewDateTime(time.Now().Add(-1 * time.Hour)),
+			ChargingRateUnit:       types.ChargingRateUnitAmperes,
+			ChargingSchedulePeriod: []types.ChargingSchedulePeriod{period},
+		}},
+	}
+}
@@ -6,12 +6,12 @@ import (
 	"github.com/evcc-io/evcc/api"
 )
 
-func decorateOCPP(base *OCPP, meter func() (float64, error), meterCurrent func() (float64, float64, float64, error), chargeRater func() (float64, error)) api.Charger {
+func decorateOCPP(base *OCPP, meter func() (float64, error), meterCurrent func() (float64, float64, float64, error), chargeRater func() (float64, error), phaseSwitcher func(int) error) api.Charger {
 	switch {
-	case chargeRater == nil && meter == nil && meterCurrent == nil:
+	case chargeRater == nil && meter == nil && meterCurrent == nil && phaseSwitcher == nil:
 		return base
 
-	case chargeRater == nil && meter != nil && meterCurrent == nil:
+	case chargeRater == nil && meter != nil && meterCurrent == nil && phaseSwitcher == nil:
 		return &struct {
 			*OCPP
 			api.Meter
@@ -22,7 +22,7 @@ func decorateOCPP(base *OCPP, meter func() (float64, error), meterCurrent func()
 			},
 		}
 
-	case chargeRater == nil && meter == nil && meterCurrent != nil:
+	case chargeRater == nil && meter == nil && meterCurrent != nil && phaseSwitcher == nil:
 		return &struct {
 			*OCPP
 			api.MeterCurrent
@@ -33,7 +33,7 @@ func decorateOCPP(base *OCPP, meter func() (float64, error), meterCurrent func()
 			},
 		}
 
-	case chargeRater == nil && meter != nil && meterCurrent != nil:
+	case chargeRater == nil && meter != nil && meterCurrent != nil && phaseSwitcher == nil:
 		return &struct {
 			*OCPP
 			api.Meter
@@ -48,7 +48,7 @@ func decorateOCPP(base *OCPP, meter func() (float64, error), meterCurrent func()
 			},
 		}
 
-	case chargeRater != nil && meter == nil && meterCurrent == nil:
+	case chargeRater != nil && meter == nil && meterCurrent == nil && phaseSwitcher == nil:
 		return &struct {
 			*OCPP
 			api.ChargeRater
@@ -59,7 +59,7 @@ func decorateOCPP(base *OCPP, meter func() (float64, error), meterCurrent func()
 			},
 		}
 
-	case chargeRater != nil && meter != nil && meterCurrent == nil:
+	case chargeRater != nil && meter != nil && meterCurrent == nil && phaseSwitcher == nil:
 		return &struct {
 			*OCPP
 			api.ChargeRater
@@ -74,7 +74,7 @@ func decorateOCPP(base *OCPP, meter func() (float64, error), meterCurrent func()
 			},
 		}
 
-	case chargeRater != nil && meter == nil && meterCurrent != nil:
+	case chargeRater != nil && meter == nil && meterCurrent != nil && phaseSwitcher == nil:
 		return &struct {
 			*OCPP
 			api.ChargeRater
@@ -89,7 +89,7 @@ func decorateOCPP(base *OCPP, meter func() (float64, error), meterCurrent func()
 			},
 		}
 
-	case chargeRater != nil && meter != nil && meterCurrent != nil:
+	case chargeRater != nil && meter != nil && meterCurrent != nil && phaseSwitcher == nil:
 		return &struct {
 			*OCPP
 			api.ChargeRater
@@ -107,6 +107,142 @@ func decorateOCPP(base *OCPP, meter func() (float64, error), meterCurrent func()
 				meterCurrent: meterCurrent,
 			},
 		}
+
+	case chargeRater == nil && meter == nil && meterCurrent == nil && phaseSwitcher != nil:
+		return &struct {
+			*OCPP
+			api.PhaseSwitcher
+		}{
+			OCPP: base,
+			PhaseSwitcher: &decorateOCPPPhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+		}
+
+	case chargeRater == nil && meter != nil && meterCurrent == nil && phaseSwitcher != nil:
+		return &struct {
+			*OCPP
+			api.Meter
+			api.PhaseSwitcher
+		}{
+			OCPP: base,
+			Meter: &decorateOCPPMeterImpl{
+				meter: meter,
+			},
+			PhaseSwitcher: &decorateOCPPPhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+		}
+
+	case chargeRater == nil && meter == nil && meterCurrent != nil && phaseSwitcher != nil:
+		return &struct {
+			*OCPP
+			api.MeterCurrent
+			api.PhaseSwitcher
+		}{
+			OCPP: base,
+			MeterCurrent: &decorateOCPPMeterCurrentImpl{
+				meterCurrent: meterCurrent,
+			},
+			PhaseSwitcher: &decorateOCPPPhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+		}
+
+	case chargeRater == nil && meter != nil && meterCurrent != nil && phaseSwitcher != nil:
+		return &struct {
+			*OCPP
+			api.Meter
+			api.MeterCurrent
+			api.PhaseSwitcher
+		}{
+			OCPP: base,
+			Meter: &decorateOCPPMeterImpl{
+				meter: meter,
+			},
+			MeterCurrent: &decorateOCPPMeterCurrentImpl{
+				meterCurrent: meterCurrent,
+			},
+			PhaseSwitcher: &decorateOCPPPhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+		}
+
+	case chargeRater != nil && meter == nil && meterCurrent == nil && phaseSwitcher != nil:
+		return &struct {
+			*OCPP
+			api.ChargeRater
+			api.PhaseSwitcher
+		}{
+			OCPP: base,
+			ChargeRater: &decorateOCPPChargeRaterImpl{
+				chargeRater: chargeRater,
+			},
+			PhaseSwitcher: &decorateOCPPPhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+		}
+
+	case chargeRater != nil && meter != nil && meterCurrent == nil && phaseSwitcher != nil:
+		return &struct {
+			*OCPP
+			api.ChargeRater
+			api.Meter
+			api.PhaseSwitcher
+		}{
+			OCPP: base,
+			ChargeRater: &decorateOCPPChargeRaterImpl{
+				chargeRater: chargeRater,
+			},
+			Meter: &decorateOCPPMeterImpl{
+				meter: meter,
+			},
+			PhaseSwitcher: &decorateOCPPPhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+		}
+
+	case chargeRater != nil && meter == nil && meterCurrent != nil && phaseSwitcher != nil:
+		return &struct {
+			*OCPP
+			api.ChargeRater
+			api.MeterCurrent
+			api.PhaseSwitcher
+		}{
+			OCPP: base,
+			ChargeRater: &decorateOCPPChargeRaterImpl{
+				chargeRater: chargeRater,
+			},
+			MeterCurrent: &decorateOCPPMeterCurrentImpl{
+				meterCurrent: meterCurrent,
+			},
+			PhaseSwitcher: &decorateOCPPPhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+		}
+
+	case chargeRater != nil && meter != nil && meterCurrent != nil && phaseSwitcher != nil:
+		return &struct {
+			*OCPP
+			api.ChargeRater
+			api.Meter
+			api.MeterCurrent
+			api.PhaseSwitcher
+		}{
+			OCPP: base,
+			ChargeRater: &decorateOCPPChargeRaterImpl{
+				chargeRater: chargeRater,
+			},
+			Meter: &decorateOCPPMeterImpl{
+				meter: meter,
+			},
+			MeterCurrent: &decorateOCPPMeterCurrentImpl{
+				meterCurrent: meterCurrent,
+			},
+			PhaseSwitcher: &decorateOCPPPhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+		}
 	}
 
 	return nil
@@ -135,3 +271,11 @@ type decorateOCPPMeterCurrentImpl struct {
 func (impl *decorateOCPPMeterCurrentImpl) Currents() (float64, float64, float64, error) {
 	return impl.meterCurrent()
 }
+
+type decorateOCPPPhaseSwitcherImpl struct {
+	phaseSwitcher func(int) error
+}
+
+func (impl *decorateOCPPPhaseSwitcherImpl) Phases1p3p(p0 int) error {
+	return impl.phaseSwitcher(p0)
+}
@@ -11,21 +11,27 @@ import (
 	"github.com/dustin/go-humanize"
 	"github.com/evcc-io/evcc/api"
 	"github.com/evcc-io/evcc/charger"
+	"github.com/evcc-io/evcc/core/energy"
+	"github.com/evcc-io/evcc/core/metrics"
 	"github.com/evcc-io/evcc/meter"
 	"github.com/evcc-io/evcc/provider/mqtt"
 	"github.com/evcc-io/evcc/push"
 	"github.com/evcc-io/evcc/server"
 	autoauth "github.com/evcc-io/evcc/server/auth"
+	"github.com/evcc-io/evcc/server/history"
 	"github.com/evcc-io/evcc/util"
 	"github.com/evcc-io/evcc/vehicle"
 	"github.com/evcc-io/evcc/vehicle/wrapper"
 	"github.com/gorilla/handlers"
 	"github.com/gorilla/mux"
+	"github.com/mitchellh/mapstructure"
+	"github.com/spf13/viper"
 )
 
 type config struct {
 	URI          interface{} // TODO deprecated
 	Network      networkConfig
+	Auth         server.AuthConfig
 	Log          string
 	SponsorToken string
 	Metrics      bool
@@ -35,6 +41,8 @@ type config struct {
 	Mqtt         mqttConfig
 	Javascript   map[string]interface{}
 	Influx       server.InfluxConfig
+	History      history.Config
+	Energy       energy.Config
 	EEBus        map[string]interface{}
 	HEMS         typedConfig
 	Messaging    messagingConfig
@@ -46,6 +54,13 @@ type config struct {
 	LoadPoints   []map[string]interface{}
 }
 
+// secretsDecodeHook resolves secret references in addition to viper's default decode hooks
+var secretsDecodeHook = viper.DecodeHook(mapstructure.ComposeDecodeHookFunc(
+	util.SecretsHookFunc(),
+	mapstructure.StringToTimeDurationHookFunc(),
+	mapstructure.StringToSliceHookFunc(","),
+))
+
 type networkConfig struct {
 	Schema string
 	Host   string
@@ -64,8 +79,10 @@ func (c networkConfig) URI() string {
 }
 
 type mqttConfig struct {
-	mqtt.Config `mapstructure:",squash"`
-	Topic       string
+	mqtt.Config   `mapstructure:",squash"`
+	Topic         string
+	HomeAssistant string // home assistant discovery prefix, empty to disable
+	State         bool   // publish full site and loadpoint state as json
 }
 
 func (conf *mqttConfig) RootTopic() string {
@@ -87,7 +104,8 @@ type typedConfig struct {
 
 type messagingConfig struct {
 	Events   map[string]push.EventTemplateConfig
-	Services []typedConfig
+	Services []qualifiedConfig
+	Rules    []push.RuleConfig
 }
 
 type tariffConfig struct {
@@ -162,9 +180,8 @@ func (cp *ConfigProvider) configureMeters(conf config) error {
 			return fmt.Errorf("cannot create %s meter: missing name", humanize.Ordinal(id+1))
 		}
 
-		m, err := meter.NewFromConfig(cc.Type, cc.Other)
+		m, err := newMeter(cc)
 		if err != nil {
-			err = fmt.Errorf("cannot create meter '%s': %w", cc.Name, err)
 			return err
 		}
 
@@ -185,9 +202,8 @@ func (cp *ConfigProvider) configureChargers(conf config) error {
 			return fmt.Errorf("cannot create %s charger: missing name", humanize.Ordinal(id+1))
 		}
 
-		c, err := charger.NewFromConfig(cc.Type, cc.Other)
+		c, err := newCharger(cc)
 		if err != nil {
-			err = fmt.Errorf("cannot create charger '%s': %w", cc.Name, err)
 			return err
 		}
 
@@ -208,25 +224,9 @@ func (cp *ConfigProvider) configureVehicles(conf config) error {
 			return fmt.Errorf("cannot create %s vehicle: missing name", humanize.Ordinal(id+1))
 		}
 
-		// ensure vehicle config has title
-		var ccWithTitle struct {
-			Title string
-			Other map[string]interface{} `mapstructure:",remain"`
-		}
-
-		if err := util.DecodeOther(cc.Other, &ccWithTitle); err != nil {
-			return err
-		}
-
-		if ccWithTitle.Title == "" {
-			//lint:ignore SA1019 as Title is safe on ascii
-			cc.Other["title"] = strings.Title(cc.Name)
-		}
-
-		v, err := vehicle.NewFromConfig(cc.Type, cc.Other)
+		v, err := newVehicle(cc)
 		if err != nil {
-			// wrap any created errors to prevent fatals
-			v, _ = wrapper.New(v, err)
+			return err
 		}
 
 		if _, exists := cp.vehicles[cc.Name]; exists {
@@ -239,6 +239,52 @@ func (cp *ConfigProvider) configureVehicles(conf config) error {
 	return nil
 }
 
+func newMeter(cc qualifiedConfig) (api.Meter, error) {
+	m, err := meter.NewFromConfig(cc.Type, cc.Other)
+	if err != nil {
+		return nil, fmt.Errorf("cannot create meter '%s': %w", cc.Name, err)
+	}
+	return metrics.InstrumentMeter(cc.Name, m), nil
+}
+
+func newCharger(cc qualifiedConfig) (api.Charger, error) {
+	c, err := charger.NewFromConfig(cc.Type, cc.Other)
+	if err != nil {
+		return nil, fmt.Errorf("cannot create charger '%s': %w", cc.Name, err)
+	}
+	return metrics.InstrumentCharger(cc.Name, c), nil
+}
+
+func newVehicle(cc qualifiedConfig) (api.Vehicle, error) {
+	// ensure vehicle config has title
+	var ccWithTitle struct {
+		Title string
+		Other map[string]interface{} `mapstructure:",remain"`
+	}
+
+	if err := util.DecodeOther(cc.Other, &ccWithTitle); err != nil {
+		return nil, err
+	}
+
+	other := make(map[string]interface{}, len(cc.Other)+1)
+	for k, v := range cc.Other {
+		other[k] = v
+	}
+
+	if ccWithTitle.Title == "" {
+		//lint:ignore SA1019 as Title is safe on ascii
+		other["title"] = strings.Title(cc.Name)
+	}
+
+	v, err := vehicle.NewFromConfig(cc.Type, other)
+	if err != nil {
+		// wrap any created errors to prevent fatals
+		v, _ = wrapper.New(v, err)
+	}
+
+	return metrics.InstrumentVehicle(cc.Name, v), nil
+}
+
 // webControl handles routing for devices. For now only api.AuthProvider related routes
 func (cp *ConfigProvider) webControl(conf networkConfig, router *mux.Router, paramC chan<- util.Param) {
 	auth := router.PathPrefix("/oauth").Subrouter()
@@ -0,0 +1,342 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/evcc-io/evcc/api"
+	"github.com/evcc-io/evcc/charger"
+	"github.com/evcc-io/evcc/core"
+	"github.com/evcc-io/evcc/meter"
+	"github.com/evcc-io/evcc/server"
+	"github.com/evcc-io/evcc/util"
+	"github.com/evcc-io/evcc/util/templates"
+	"github.com/evcc-io/evcc/vehicle"
+	"github.com/mitchellh/mapstructure"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"gopkg.in/yaml.v3"
+)
+
+// configCmd represents the config command
+var configCmd = &cobra.Command{
+	Use:   "config",
+	Short: "Configuration tools",
+}
+
+// configCheckCmd represents the config check command
+var configCheckCmd = &cobra.Command{
+	Use:   "check",
+	Short: "Validate configuration and devices",
+	Run:   runConfigCheck,
+}
+
+func init() {
+	rootCmd.AddCommand(configCmd)
+	configCmd.AddCommand(configCheckCmd)
+	configCheckCmd.Flags().BoolP("test", "t", false, "Read-only test of each device")
+}
+
+// problem is a configuration error at a configuration path
+type problem struct {
+	Path string // configuration path, e.g. loadpoints[0].charger
+	Line int    // line in the configuration file, 0 if unknown
+	Err  error
+}
+
+func (p problem) Error() string {
+	if p.Path == "" {
+		return p.Err.Error()
+	}
+	return fmt.Sprintf("%s: %v", p.Path, p.Err)
+}
+
+// deviceTest is the result of a read-only device test
+type deviceTest struct {
+	class, name string
+	err         error
+}
+
+func runConfigCheck(cmd *cobra.Command, args []string) {
+	// configured levels are validated by the check
+	util.LogLevel(rootCmd.PersistentFlags().Lookup("log").Value.String(), nil)
+	log.INFO.Printf("evcc %s", server.FormattedVersion())
+
+	if cfgFile == "" {
+		log.FATAL.Fatal("missing evcc config")
+	}
+
+	b, err := os.ReadFile(cfgFile)
+	if err != nil {
+		log.FATAL.Fatal(err)
+	}
+
+	var doc yaml.Node
+	if err := yaml.Unmarshal(b, &doc); err != nil {
+		log.FATAL.Fatalf("%s: %v", cfgFile, err)
+	}
+
+	test, _ := cmd.Flags().GetBool("test")
+	problems, tests := checkConfig(&doc, test)
+
+	if test {
+		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+		fmt.Fprintln(w, "Type\tName\tResult")
+		for _, t := range tests {
+			res := "ok"
+			if t.err != nil {
+				res = t.err.Error()
+			}
+			fmt.Fprintf(w, "%s\t%s\t%s\n", t.class, t.name, res)
+		}
+		w.Flush()
+		fmt.Println()
+	}
+
+	file := filepath.Base(cfgFile)
+	for _, p := range problems {
+		if p.Line > 0 {
+			fmt.Printf("%s:%d: %v\n", file, p.Line, p)
+		} else {
+			fmt.Printf("%s: %v\n", file, p)
+		}
+	}
+
+	if len(problems) > 0 {
+		fmt.Printf("\n%d problem(s) found\n", len(problems))
+		os.Exit(1)
+	}
+
+	fmt.Printf("%s: configuration valid\n", file)
+}
+
+// checkConfig validates the configuration read by viper and its yaml document.
+// Devices are created, but the site is not started. If test is true, devices are queried read-only.
+func checkConfig(doc *yaml.Node, test bool) ([]problem, []deviceTest) {
+	var problems []problem
+
+	if len(ConfigSchema) > 0 {
+		v, err := newSchemaValidator(ConfigSchema)
+		if err != nil {
+			problems = append(problems, problem{Err: err})
+		} else {
+			problems = append(problems, v.Validate(doc)...)
+		}
+	}
+
+	var conf config
+	if err := viper.UnmarshalExact(&conf, secretsDecodeHook); err != nil {
+		problems = append(problems, decodeProblems("", err)...)
+	}
+
+	if err := configureEnvironment(conf); err != nil {
+		problems = append(problems, problem{Err: err})
+	}
+
+	meters, p := checkDevices("meter", templates.Meter, conf.Meters, meter.NewFromConfig)
+	problems = append(problems, p...)
+
+	chargers, p := checkDevices("charger", templates.Charger, conf.Chargers, charger.NewFromConfig)
+	problems = append(problems, p...)
+
+	vehicles, p := checkDevices("vehicle", templates.Vehicle, conf.Vehicles, vehicle.NewFromConfig)
+	problems = append(problems, p...)
+
+	problems = append(problems, checkReferences(conf, meters, chargers, vehicles)...)
+
+	if err := util.DecodeOther(conf.Site, core.NewSite()); err != nil {
+		problems = append(problems, decodeProblems("site", err)...)
+	}
+
+	for id, lpc := range conf.LoadPoints {
+		if err := util.DecodeOther(lpc, core.NewLoadPoint(util.NewLogger(fmt.Sprintf("lp-%d", id+1)))); err != nil {
+			problems = append(problems, decodeProblems(fmt.Sprintf("loadpoints[%d]", id), err)...)
+		}
+	}
+
+	var tests []deviceTest
+	if test {
+		tests = testDevices(meters, chargers, vehicles)
+
+		for _, t := range tests {
+			if t.err != nil {
+				problems = append(problems, problem{Path: devicePath(conf, t.class, t.name), Err: fmt.Errorf("%s test: %w", t.name, t.err)})
+			}
+		}
+	}
+
+	for i, p := range problems {
+		if p.Line == 0 {
+			problems[i].Line = lineOf(doc, p.Path)
+		}
+	}
+
+	sort.SliceStable(problems, func(i, j int) bool { return problems[i].Line < problems[j].Line })
+
+	// schema and decoding may report the same problem
+	var res []problem
+	for i, p := range problems {
+		if i == 0 || p.Line != problems[i-1].Line || p.Error() != problems[i-1].Error() {
+			res = append(res, p)
+		}
+	}
+
+	return res, tests
+}
+
+var (
+	decodeInvalidKeys = regexp.MustCompile(`^'([^']*)' has invalid keys: (.*)$`)
+	decodeError       = regexp.MustCompile(`^error decoding '([^']*)': (.*)$`)
+	decodeName        = regexp.MustCompile(`'([^']*)'`)
+)
+
+// decodeProblems splits decoding errors into problems by path below prefix
+func decodeProblems(prefix string, err error) []problem {
+	var merr *mapstructure.Error
+	if !errors.As(err, &merr) {
+		return []problem{{Path: prefix, Err: err}}
+	}
+
+	var res []problem
+	for _, msg := range merr.Errors {
+		if m := decodeInvalidKeys.FindStringSubmatch(msg); m != nil {
+			for _, key := range strings.Split(m[2], ", ") {
+				res = append(res, problem{Path: joinPath(joinPath(prefix, m[1]), key), Err: errors.New("invalid key")})
+			}
+			continue
+		}
+
+		if m := decodeError.FindStringSubmatch(msg); m != nil {
+			res = append(res, problem{Path: joinPath(prefix, m[1]), Err: errors.New(m[2])})
+			continue
+		}
+
+		path := prefix
+		if m := decodeName.FindStringSubmatch(msg); m != nil {
+			path = joinPath(prefix, m[1])
+		}
+
+		res = append(res, problem{Path: path, Err: errors.New(msg)})
+	}
+
+	return res
+}
+
+// checkDevices resolves templates and creates the configured devices
+func checkDevices[T any](class, templateClass string, configs []qualifiedConfig, create func(string, map[string]interface{}) (T, error)) (map[string]T, []problem) {
+	res := make(map[string]T)
+	var problems []problem
+
+	for id, cc := range configs {
+		path := fmt.Sprintf("%ss[%d]", class, id)
+
+		if cc.Name == "" {
+			problems = append(problems, problem{Path: path, Err: errors.New("missing name")})
+			continue
+		}
+
+		if _, exists := res[cc.Name]; exists {
+			problems = append(problems, problem{Path: path + ".name", Err: fmt.Errorf("duplicate %s name: %s", class, cc.Name)})
+			continue
+		}
+
+		if cc.Type == "template" {
+			if err := checkTemplate(templateClass, cc.Other); err != nil {
+				problems = append(problems, problem{Path: path + ".template", Err: fmt.Errorf("%s: %w", cc.Name, err)})
+				continue
+			}
+		}
+
+		dev, err := create(cc.Type, cc.Other)
+		if err != nil {
+			problems = append(problems, problem{Path: path, Err: fmt.Errorf("cannot create %s '%s': %w", class, cc.Name, err)})
+			continue
+		}
+
+		res[cc.Name] = dev
+	}
+
+	return res, problems
+}
+
+// checkTemplate verifies that the template exists and can be rendered with the given values
+func checkTemplate(class string, other map[string]interface{}) error {
+	var cc struct {
+		Template string
+		Other    map[string]interface{} `mapstructure:",remain"`
+	}
+
+	if err := util.DecodeOther(other, &cc); err != nil {
+		return err
+	}
+
+	tmpl, err := templates.ByName(cc.Template, class)
+	if err != nil {
+		return err
+	}
+
+	_, _, err = tmpl.RenderResult(templates.TemplateRenderModeInstance, other)
+
+	return err
+}
+
+// devicePath returns the configuration path of the named device
+func devicePath(conf config, class, name string) string {
+	configs := map[string][]qualifiedConfig{
+		"meter":   conf.Meters,
+		"charger": conf.Chargers,
+		"vehicle": conf.Vehicles,
+	}[class]
+
+	for id, cc := range configs {
+		if cc.Name == name {
+			return fmt.Sprintf("%ss[%d]", class, id)
+		}
+	}
+
+	return class + "s"
+}
+
+// testDevices queries the devices read-only
+func testDevices(meters map[string]api.Meter, chargers map[string]api.Charger, vehicles map[string]api.Vehicle) []deviceTest {
+	var res []deviceTest
+
+	for name, m := range meters {
+		_, err := m.CurrentPower()
+		if b, ok := m.(api.Battery); ok && err == nil {
+			_, err = b.SoC()
+		}
+		res = append(res, deviceTest{"meter", name, err})
+	}
+
+	for name, c := range chargers {
+		_, err := c.Status()
+		if err == nil {
+			_, err = c.Enabled()
+		}
+		res = append(res, deviceTest{"charger", name, err})
+	}
+
+	for name, v := range vehicles {
+		var err error
+		if b, ok := v.(api.Battery); ok {
+			_, err = b.SoC()
+		}
+		res = append(res, deviceTest{"vehicle", name, err})
+	}
+
+	sort.Slice(res, func(i, j int) bool {
+		if res[i].class != res[j].class {
+			return res[i].class < res[j].class
+		}
+		return res[i].name < res[j].name
+	})
+
+	return res
+}
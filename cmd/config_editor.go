@@ -0,0 +1,325 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/evcc-io/evcc/api"
+	"github.com/evcc-io/evcc/cmd/configstore"
+	"github.com/evcc-io/evcc/core"
+	"github.com/evcc-io/evcc/util"
+	"github.com/evcc-io/evcc/util/templates"
+)
+
+// configEditor changes devices and loadpoints of the configuration file.
+// Changes are applied by reloading and reverted if reloading fails.
+type configEditor struct {
+	store  *configstore.Store
+	reload func() (reloadResult, error)
+}
+
+var templateClasses = map[string]string{
+	"meter":   templates.Meter,
+	"charger": templates.Charger,
+	"vehicle": templates.Vehicle,
+}
+
+// modify applies fn to the configuration and reloads it
+func (e *configEditor) modify(fn func(doc *configstore.Document) error) (interface{}, error) {
+	var res reloadResult
+
+	err := e.store.Modify(fn, func() error {
+		var err error
+		res, err = e.reload()
+		return err
+	})
+
+	return res, err
+}
+
+// Devices returns the configured devices of class with masked secrets
+func (e *configEditor) Devices(class string) ([]map[string]interface{}, error) {
+	res, err := e.store.List(class + "s")
+
+	for _, conf := range res {
+		mask(class, conf)
+	}
+
+	if res == nil {
+		res = []map[string]interface{}{}
+	}
+
+	return res, err
+}
+
+func (e *configEditor) AddDevice(class string, conf map[string]interface{}) (interface{}, error) {
+	if err := checkDevice(class, conf); err != nil {
+		return nil, err
+	}
+
+	return e.modify(func(doc *configstore.Document) error {
+		devices, err := doc.List(class + "s")
+		if err != nil {
+			return err
+		}
+
+		if deviceIndex(devices, conf["name"]) >= 0 {
+			return fmt.Errorf("duplicate %s name: %v already defined and must be unique", class, conf["name"])
+		}
+
+		return doc.Append(class+"s", conf)
+	})
+}
+
+func (e *configEditor) UpdateDevice(class, name string, conf map[string]interface{}) (interface{}, error) {
+	if _, ok := conf["name"]; !ok {
+		conf["name"] = name
+	}
+
+	return e.modify(func(doc *configstore.Document) error {
+		devices, err := doc.List(class + "s")
+		if err != nil {
+			return err
+		}
+
+		id := deviceIndex(devices, name)
+		if id < 0 {
+			return fmt.Errorf("%s not found: %s", class, name)
+		}
+
+		if other := deviceIndex(devices, conf["name"]); other >= 0 && other != id {
+			return fmt.Errorf("duplicate %s name: %v already defined and must be unique", class, conf["name"])
+		}
+
+		unmask(class, conf, devices[id])
+
+		if err := checkDevice(class, conf); err != nil {
+			return err
+		}
+
+		return doc.Set(class+"s", id, conf)
+	})
+}
+
+func (e *configEditor) DeleteDevice(class, name string) (interface{}, error) {
+	return e.modify(func(doc *configstore.Document) error {
+		devices, err := doc.List(class + "s")
+		if err != nil {
+			return err
+		}
+
+		id := deviceIndex(devices, name)
+		if id < 0 {
+			return fmt.Errorf("%s not found: %s", class, name)
+		}
+
+		return doc.Delete(class+"s", id)
+	})
+}
+
+// TestDevice creates the device, queries it read-only and closes it. Masked values are taken from the configured device of the same name.
+func (e *configEditor) TestDevice(class string, conf map[string]interface{}) error {
+	devices, err := e.store.List(class + "s")
+	if err != nil {
+		return err
+	}
+
+	if id := deviceIndex(devices, conf["name"]); id >= 0 {
+		unmask(class, conf, devices[id])
+	}
+
+	if err := checkDevice(class, conf); err != nil {
+		return err
+	}
+
+	var cc qualifiedConfig
+	if err := util.DecodeOther(conf, &cc); err != nil {
+		return err
+	}
+
+	var tests []deviceTest
+
+	switch class {
+	case "meter":
+		var dev api.Meter
+		if dev, err = newMeter(cc); err == nil {
+			defer closeDevices([]interface{}{dev})
+			tests = testDevices(map[string]api.Meter{cc.Name: dev}, nil, nil)
+		}
+	case "charger":
+		var dev api.Charger
+		if dev, err = newCharger(cc); err == nil {
+			defer closeDevices([]interface{}{dev})
+			tests = testDevices(nil, map[string]api.Charger{cc.Name: dev}, nil)
+		}
+	case "vehicle":
+		var dev api.Vehicle
+		if dev, err = newVehicle(cc); err == nil {
+			defer closeDevices([]interface{}{dev})
+			tests = testDevices(nil, nil, map[string]api.Vehicle{cc.Name: dev})
+		}
+	}
+
+	if err == nil && len(tests) > 0 {
+		err = tests[0].err
+	}
+
+	return err
+}
+
+func (e *configEditor) Loadpoints() ([]map[string]interface{}, error) {
+	res, err := e.store.List("loadpoints")
+	if res == nil {
+		res = []map[string]interface{}{}
+	}
+	return res, err
+}
+
+func (e *configEditor) AddLoadpoint(conf map[string]interface{}) (interface{}, error) {
+	if err := checkLoadpoint(conf); err != nil {
+		return nil, err
+	}
+
+	return e.modify(func(doc *configstore.Document) error {
+		return doc.Append("loadpoints", conf)
+	})
+}
+
+func (e *configEditor) UpdateLoadpoint(id int, conf map[string]interface{}) (interface{}, error) {
+	if err := checkLoadpoint(conf); err != nil {
+		return nil, err
+	}
+
+	return e.modify(func(doc *configstore.Document) error {
+		if err := doc.Set("loadpoints", id, conf); err != nil {
+			return fmt.Errorf("loadpoint %d: %w", id, err)
+		}
+		return nil
+	})
+}
+
+func (e *configEditor) DeleteLoadpoint(id int) (interface{}, error) {
+	return e.modify(func(doc *configstore.Document) error {
+		if err := doc.Delete("loadpoints", id); err != nil {
+			return fmt.Errorf("loadpoint %d: %w", id, err)
+		}
+		return nil
+	})
+}
+
+// checkDevice verifies name and type and renders the device template
+func checkDevice(class string, conf map[string]interface{}) error {
+	templateClass, ok := templateClasses[class]
+	if !ok {
+		return fmt.Errorf("invalid class: %s", class)
+	}
+
+	var cc qualifiedConfig
+	if err := util.DecodeOther(conf, &cc); err != nil {
+		return err
+	}
+
+	if cc.Name == "" {
+		return errors.New("missing name")
+	}
+
+	if cc.Type == "" {
+		return errors.New("missing type")
+	}
+
+	if cc.Type == "template" {
+		return checkTemplate(templateClass, cc.Other)
+	}
+
+	return nil
+}
+
+// checkLoadpoint verifies the loadpoint settings. Referenced devices are verified on reload.
+func checkLoadpoint(conf map[string]interface{}) error {
+	return util.DecodeOther(conf, core.NewLoadPoint(util.NewLogger("lp")))
+}
+
+// deviceIndex returns the index of the device named name or -1
+func deviceIndex(devices []map[string]interface{}, name interface{}) int {
+	for id, conf := range devices {
+		if fmt.Sprint(conf["name"]) == fmt.Sprint(name) {
+			return id
+		}
+	}
+	return -1
+}
+
+// credentialKeys are parts of configuration keys whose values are treated as secret for devices without template
+var credentialKeys = []string{"password", "secret", "token", "key", "auth", "pin", "pass", "credential"}
+
+// maskedKeys returns the keys of the device configuration whose values are secret.
+// Templates define their secret params, other devices mask credential-like keys.
+func maskedKeys(class string, conf map[string]interface{}) []string {
+	var res []string
+
+	if conf["type"] != "template" {
+		for key := range conf {
+			if _, nested := conf[key].(map[string]interface{}); nested {
+				continue
+			}
+
+			for _, part := range credentialKeys {
+				if strings.Contains(strings.ToLower(key), part) {
+					res = append(res, key)
+					break
+				}
+			}
+		}
+
+		return res
+	}
+
+	tmpl, err := templates.ByName(fmt.Sprint(conf["template"]), templateClasses[class])
+	if err != nil {
+		return nil
+	}
+
+	for key := range conf {
+		for _, name := range tmpl.MaskedParams() {
+			if strings.EqualFold(key, name) {
+				res = append(res, key)
+			}
+		}
+	}
+
+	return res
+}
+
+// mask replaces secret values of the configuration including nested plugin configurations
+func mask(class string, conf map[string]interface{}) {
+	for _, key := range maskedKeys(class, conf) {
+		conf[key] = util.RedactReplacement
+	}
+
+	for _, val := range conf {
+		if nested, ok := val.(map[string]interface{}); ok {
+			mask(class, nested)
+		}
+	}
+}
+
+// unmask replaces masked values with the values of the previous configuration
+func unmask(class string, conf, prev map[string]interface{}) {
+	for _, key := range maskedKeys(class, conf) {
+		if conf[key] == util.RedactReplacement {
+			conf[key] = prev[key]
+		}
+	}
+
+	for key, val := range conf {
+		nested, ok := val.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		if prevNested, ok := prev[key].(map[string]interface{}); ok {
+			unmask(class, nested, prevNested)
+		}
+	}
+}
@@ -0,0 +1,103 @@
+package cmd
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/evcc-io/evcc/cmd/configstore"
+	"github.com/evcc-io/evcc/util"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConfigEditor(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "evcc.yaml")
+	require.NoError(t, os.WriteFile(file, []byte(`chargers:
+- name: wallbox
+  type: template
+  template: easee
+  user: foo
+  password: secret
+  charger: EH123456
+loadpoints:
+- charger: wallbox
+`), 0o600))
+
+	var reloadErr error
+	e := &configEditor{
+		store: configstore.New(file),
+		reload: func() (reloadResult, error) {
+			return reloadResult{Reloaded: []string{"charger"}}, reloadErr
+		},
+	}
+
+	chargers, err := e.Devices("charger")
+	require.NoError(t, err)
+	require.Len(t, chargers, 1)
+	assert.Equal(t, util.RedactReplacement, chargers[0]["password"])
+	assert.Equal(t, "foo", chargers[0]["user"])
+
+	// masked values are kept
+	chargers[0]["charger"] = "EH654321"
+	res, err := e.UpdateDevice("charger", "wallbox", chargers[0])
+	require.NoError(t, err)
+	assert.Equal(t, reloadResult{Reloaded: []string{"charger"}}, res)
+
+	stored, err := e.store.List("chargers")
+	require.NoError(t, err)
+	assert.Equal(t, "secret", stored[0]["password"])
+	assert.Equal(t, "EH654321", stored[0]["charger"])
+
+	_, err = e.AddDevice("charger", map[string]interface{}{"name": "wallbox", "type": "demo"})
+	assert.EqualError(t, err, "duplicate charger name: wallbox already defined and must be unique")
+
+	_, err = e.AddDevice("charger", map[string]interface{}{"type": "demo"})
+	assert.EqualError(t, err, "missing name")
+
+	_, err = e.AddDevice("charger", map[string]interface{}{"name": "other", "type": "template", "template": "unknown"})
+	assert.EqualError(t, err, "template not found: unknown")
+
+	_, err = e.AddLoadpoint(map[string]interface{}{"charger": "wallbox", "foo": "bar"})
+	assert.Error(t, err)
+
+	_, err = e.DeleteLoadpoint(1)
+	assert.EqualError(t, err, "loadpoint 1: not found")
+
+	// failed reload restores the configuration
+	reloadErr = errors.New("loadpoints[0]: invalid charger: wallbox")
+	_, err = e.DeleteDevice("charger", "wallbox")
+	assert.Equal(t, reloadErr, err)
+
+	chargers, err = e.Devices("charger")
+	require.NoError(t, err)
+	assert.Len(t, chargers, 1)
+}
+
+func TestConfigEditorMaskCustom(t *testing.T) {
+	conf := map[string]interface{}{
+		"name":   "custom",
+		"type":   "custom",
+		"apikey": "secret",
+		"status": map[string]interface{}{
+			"source":   "http",
+			"uri":      "http://charger/status",
+			"password": "secret",
+		},
+	}
+
+	mask("charger", conf)
+	assert.Equal(t, util.RedactReplacement, conf["apikey"])
+	assert.Equal(t, util.RedactReplacement, conf["status"].(map[string]interface{})["password"])
+	assert.Equal(t, "http://charger/status", conf["status"].(map[string]interface{})["uri"])
+
+	prev := map[string]interface{}{
+		"apikey": "secret",
+		"status": map[string]interface{}{"password": "secret"},
+	}
+
+	unmask("charger", conf, prev)
+	assert.Equal(t, "secret", conf["apikey"])
+	assert.Equal(t, "secret", conf["status"].(map[string]interface{})["password"])
+}
@@ -0,0 +1,248 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/evcc-io/evcc/util"
+	"gopkg.in/yaml.v3"
+)
+
+// ConfigSchema is the json schema of the configuration file
+var ConfigSchema []byte
+
+// schema is the subset of json schema draft-07 used by the configuration schema
+type schema struct {
+	Ref                  string             `json:"$ref"`
+	Type                 string             `json:"type"`
+	Properties           map[string]*schema `json:"properties"`
+	AdditionalProperties *schema            `json:"additionalProperties"`
+	Required             []string           `json:"required"`
+	Items                *schema            `json:"items"`
+	Enum                 []interface{}      `json:"enum"`
+	Pattern              string             `json:"pattern"`
+	MinItems             int                `json:"minItems"`
+	UniqueItems          bool               `json:"uniqueItems"`
+	Definitions          map[string]*schema `json:"definitions"`
+}
+
+// schemaValidator validates yaml documents against a schema
+type schemaValidator struct {
+	root     *schema
+	problems []problem
+}
+
+func newSchemaValidator(b []byte) (*schemaValidator, error) {
+	var root schema
+	if err := json.Unmarshal(b, &root); err != nil {
+		return nil, fmt.Errorf("invalid schema: %w", err)
+	}
+	return &schemaValidator{root: &root}, nil
+}
+
+// Validate validates the yaml document and returns the problems found
+func (v *schemaValidator) Validate(doc *yaml.Node) []problem {
+	v.problems = nil
+
+	if doc.Kind == yaml.DocumentNode && len(doc.Content) > 0 {
+		v.validate(v.root, doc.Content[0], "")
+	}
+
+	return v.problems
+}
+
+func (v *schemaValidator) report(node *yaml.Node, path, format string, a ...interface{}) {
+	v.problems = append(v.problems, problem{
+		Path: path,
+		Line: node.Line,
+		Err:  fmt.Errorf(format, a...),
+	})
+}
+
+// resolve returns the schema referenced by a local definition ref
+func (v *schemaValidator) resolve(ref string) *schema {
+	if name := strings.TrimPrefix(ref, "#/definitions/"); name != ref {
+		return v.root.Definitions[name]
+	}
+	return nil
+}
+
+// typeOf returns the json schema type of the node
+func typeOf(node *yaml.Node) string {
+	switch node.Kind {
+	case yaml.MappingNode:
+		return "object"
+	case yaml.SequenceNode:
+		return "array"
+	case yaml.AliasNode:
+		return typeOf(node.Alias)
+	}
+
+	switch node.ShortTag() {
+	case "!!int":
+		return "integer"
+	case "!!float":
+		return "number"
+	case "!!bool":
+		return "boolean"
+	case "!!null":
+		return "null"
+	default:
+		return "string"
+	}
+}
+
+func (v *schemaValidator) validate(s *schema, node *yaml.Node, path string) {
+	if node.Kind == yaml.AliasNode {
+		node = node.Alias
+	}
+
+	// empty values are treated as missing, secret references are validated when resolved
+	if s == nil || typeOf(node) == "null" || node.Kind == yaml.ScalarNode && util.HasSecrets(node.Value) {
+		return
+	}
+
+	// siblings of $ref are applied, too
+	if s.Ref != "" {
+		v.validate(v.resolve(s.Ref), node, path)
+	}
+
+	if typ := typeOf(node); s.Type != "" && typ != s.Type && !(s.Type == "number" && typ == "integer") {
+		v.report(node, path, "expected %s, got %s", s.Type, typ)
+		return
+	}
+
+	if len(s.Enum) > 0 {
+		var valid []string
+		for _, e := range s.Enum {
+			valid = append(valid, fmt.Sprint(e))
+		}
+
+		if !containsFold(valid, node.Value) {
+			v.report(node, path, "invalid value %s, expected one of %s", node.Value, strings.Join(valid, ", "))
+		}
+	}
+
+	if s.Pattern != "" && node.Kind == yaml.ScalarNode {
+		if re, err := regexp.Compile(s.Pattern); err == nil && !re.MatchString(node.Value) {
+			v.report(node, path, "invalid value %s, must match %s", node.Value, s.Pattern)
+		}
+	}
+
+	switch node.Kind {
+	case yaml.MappingNode:
+		v.validateObject(s, node, path)
+	case yaml.SequenceNode:
+		v.validateArray(s, node, path)
+	}
+}
+
+func (v *schemaValidator) validateObject(s *schema, node *yaml.Node, path string) {
+	var keys []string
+
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		key, val := node.Content[i].Value, node.Content[i+1]
+		keys = append(keys, key)
+
+		prop, ok := propertyFold(s.Properties, key)
+		if !ok {
+			prop = s.AdditionalProperties
+		}
+
+		v.validate(prop, val, joinPath(path, key))
+	}
+
+	for _, req := range s.Required {
+		if !containsFold(keys, req) {
+			v.report(node, path, "missing %s", req)
+		}
+	}
+}
+
+func (v *schemaValidator) validateArray(s *schema, node *yaml.Node, path string) {
+	if len(node.Content) < s.MinItems {
+		v.report(node, path, "expected at least %d items", s.MinItems)
+	}
+
+	seen := make(map[string]bool)
+
+	for i, item := range node.Content {
+		itemPath := path + "[" + strconv.Itoa(i) + "]"
+		v.validate(s.Items, item, itemPath)
+
+		if s.UniqueItems && item.Kind == yaml.ScalarNode {
+			if seen[item.Value] {
+				v.report(item, itemPath, "duplicate item %s", item.Value)
+			}
+			seen[item.Value] = true
+		}
+	}
+}
+
+// propertyFold returns the property schema matching key case-insensitively like viper
+func propertyFold(props map[string]*schema, key string) (*schema, bool) {
+	for name, prop := range props {
+		if strings.EqualFold(name, key) {
+			return prop, true
+		}
+	}
+	return nil, false
+}
+
+func containsFold(list []string, s string) bool {
+	for _, e := range list {
+		if strings.EqualFold(e, s) {
+			return true
+		}
+	}
+	return false
+}
+
+func joinPath(path, key string) string {
+	if path == "" || key == "" {
+		return path + key
+	}
+	return path + "." + key
+}
+
+var pathSegment = regexp.MustCompile(`([^.\[\]]+)|\[(\d+)\]`)
+
+// lineOf returns the line of the node at path or of its closest existing parent
+func lineOf(doc *yaml.Node, path string) int {
+	if doc == nil || doc.Kind != yaml.DocumentNode || len(doc.Content) == 0 {
+		return 0
+	}
+
+	node := doc.Content[0]
+	line := node.Line
+
+	for _, m := range pathSegment.FindAllStringSubmatch(path, -1) {
+		var next *yaml.Node
+
+		switch {
+		case m[1] != "" && node.Kind == yaml.MappingNode:
+			for i := 0; i+1 < len(node.Content); i += 2 {
+				if strings.EqualFold(node.Content[i].Value, m[1]) {
+					line = node.Content[i].Line
+					next = node.Content[i+1]
+					break
+				}
+			}
+		case m[2] != "" && node.Kind == yaml.SequenceNode:
+			if i, _ := strconv.Atoi(m[2]); i < len(node.Content) {
+				next = node.Content[i]
+				line = next.Line
+			}
+		}
+
+		if next == nil {
+			break
+		}
+		node = next
+	}
+
+	return line
+}
@@ -0,0 +1,123 @@
+package cmd
+
+import (
+	"testing"
+
+	"github.com/evcc-io/evcc/util"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+)
+
+const testSchema = `{
+  "type": "object",
+  "properties": {
+    "log": { "$ref": "#/definitions/loglevel" },
+    "interval": { "type": "string", "pattern": "\\d[msh]$" },
+    "meters": {
+      "type": "array",
+      "items": { "$ref": "#/definitions/namedObject" }
+    },
+    "site": {
+      "type": "object",
+      "required": ["meters"],
+      "properties": {
+        "pvs": { "type": "array", "items": { "type": "string" }, "minItems": 1, "uniqueItems": true },
+        "voltage": { "type": "number" }
+      }
+    }
+  },
+  "definitions": {
+    "loglevel": { "enum": ["debug", "error"] },
+    "namedObject": {
+      "type": "object",
+      "required": ["name", "type"]
+    }
+  }
+}`
+
+func TestSchemaValidator(t *testing.T) {
+	v, err := newSchemaValidator([]byte(testSchema))
+	require.NoError(t, err)
+
+	validate := func(doc string) []string {
+		var node yaml.Node
+		require.NoError(t, yaml.Unmarshal([]byte(doc), &node))
+
+		var res []string
+		for _, p := range v.Validate(&node) {
+			res = append(res, p.Error())
+		}
+		return res
+	}
+
+	assert.Empty(t, validate(`
+log: ERROR
+interval: 10s
+meters:
+- name: grid
+  type: custom
+site:
+  meters:
+  pvs: [a, b]
+  voltage: 230
+`))
+
+	// secret references are validated when resolved
+	assert.Empty(t, validate(`
+log: ${env:LOG}
+site:
+  meters:
+  voltage: ${file:/run/secrets/voltage}
+`))
+
+	assert.ElementsMatch(t, []string{
+		"log: invalid value verbose, expected one of debug, error",
+		"interval: invalid value 10, must match \\d[msh]$",
+		"meters[0]: missing type",
+		"site: missing meters",
+		"site.pvs[1]: duplicate item a",
+		"site.voltage: expected number, got string",
+	}, validate(`
+log: verbose
+interval: "10"
+meters:
+- name: grid
+site:
+  pvs: [a, a]
+  voltage: high
+`))
+}
+
+func TestLineOf(t *testing.T) {
+	var doc yaml.Node
+	require.NoError(t, yaml.Unmarshal([]byte(`site:
+  title: home
+loadpoints:
+- title: garage
+  charger: wallbox
+`), &doc))
+
+	assert.Equal(t, 2, lineOf(&doc, "site.title"))
+	assert.Equal(t, 5, lineOf(&doc, "loadpoints[0].charger"))
+	assert.Equal(t, 4, lineOf(&doc, "loadpoints[0].unknown"))
+	assert.Equal(t, 3, lineOf(&doc, "loadpoints[1]"))
+	assert.Equal(t, 1, lineOf(&doc, ""))
+}
+
+func TestDecodeProblems(t *testing.T) {
+	var lp struct{ Mode int }
+	err := util.DecodeOther(map[string]interface{}{"mode": "fast", "foo": 1, "bar": 2}, &lp)
+	require.Error(t, err)
+
+	var res []string
+	for _, p := range decodeProblems("loadpoints[0]", err) {
+		res = append(res, p.Error())
+	}
+
+	assert.ElementsMatch(t, []string{
+		"loadpoints[0].bar: invalid key",
+		"loadpoints[0].foo: invalid key",
+		"loadpoints[0].Mode: cannot parse 'Mode' as int: strconv.ParseInt: parsing \"fast\": invalid syntax",
+	}, res)
+}
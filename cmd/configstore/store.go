@@ -0,0 +1,272 @@
+package configstore
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Store reads and modifies the list sections of a yaml configuration file.
+// Comments are kept, the file is reformatted on save.
+type Store struct {
+	mu   sync.Mutex
+	file string
+}
+
+// New creates a store for the configuration file
+func New(file string) *Store {
+	return &Store{file: file}
+}
+
+// List returns the entries of the configuration section key
+func (s *Store) List(key string) ([]map[string]interface{}, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	doc, _, err := s.read()
+	if err != nil {
+		return nil, err
+	}
+
+	return doc.List(key)
+}
+
+// Modify applies fn to the configuration document and saves the result.
+// If apply fails, the previous configuration file is restored and the error returned.
+func (s *Store) Modify(fn func(doc *Document) error, apply func() error) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	doc, prev, err := s.read()
+	if err != nil {
+		return err
+	}
+
+	if err := fn(doc); err != nil {
+		return err
+	}
+
+	b, err := doc.bytes()
+	if err != nil {
+		return err
+	}
+
+	if err := s.write(b); err != nil {
+		return err
+	}
+
+	if err := apply(); err != nil {
+		if rerr := s.write(prev); rerr != nil {
+			return fmt.Errorf("%w (restore failed: %v)", err, rerr)
+		}
+		return err
+	}
+
+	return nil
+}
+
+func (s *Store) read() (*Document, []byte, error) {
+	b, err := os.ReadFile(s.file)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var node yaml.Node
+	if err := yaml.Unmarshal(b, &node); err != nil {
+		return nil, nil, fmt.Errorf("%s: %w", s.file, err)
+	}
+
+	// empty file
+	if node.Kind == 0 {
+		node = yaml.Node{Kind: yaml.DocumentNode, Content: []*yaml.Node{{Kind: yaml.MappingNode, Tag: "!!map"}}}
+	}
+
+	if len(node.Content) == 0 || node.Content[0].Kind != yaml.MappingNode {
+		return nil, nil, fmt.Errorf("%s: invalid configuration", s.file)
+	}
+
+	return &Document{root: node.Content[0], node: &node}, b, nil
+}
+
+// write replaces the configuration file atomically
+func (s *Store) write(b []byte) error {
+	mode := os.FileMode(0o644)
+	if fi, err := os.Stat(s.file); err == nil {
+		mode = fi.Mode()
+	}
+
+	tmp := s.file + ".tmp"
+	if err := os.WriteFile(tmp, b, mode); err != nil {
+		return err
+	}
+
+	return os.Rename(tmp, s.file)
+}
+
+// Document is a yaml configuration document
+type Document struct {
+	node *yaml.Node
+	root *yaml.Node
+}
+
+func (d *Document) bytes() ([]byte, error) {
+	var buf bytes.Buffer
+
+	enc := yaml.NewEncoder(&buf)
+	enc.SetIndent(2)
+
+	if err := enc.Encode(d.node); err != nil {
+		return nil, err
+	}
+
+	err := enc.Close()
+
+	return buf.Bytes(), err
+}
+
+// section returns the sequence node of key. If create is true, missing sections are added.
+func (d *Document) section(key string, create bool) (*yaml.Node, error) {
+	for i := 0; i+1 < len(d.root.Content); i += 2 {
+		if strings.EqualFold(d.root.Content[i].Value, key) {
+			node := d.root.Content[i+1]
+
+			// empty section
+			if node.Kind == yaml.ScalarNode && node.ShortTag() == "!!null" {
+				*node = yaml.Node{Kind: yaml.SequenceNode, Tag: "!!seq"}
+			}
+
+			if node.Kind != yaml.SequenceNode {
+				return nil, fmt.Errorf("%s: not a list", key)
+			}
+
+			return node, nil
+		}
+	}
+
+	if !create {
+		return nil, nil
+	}
+
+	node := &yaml.Node{Kind: yaml.SequenceNode, Tag: "!!seq"}
+	d.root.Content = append(d.root.Content, &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: key}, node)
+
+	return node, nil
+}
+
+// List returns the entries of section key
+func (d *Document) List(key string) ([]map[string]interface{}, error) {
+	node, err := d.section(key, false)
+	if node == nil || err != nil {
+		return nil, err
+	}
+
+	var res []map[string]interface{}
+	err = node.Decode(&res)
+
+	return res, err
+}
+
+// Append adds an entry to section key
+func (d *Document) Append(key string, entry map[string]interface{}) error {
+	node, err := d.section(key, true)
+	if err != nil {
+		return err
+	}
+
+	val, err := encode(entry)
+	if err == nil {
+		node.Content = append(node.Content, val)
+	}
+
+	return err
+}
+
+// Set replaces the entry at index of section key
+func (d *Document) Set(key string, index int, entry map[string]interface{}) error {
+	node, err := d.item(key, index)
+	if err != nil {
+		return err
+	}
+
+	val, err := encode(entry)
+	if err != nil {
+		return err
+	}
+
+	// keep comments of the replaced entry
+	val.HeadComment, val.LineComment, val.FootComment = node.HeadComment, node.LineComment, node.FootComment
+	*node = *val
+
+	return nil
+}
+
+// Delete removes the entry at index of section key
+func (d *Document) Delete(key string, index int) error {
+	if _, err := d.item(key, index); err != nil {
+		return err
+	}
+
+	node, _ := d.section(key, false)
+	node.Content = append(node.Content[:index], node.Content[index+1:]...)
+
+	return nil
+}
+
+func (d *Document) item(key string, index int) (*yaml.Node, error) {
+	node, err := d.section(key, false)
+	if err != nil {
+		return nil, err
+	}
+
+	if node == nil || index < 0 || index >= len(node.Content) {
+		return nil, errors.New("not found")
+	}
+
+	return node.Content[index], nil
+}
+
+// order of well-known keys, all other keys are sorted alphabetically
+var keyOrder = []string{"name", "type", "template", "title"}
+
+func keyRank(key string) int {
+	for i, k := range keyOrder {
+		if k == key {
+			return i
+		}
+	}
+	return len(keyOrder)
+}
+
+// encode creates a mapping node with well-known keys first
+func encode(entry map[string]interface{}) (*yaml.Node, error) {
+	keys := make([]string, 0, len(entry))
+	for k := range entry {
+		keys = append(keys, k)
+	}
+
+	sort.Slice(keys, func(i, j int) bool {
+		if ri, rj := keyRank(keys[i]), keyRank(keys[j]); ri != rj {
+			return ri < rj
+		}
+		return keys[i] < keys[j]
+	})
+
+	res := &yaml.Node{Kind: yaml.MappingNode, Tag: "!!map"}
+
+	for _, k := range keys {
+		var val yaml.Node
+		if err := val.Encode(entry[k]); err != nil {
+			return nil, fmt.Errorf("%s: %w", k, err)
+		}
+
+		res.Content = append(res.Content, &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: k}, &val)
+	}
+
+	return res, nil
+}
@@ -0,0 +1,82 @@
+package configstore
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testConfig = `# site setup
+site:
+  title: home
+meters:
+- name: grid
+  type: template
+  template: demo
+- name: pv # roof
+  type: demo
+loadpoints:
+`
+
+func TestStore(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "evcc.yaml")
+	require.NoError(t, os.WriteFile(file, []byte(testConfig), 0o600))
+
+	s := New(file)
+
+	res, err := s.List("meters")
+	require.NoError(t, err)
+	assert.Equal(t, []map[string]interface{}{
+		{"name": "grid", "type": "template", "template": "demo"},
+		{"name": "pv", "type": "demo"},
+	}, res)
+
+	apply := func() error { return nil }
+
+	require.NoError(t, s.Modify(func(doc *Document) error {
+		if err := doc.Set("meters", 0, map[string]interface{}{"template": "other", "name": "grid", "type": "template"}); err != nil {
+			return err
+		}
+		if err := doc.Append("chargers", map[string]interface{}{"type": "demo", "name": "wallbox"}); err != nil {
+			return err
+		}
+		return doc.Append("loadpoints", map[string]interface{}{"title": "garage", "charger": "wallbox"})
+	}, apply))
+
+	b, err := os.ReadFile(file)
+	require.NoError(t, err)
+	assert.Contains(t, string(b), "# site setup")
+	assert.Contains(t, string(b), "pv # roof")
+	assert.Contains(t, string(b), "name: wallbox\n    type: demo")
+
+	res, err = s.List("loadpoints")
+	require.NoError(t, err)
+	assert.Equal(t, []map[string]interface{}{{"title": "garage", "charger": "wallbox"}}, res)
+
+	fi, err := os.Stat(file)
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(0o600), fi.Mode().Perm())
+
+	// restore on failure
+	err = s.Modify(func(doc *Document) error {
+		return doc.Delete("chargers", 0)
+	}, func() error {
+		return errors.New("failed")
+	})
+	assert.EqualError(t, err, "failed")
+
+	res, err = s.List("chargers")
+	require.NoError(t, err)
+	assert.Len(t, res, 1)
+
+	assert.Error(t, s.Modify(func(doc *Document) error {
+		return doc.Delete("chargers", 1)
+	}, apply))
+	assert.Error(t, s.Modify(func(doc *Document) error {
+		return doc.Append("site", nil)
+	}, apply))
+}
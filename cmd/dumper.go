@@ -10,6 +10,7 @@ import (
 	"time"
 
 	"github.com/evcc-io/evcc/api"
+	"github.com/evcc-io/evcc/util"
 	"github.com/fatih/structs"
 )
 
@@ -37,7 +38,8 @@ func (d *dumper) DumpWithHeader(name string, device interface{}) {
 }
 
 func (d *dumper) Dump(name string, v interface{}) {
-	w := tabwriter.NewWriter(os.Stdout, 0, 0, 1, ' ', 0)
+	// redact resolved secrets from device errors
+	w := tabwriter.NewWriter(new(util.Redactor), 0, 0, 1, ' ', 0)
 
 	// meter
 
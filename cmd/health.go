@@ -3,11 +3,15 @@
 package cmd
 
 import (
+	"encoding/json"
 	"fmt"
 	"net/http"
 	"os"
+	"sort"
+	"text/tabwriter"
 	"time"
 
+	"github.com/evcc-io/evcc/core/metrics"
 	"github.com/evcc-io/evcc/server"
 	"github.com/evcc-io/evcc/util"
 	"github.com/spf13/cobra"
@@ -15,7 +19,12 @@ import (
 	"github.com/tv42/httpunix"
 )
 
-const serviceName = "evcc"
+const (
+	serviceName = "evcc"
+
+	flagDiagnostics            = "diagnostics"
+	flagDiagnosticsDescription = "Show device request statistics"
+)
 
 // healthCmd represents the meter command
 var healthCmd = &cobra.Command{
@@ -26,6 +35,7 @@ var healthCmd = &cobra.Command{
 
 func init() {
 	rootCmd.AddCommand(healthCmd)
+	healthCmd.Flags().BoolP(flagDiagnostics, "d", false, flagDiagnosticsDescription)
 }
 
 func runHealth(cmd *cobra.Command, args []string) {
@@ -56,8 +66,57 @@ func runHealth(cmd *cobra.Command, args []string) {
 		}
 	}
 
+	if ok && cmd.Flags().Lookup(flagDiagnostics).Changed {
+		if err := printDiagnostics(client); err != nil {
+			log.ERROR.Printf("diagnostics: %v", err)
+		}
+	}
+
 	if !ok {
 		log.ERROR.Printf("health check failed")
 		os.Exit(1)
 	}
 }
+
+// printDiagnostics prints the device request statistics, slowest devices first
+func printDiagnostics(client http.Client) error {
+	resp, err := client.Get(fmt.Sprintf("http+unix://%s/diagnostics", serviceName))
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	var res struct {
+		Result []metrics.Stats
+	}
+
+	if err := json.NewDecoder(resp.Body).Decode(&res); err != nil {
+		return err
+	}
+
+	sort.SliceStable(res.Result, func(i, j int) bool {
+		return res.Result[i].Latency.P90 > res.Result[j].Latency.P90
+	})
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "Type\tName\tRequests\tErrors\tp50\tp90\tp99\tLast success\tLast error")
+
+	ms := func(f float64) string {
+		return fmt.Sprintf("%.0fms", f*1e3)
+	}
+
+	for _, s := range res.Result {
+		var success, lastErr string
+		if !s.LastSuccess.IsZero() {
+			success = s.LastSuccess.Format(time.Stamp)
+		}
+		if s.LastError != "" {
+			lastErr = fmt.Sprintf("%s: %s", s.LastErrorTime.Format(time.Stamp), s.LastError)
+		}
+
+		fmt.Fprintf(w, "%s\t%s\t%d\t%d\t%s\t%s\t%s\t%s\t%s\n", s.Type, s.Name, s.Requests, s.Errors,
+			ms(s.Latency.P50), ms(s.Latency.P90), ms(s.Latency.P99), success, lastErr)
+	}
+
+	return w.Flush()
+}
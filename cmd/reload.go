@@ -0,0 +1,378 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"reflect"
+	"sort"
+	"sync"
+
+	"github.com/dustin/go-humanize"
+	"github.com/evcc-io/evcc/api"
+	"github.com/evcc-io/evcc/core"
+	"github.com/evcc-io/evcc/push"
+	"github.com/evcc-io/evcc/tariff"
+	"github.com/evcc-io/evcc/util"
+	"github.com/spf13/viper"
+)
+
+// reloadResult lists the reloaded configuration items and changes that require a restart
+type reloadResult struct {
+	Reloaded []string `json:"reloaded"`
+	Restart  []string `json:"restart,omitempty"`
+}
+
+// reloader applies configuration file changes to the running site.
+// Meters, chargers, vehicles, tariffs and messengers are rebuilt if changed,
+// site and loadpoint changes are reported as requiring a restart.
+type reloader struct {
+	mu    sync.Mutex
+	conf  config
+	cp    *ConfigProvider
+	site  *core.Site
+	hub   *push.Hub
+	cache *util.Cache
+}
+
+// Reload reads the configuration file and applies the changes.
+// Replaced and removed devices and messengers are closed, rebuilt ones are closed if the reload fails.
+func (r *reloader) Reload() (res reloadResult, err error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	res = reloadResult{Reloaded: []string{}}
+
+	if cfgFile == "" {
+		return res, errors.New("missing evcc config")
+	}
+
+	if err := viper.ReadInConfig(); err != nil {
+		return res, err
+	}
+
+	next := config{Network: r.conf.Network}
+	if err := viper.UnmarshalExact(&next, secretsDecodeHook); err != nil {
+		return res, err
+	}
+
+	// rebuilt devices and messengers
+	var created []interface{}
+	defer func() {
+		if err != nil {
+			closeDevices(created)
+		}
+	}()
+
+	meters, changedMeters, err := rebuild("meter", r.cp.meters, r.conf.Meters, next.Meters, newMeter)
+	if err != nil {
+		return res, err
+	}
+	created = append(created, selectDevices(meters, changedMeters)...)
+
+	chargers, changedChargers, err := rebuild("charger", r.cp.chargers, r.conf.Chargers, next.Chargers, newCharger)
+	if err != nil {
+		return res, err
+	}
+	created = append(created, selectDevices(chargers, changedChargers)...)
+
+	vehicles, changedVehicles, err := rebuild("vehicle", r.cp.vehicles, r.conf.Vehicles, next.Vehicles, newVehicle)
+	if err != nil {
+		return res, err
+	}
+	created = append(created, selectDevices(vehicles, changedVehicles)...)
+
+	effective := effectiveConfig(r.conf, next)
+	if problems := checkReferences(effective, meters, chargers, vehicles); len(problems) > 0 {
+		return res, problems[0]
+	}
+
+	var tariffs *tariff.Tariffs
+	if !reflect.DeepEqual(r.conf.Tariffs, next.Tariffs) {
+		t, err := configureTariffs(next.Tariffs)
+		if err != nil {
+			return res, err
+		}
+		tariffs = &t
+	}
+
+	var hub *push.Hub
+	if !reflect.DeepEqual(r.conf.Messaging, next.Messaging) {
+		if hub, err = newMessengerHub(next.Messaging, r.site, r.cache); err != nil {
+			return res, err
+		}
+		created = append(created, hub)
+	}
+
+	devices := core.Devices{
+		Meters:   make(map[api.Meter]api.Meter),
+		Chargers: make(map[api.Charger]api.Charger),
+		Vehicles: make(map[api.Vehicle]api.Vehicle),
+	}
+
+	// devices replaced by rebuilt devices or removed from the configuration
+	replaced := append(removedDevices(r.cp.meters, meters), removedDevices(r.cp.chargers, chargers)...)
+	replaced = append(replaced, removedDevices(r.cp.vehicles, vehicles)...)
+
+	for _, name := range changedMeters {
+		if old, ok := r.cp.meters[name]; ok {
+			devices.Meters[old] = meters[name]
+			replaced = append(replaced, old)
+		}
+	}
+	for _, name := range changedChargers {
+		if old, ok := r.cp.chargers[name]; ok {
+			devices.Chargers[old] = chargers[name]
+			replaced = append(replaced, old)
+		}
+	}
+	for _, name := range changedVehicles {
+		if old, ok := r.cp.vehicles[name]; ok {
+			devices.Vehicles[old] = vehicles[name]
+			replaced = append(replaced, old)
+		}
+
+		if _, ok := vehicles[name].(api.AuthProvider); ok {
+			res.Restart = append(res.Restart, fmt.Sprintf("vehicle %s login", name))
+		}
+	}
+
+	// keep vehicle order of the configuration
+	vehicleList := make([]api.Vehicle, 0, len(next.Vehicles))
+	for _, cc := range next.Vehicles {
+		vehicleList = append(vehicleList, vehicles[cc.Name])
+	}
+
+	if err := r.site.Reload(devices, vehicleList, tariffs); err != nil {
+		return res, err
+	}
+
+	r.cp.meters, r.cp.chargers, r.cp.vehicles = meters, chargers, vehicles
+	closeDevices(replaced)
+
+	for _, name := range changedMeters {
+		res.Reloaded = append(res.Reloaded, "meter "+name)
+	}
+	for _, name := range changedChargers {
+		res.Reloaded = append(res.Reloaded, "charger "+name)
+	}
+	for _, name := range changedVehicles {
+		res.Reloaded = append(res.Reloaded, "vehicle "+name)
+	}
+
+	if tariffs != nil {
+		res.Reloaded = append(res.Reloaded, "tariffs")
+	}
+
+	if hub != nil {
+		// stop receiving commands before the new messengers take over
+		r.hub.Close()
+		r.hub.Update(hub)
+		res.Reloaded = append(res.Reloaded, "messaging")
+	}
+
+	if r.conf.Log != next.Log || !reflect.DeepEqual(r.conf.Levels, next.Levels) {
+		util.LogLevel(viper.GetString("log"), viper.GetStringMapString("levels"))
+		res.Reloaded = append(res.Reloaded, "log")
+	}
+
+	res.Restart = append(res.Restart, restartRequired(r.conf, next)...)
+
+	r.conf = effective
+
+	return res, nil
+}
+
+// selectDevices returns the named devices
+func selectDevices[T any](devs map[string]T, names []string) []interface{} {
+	res := make([]interface{}, 0, len(names))
+	for _, name := range names {
+		res = append(res, devs[name])
+	}
+	return res
+}
+
+// removedDevices returns the running devices missing from the next devices
+func removedDevices[T any](running, next map[string]T) []interface{} {
+	var res []interface{}
+	for name, dev := range running {
+		if _, ok := next[name]; !ok {
+			res = append(res, dev)
+		}
+	}
+	return res
+}
+
+// closeDevices closes devices and messenger hubs that are no longer used
+func closeDevices(devs []interface{}) {
+	for _, dev := range devs {
+		switch dev := dev.(type) {
+		case *push.Hub:
+			dev.Close()
+		default:
+			if c, ok := dev.(io.Closer); ok {
+				if err := c.Close(); err != nil {
+					log.ERROR.Printf("closing device: %v", err)
+				}
+			}
+		}
+	}
+}
+
+// checkReloadable verifies that site and loadpoints are unchanged
+func checkReloadable(conf, next config) error {
+	if !reflect.DeepEqual(conf.Site, next.Site) {
+		return errors.New("site changed, restart required")
+	}
+
+	if len(conf.LoadPoints) != len(next.LoadPoints) {
+		return errors.New("loadpoints changed, restart required")
+	}
+
+	for id := range conf.LoadPoints {
+		if !reflect.DeepEqual(conf.LoadPoints[id], next.LoadPoints[id]) {
+			return fmt.Errorf("loadpoint %d changed, restart required", id+1)
+		}
+	}
+
+	return nil
+}
+
+// effectiveConfig returns the next configuration with site and loadpoints
+// keeping their running configuration until restart if changed
+func effectiveConfig(conf, next config) config {
+	if checkReloadable(conf, next) != nil {
+		next.Site, next.LoadPoints = conf.Site, conf.LoadPoints
+	}
+	return next
+}
+
+// restartRequired returns the changed configuration sections that are not reloaded
+func restartRequired(conf, next config) []string {
+	var res []string
+
+	for name, values := range map[string][2]interface{}{
+		"network":      {conf.Network, next.Network},
+		"auth":         {conf.Auth, next.Auth},
+		"sponsortoken": {conf.SponsorToken, next.SponsorToken},
+		"interval":     {conf.Interval, next.Interval},
+		"mqtt":         {conf.Mqtt, next.Mqtt},
+		"javascript":   {conf.Javascript, next.Javascript},
+		"influx":       {conf.Influx, next.Influx},
+		"history":      {conf.History, next.History},
+		"energy":       {conf.Energy, next.Energy},
+		"eebus":        {conf.EEBus, next.EEBus},
+		"hems":         {conf.HEMS, next.HEMS},
+		"site":         {conf.Site, next.Site},
+		"loadpoints":   {conf.LoadPoints, next.LoadPoints},
+	} {
+		if !reflect.DeepEqual(values[0], values[1]) {
+			res = append(res, name)
+		}
+	}
+
+	sort.Strings(res)
+
+	return res
+}
+
+// rebuild creates the devices whose configuration was added or changed and keeps all other running devices.
+// It returns the resulting devices and the names of the rebuilt devices. Rebuilt devices are closed on error.
+func rebuild[T any](class string, running map[string]T, conf, next []qualifiedConfig, create func(qualifiedConfig) (T, error)) (_ map[string]T, _ []string, err error) {
+	previous := make(map[string]qualifiedConfig, len(conf))
+	for _, cc := range conf {
+		previous[cc.Name] = cc
+	}
+
+	res := make(map[string]T, len(next))
+	var changed []string
+
+	defer func() {
+		if err != nil {
+			closeDevices(selectDevices(res, changed))
+		}
+	}()
+
+	for id, cc := range next {
+		if cc.Name == "" {
+			return nil, nil, fmt.Errorf("cannot create %s %s: missing name", humanize.Ordinal(id+1), class)
+		}
+
+		if _, exists := res[cc.Name]; exists {
+			return nil, nil, fmt.Errorf("duplicate %s name: %s already defined and must be unique", class, cc.Name)
+		}
+
+		if prev, ok := previous[cc.Name]; ok && reflect.DeepEqual(prev, cc) {
+			res[cc.Name] = running[cc.Name]
+			continue
+		}
+
+		dev, err := create(cc)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		res[cc.Name] = dev
+		changed = append(changed, cc.Name)
+	}
+
+	return res, changed, nil
+}
+
+// checkReferences verifies that all devices referenced by site and loadpoints exist
+func checkReferences(conf config, meters map[string]api.Meter, chargers map[string]api.Charger, vehicles map[string]api.Vehicle) []problem {
+	var res []problem
+
+	var site struct {
+		Meters core.MetersConfig
+		Other  map[string]interface{} `mapstructure:",remain"`
+	}
+
+	if err := util.DecodeOther(conf.Site, &site); err != nil {
+		return append(res, problem{Path: "site", Err: err})
+	}
+
+	meterRef := func(path, ref string) {
+		if _, ok := meters[ref]; ref != "" && !ok {
+			res = append(res, problem{Path: path, Err: fmt.Errorf("invalid meter: %s", ref)})
+		}
+	}
+
+	meterRef("site.meters.grid", site.Meters.GridMeterRef)
+	meterRef("site.meters.pv", site.Meters.PVMeterRef)
+	meterRef("site.meters.battery", site.Meters.BatteryMeterRef)
+	for i, ref := range site.Meters.PVMetersRef {
+		meterRef(fmt.Sprintf("site.meters.pvs[%d]", i), ref)
+	}
+	for i, ref := range site.Meters.BatteryMetersRef {
+		meterRef(fmt.Sprintf("site.meters.batteries[%d]", i), ref)
+	}
+
+	for id, lpc := range conf.LoadPoints {
+		path := fmt.Sprintf("loadpoints[%d]", id)
+
+		var lp struct {
+			Charger, Meter, Vehicle string
+			Other                   map[string]interface{} `mapstructure:",remain"`
+		}
+
+		if err := util.DecodeOther(lpc, &lp); err != nil {
+			res = append(res, problem{Path: path, Err: err})
+			continue
+		}
+
+		if lp.Charger == "" {
+			res = append(res, problem{Path: path, Err: errors.New("missing charger")})
+		} else if _, ok := chargers[lp.Charger]; !ok {
+			res = append(res, problem{Path: path + ".charger", Err: fmt.Errorf("invalid charger: %s", lp.Charger)})
+		}
+
+		meterRef(path+".meter", lp.Meter)
+
+		if _, ok := vehicles[lp.Vehicle]; lp.Vehicle != "" && !ok {
+			res = append(res, problem{Path: path + ".vehicle", Err: fmt.Errorf("invalid vehicle: %s", lp.Vehicle)})
+		}
+	}
+
+	return res
+}
@@ -0,0 +1,150 @@
+package cmd
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/evcc-io/evcc/api"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReloadRebuild(t *testing.T) {
+	conf := []qualifiedConfig{
+		{Name: "a", Type: "foo", Other: map[string]interface{}{"power": 1}},
+		{Name: "b", Type: "foo"},
+		{Name: "c", Type: "foo"},
+	}
+	next := []qualifiedConfig{
+		{Name: "a", Type: "foo", Other: map[string]interface{}{"power": 1}},
+		{Name: "b", Type: "bar"},
+		{Name: "d", Type: "foo"},
+	}
+
+	running := map[string]string{"a": "a1", "b": "b1", "c": "c1"}
+
+	var created []string
+	create := func(cc qualifiedConfig) (string, error) {
+		created = append(created, cc.Name)
+		return cc.Name + "2", nil
+	}
+
+	res, changed, err := rebuild("meter", running, conf, next, create)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"a": "a1", "b": "b2", "d": "d2"}, res)
+	assert.Equal(t, []string{"b", "d"}, changed)
+	assert.Equal(t, changed, created)
+
+	_, _, err = rebuild("meter", running, conf, append(next, next[0]), create)
+	assert.EqualError(t, err, "duplicate meter name: a already defined and must be unique")
+
+	_, _, err = rebuild("meter", running, conf, next, func(qualifiedConfig) (string, error) {
+		return "", errors.New("failed")
+	})
+	assert.Error(t, err)
+}
+
+func TestReloadable(t *testing.T) {
+	conf := config{
+		Site:       map[string]interface{}{"title": "home"},
+		LoadPoints: []map[string]interface{}{{"charger": "wallbox"}},
+	}
+
+	assert.NoError(t, checkReloadable(conf, conf))
+
+	next := conf
+	next.Site = map[string]interface{}{"title": "other"}
+	assert.EqualError(t, checkReloadable(conf, next), "site changed, restart required")
+
+	next = conf
+	next.LoadPoints = []map[string]interface{}{{"charger": "other"}}
+	assert.EqualError(t, checkReloadable(conf, next), "loadpoint 1 changed, restart required")
+
+	next = conf
+	next.Mqtt.Topic = "foo"
+	next.Influx.URL = "http://localhost:8086"
+	assert.Equal(t, []string{"influx", "mqtt"}, restartRequired(conf, next))
+
+	next = conf
+	next.LoadPoints = []map[string]interface{}{{"charger": "other"}}
+	assert.Equal(t, []string{"loadpoints"}, restartRequired(conf, next))
+}
+
+func TestReloadReferences(t *testing.T) {
+	conf := config{
+		Site: map[string]interface{}{
+			"title":  "home",
+			"meters": map[string]interface{}{"grid": "grid", "pvs": []string{"pv"}},
+		},
+		LoadPoints: []map[string]interface{}{{"charger": "wallbox", "vehicle": "car", "mode": "pv"}},
+	}
+
+	meters := map[string]api.Meter{"grid": nil, "pv": nil}
+	chargers := map[string]api.Charger{"wallbox": nil}
+	vehicles := map[string]api.Vehicle{"car": nil}
+
+	assert.Empty(t, checkReferences(conf, meters, chargers, vehicles))
+
+	delete(meters, "pv")
+	delete(vehicles, "car")
+
+	problems := checkReferences(conf, meters, chargers, vehicles)
+	require.Len(t, problems, 2)
+	assert.EqualError(t, problems[0], "site.meters.pvs[0]: invalid meter: pv")
+	assert.EqualError(t, problems[1], "loadpoints[0].vehicle: invalid vehicle: car")
+
+	// running loadpoints keep referencing their charger until restart
+	next := conf
+	next.LoadPoints = []map[string]interface{}{{"charger": "other"}}
+	assert.Equal(t, conf.LoadPoints, effectiveConfig(conf, next).LoadPoints)
+
+	delete(chargers, "wallbox")
+	chargers["other"] = nil
+
+	problems = checkReferences(effectiveConfig(conf, next), meters, chargers, vehicles)
+	require.Len(t, problems, 3)
+	assert.EqualError(t, problems[1], "loadpoints[0].charger: invalid charger: wallbox")
+}
+
+type reloadDevice struct {
+	name   string
+	closed bool
+}
+
+func (d *reloadDevice) Close() error {
+	d.closed = true
+	return nil
+}
+
+func TestReloadRebuildClose(t *testing.T) {
+	next := []qualifiedConfig{{Name: "a"}, {Name: "b"}}
+
+	var created []*reloadDevice
+	_, _, err := rebuild("charger", nil, nil, next, func(cc qualifiedConfig) (*reloadDevice, error) {
+		if cc.Name == "b" {
+			return nil, errors.New("failed")
+		}
+		dev := &reloadDevice{name: cc.Name}
+		created = append(created, dev)
+		return dev, nil
+	})
+	require.Error(t, err)
+
+	// devices created before the error are closed
+	require.Len(t, created, 1)
+	assert.True(t, created[0].closed)
+}
+
+func TestReloadRemovedDevices(t *testing.T) {
+	a, b := &reloadDevice{name: "a"}, &reloadDevice{name: "b"}
+
+	running := map[string]*reloadDevice{"a": a, "b": b}
+	next := map[string]*reloadDevice{"a": a}
+
+	removed := removedDevices(running, next)
+	assert.Equal(t, []interface{}{b}, removed)
+
+	closeDevices(removed)
+	assert.True(t, b.closed)
+	assert.False(t, a.closed)
+}
@@ -11,6 +11,7 @@ import (
 	"syscall"
 	"time"
 
+	"github.com/evcc-io/evcc/cmd/configstore"
 	"github.com/evcc-io/evcc/cmd/shutdown"
 	"github.com/evcc-io/evcc/server"
 	"github.com/evcc-io/evcc/server/updater"
@@ -19,6 +20,7 @@ import (
 	"github.com/evcc-io/evcc/util/request"
 	"github.com/evcc-io/evcc/util/sponsor"
 	"github.com/grandcat/zeroconf"
+	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
 
 	"github.com/spf13/cobra"
@@ -188,18 +190,46 @@ func run(cmd *cobra.Command, args []string) {
 
 	// setup database
 	if conf.Influx.URL != "" {
-		configureDatabase(conf.Influx, site.LoadPoints(), tee.Attach())
+		if err := configureDatabase(conf.Influx, site.LoadPoints(), tee.Attach()); err != nil {
+			log.FATAL.Fatal(fmt.Errorf("influx: %w", err))
+		}
 	}
 
 	// setup mqtt publisher
 	if conf.Mqtt.Broker != "" {
 		publisher := server.NewMQTT(conf.Mqtt.RootTopic())
+		if conf.Mqtt.HomeAssistant != "" {
+			publisher = publisher.WithDiscovery(conf.Mqtt.HomeAssistant)
+		}
+		if conf.Mqtt.State {
+			publisher = publisher.WithState(cache)
+		}
+		shutdown.Register(publisher.Shutdown)
 		go publisher.Run(site, pipe.NewDropper(ignoreMqtt...).Pipe(tee.Attach()))
 	}
 
 	// create webserver
+	auth, err := server.NewAuth(conf.Auth)
+	if err != nil {
+		log.FATAL.Fatal(fmt.Errorf("auth: %w", err))
+	}
+
 	socketHub := server.NewSocketHub()
-	httpd := server.NewHTTPd(fmt.Sprintf(":%d", conf.Network.Port), site, socketHub, cache)
+	httpd := server.NewHTTPd(fmt.Sprintf(":%d", conf.Network.Port), site, socketHub, cache, auth)
+
+	// setup history
+	if !conf.History.Disable {
+		if err := configureHistory(conf.History, httpd, tee.Attach()); err != nil {
+			log.FATAL.Fatal(fmt.Errorf("history: %w", err))
+		}
+	}
+
+	// setup energy report
+	if !conf.Energy.Disable {
+		if err := configureEnergy(conf.Energy, site, httpd); err != nil {
+			log.FATAL.Fatal(fmt.Errorf("energy: %w", err))
+		}
+	}
 
 	// announce webserver on mDNS
 	if strings.HasSuffix(conf.Network.Host, ".local") {
@@ -214,6 +244,7 @@ func run(cmd *cobra.Command, args []string) {
 	// metrics
 	if viper.GetBool("metrics") {
 		httpd.Router().Handle("/metrics", promhttp.Handler())
+		go server.NewPrometheus(prometheus.DefaultRegisterer, site.LoadPoints()).Run(tee.Attach())
 	}
 
 	// pprof
@@ -249,7 +280,30 @@ func run(cmd *cobra.Command, args []string) {
 	util.CaptureLogs(valueChan)
 
 	// setup messaging
-	pushChan := configureMessengers(conf.Messaging, cache)
+	pushChan, pushHub := configureMessengers(conf.Messaging, site, cache)
+
+	// configuration reload
+	reload := &reloader{conf: conf, cp: cp, site: site, hub: pushHub, cache: cache}
+
+	httpd.AddRoutes(map[string]server.Route{
+		"reload": {
+			Methods:     []string{http.MethodPost, http.MethodOptions},
+			Pattern:     "/config/reload",
+			HandlerFunc: server.ReloadHandler(reload.Reload),
+			Summary:     "Reload configuration",
+			Result:      reloadResult{},
+		},
+	})
+
+	// configuration editor changes devices and credentials and requires authentication unless explicitly enabled
+	if auth.Enabled() || conf.Auth.ConfigEditor {
+		if cfgFile != "" {
+			editor := &configEditor{store: configstore.New(cfgFile), reload: reload.Reload}
+			httpd.AddRoutes(server.ConfigEditorRoutes(editor))
+		}
+	} else {
+		log.INFO.Println("configuration editor disabled, requires authentication or auth.configEditor")
+	}
 
 	// set channels
 	site.DumpConfig()
@@ -267,6 +321,25 @@ func run(cmd *cobra.Command, args []string) {
 	// uds health check listener
 	go server.HealthListener(site, siteC)
 
+	// reload configuration on SIGHUP
+	go func() {
+		hupC := make(chan os.Signal, 1)
+		signal.Notify(hupC, syscall.SIGHUP)
+
+		for range hupC {
+			res, err := reload.Reload()
+			if err != nil {
+				log.ERROR.Printf("reload: %v", err)
+				continue
+			}
+
+			log.INFO.Printf("reload: reloaded %v", res.Reloaded)
+			if len(res.Restart) > 0 {
+				log.WARN.Printf("reload: restart required for %v", res.Restart)
+			}
+		}
+	}()
+
 	// catch signals
 	go func() {
 		signalC := make(chan os.Signal, 1)
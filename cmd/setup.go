@@ -4,19 +4,23 @@ import (
 	"errors"
 	"fmt"
 	"math/rand"
+	"net/http"
 	"strconv"
+	"strings"
 	"time"
 
 	paho "github.com/eclipse/paho.mqtt.golang"
 	"github.com/evcc-io/evcc/api"
 	"github.com/evcc-io/evcc/cmd/shutdown"
 	"github.com/evcc-io/evcc/core"
+	"github.com/evcc-io/evcc/core/energy"
 	"github.com/evcc-io/evcc/core/loadpoint"
 	"github.com/evcc-io/evcc/hems"
 	"github.com/evcc-io/evcc/provider/javascript"
 	"github.com/evcc-io/evcc/provider/mqtt"
 	"github.com/evcc-io/evcc/push"
 	"github.com/evcc-io/evcc/server"
+	"github.com/evcc-io/evcc/server/history"
 	"github.com/evcc-io/evcc/tariff"
 	"github.com/evcc-io/evcc/util"
 	"github.com/evcc-io/evcc/util/pipe"
@@ -35,7 +39,7 @@ var cp = new(ConfigProvider)
 func loadConfigFile(cfgFile string, conf *config) (err error) {
 	if cfgFile != "" {
 		log.INFO.Println("using config file", cfgFile)
-		if err := viper.UnmarshalExact(&conf); err != nil {
+		if err := viper.UnmarshalExact(&conf, secretsDecodeHook); err != nil {
 			log.FATAL.Fatalf("failed parsing config file %s: %v", cfgFile, err)
 		}
 	} else {
@@ -69,16 +73,74 @@ func configureEnvironment(conf config) (err error) {
 	return
 }
 
+// setup history store
+func configureHistory(conf history.Config, httpd *server.HTTPd, in <-chan util.Param) error {
+	file := conf.File
+	if file == "" {
+		var err error
+		if file, err = history.DefaultFile(); err != nil {
+			return err
+		}
+	}
+
+	store, err := history.New(file)
+	if err != nil {
+		return err
+	}
+
+	httpd.AddRoutes(map[string]server.Route{
+		"getHistory": {
+			Methods:     []string{http.MethodGet},
+			Pattern:     "/history",
+			HandlerFunc: server.HistoryHandler(store),
+			Summary:     "Get recorded values by key",
+			Result:      map[string][]history.Sample{},
+		},
+	})
+	shutdown.Register(store.Shutdown)
+
+	go store.Run(in)
+
+	return nil
+}
+
+// setup energy report
+func configureEnergy(conf energy.Config, site *core.Site, httpd *server.HTTPd) error {
+	file := conf.File
+	if file == "" {
+		var err error
+		if file, err = energy.DefaultFile(); err != nil {
+			return err
+		}
+	}
+
+	report, err := energy.NewReport(file)
+	if err != nil {
+		return err
+	}
+
+	site.SetEnergyReport(report)
+
+	httpd.AddRoutes(map[string]server.Route{
+		"getEnergy": {
+			Methods:     []string{http.MethodGet},
+			Pattern:     "/energy",
+			HandlerFunc: server.EnergyHandler(report),
+			Summary:     "Get energy totals by period",
+			Result:      []energy.Entry{},
+		},
+	})
+	shutdown.Register(report.Shutdown)
+
+	return nil
+}
+
 // setup influx database
-func configureDatabase(conf server.InfluxConfig, loadPoints []loadpoint.API, in <-chan util.Param) {
-	influx := server.NewInfluxClient(
-		conf.URL,
-		conf.Token,
-		conf.Org,
-		conf.User,
-		conf.Password,
-		conf.Database,
-	)
+func configureDatabase(conf server.InfluxConfig, loadPoints []loadpoint.API, in <-chan util.Param) error {
+	influx, err := server.NewInfluxClient(conf)
+	if err != nil {
+		return err
+	}
 
 	// eliminate duplicate values
 	dedupe := pipe.NewDeduplicator(30*time.Minute, "vehicleCapacity", "vehicleSoC", "vehicleRange", "vehicleOdometer", "chargedEnergy", "chargeRemainingEnergy")
@@ -90,6 +152,8 @@ func configureDatabase(conf server.InfluxConfig, loadPoints []loadpoint.API, in
 	in = limiter.Pipe(in)
 
 	go influx.Run(loadPoints, in)
+
+	return nil
 }
 
 // setup mqtt
@@ -122,6 +186,12 @@ func configureHEMS(conf typedConfig, site *core.Site, httpd *server.HTTPd) hems.
 	if err != nil {
 		log.FATAL.Fatalf("failed configuring hems: %v", err)
 	}
+
+	// persist hems state like open transactions
+	if s, ok := hems.(interface{ Shutdown() }); ok {
+		shutdown.Register(s.Shutdown)
+	}
+
 	return hems
 }
 
@@ -139,25 +209,44 @@ func configureEEBus(conf map[string]interface{}) error {
 }
 
 // setup messaging
-func configureMessengers(conf messagingConfig, cache *util.Cache) chan push.Event {
-	notificationChan := make(chan push.Event, 1)
-	notificationHub, err := push.NewHub(conf.Events, cache)
+func configureMessengers(conf messagingConfig, site *core.Site, cache *util.Cache) (chan push.Event, *push.Hub) {
+	notificationChan := make(chan push.Event, 16)
+	notificationHub, err := newMessengerHub(conf, site, cache)
+	if err != nil {
+		log.FATAL.Fatal(err)
+	}
+
+	go notificationHub.Run(notificationChan)
+
+	return notificationChan, notificationHub
+}
+
+// newMessengerHub creates the push hub and its messengers
+func newMessengerHub(conf messagingConfig, site *core.Site, cache *util.Cache) (*push.Hub, error) {
+	notificationHub, err := push.NewHub(conf.Events, conf.Rules, cache)
 	if err != nil {
-		log.FATAL.Fatalf("failed configuring push services: %v", err)
+		return nil, fmt.Errorf("failed configuring push services: %w", err)
 	}
 
 	for _, service := range conf.Services {
 		impl, err := push.NewMessengerFromConfig(service.Type, service.Other)
 		if err != nil {
-			log.FATAL.Fatal(err)
-			log.FATAL.Fatalf("failed configuring messenger %s: %v", service.Type, err)
+			return nil, fmt.Errorf("failed configuring messenger %s: %w", service.Type, err)
+		}
+		if c, ok := impl.(push.Controller); ok {
+			c.Control(site)
 		}
-		notificationHub.Add(impl)
-	}
 
-	go notificationHub.Run(notificationChan)
+		// services are routed by name if given or by type otherwise
+		name := service.Name
+		if name == "" {
+			name = service.Type
+		}
+
+		notificationHub.Add(strings.ToLower(name), impl)
+	}
 
-	return notificationChan
+	return notificationHub, nil
 }
 
 func configureTariffs(conf tariffConfig) (tariff.Tariffs, error) {
@@ -0,0 +1,144 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+
+	"github.com/evcc-io/evcc/cmd/simulate"
+	"github.com/evcc-io/evcc/core"
+	"github.com/evcc-io/evcc/server"
+	"github.com/evcc-io/evcc/tariff"
+	"github.com/evcc-io/evcc/util"
+	"github.com/spf13/cobra"
+	"golang.org/x/text/currency"
+)
+
+// simulateCmd represents the simulate command
+var simulateCmd = &cobra.Command{
+	Use:   "simulate [scenario]",
+	Short: "Simulate site and loadpoints using a scenario file",
+	Long: `Replays a scenario of pv production, home consumption and vehicle sessions
+against the configured site and loadpoints. Meters, chargers and vehicles are simulated,
+all other loadpoint settings like mode and thresholds are taken from the configuration.`,
+	Example: "  evcc simulate --config evcc.yaml scenario.yaml --csv intervals.csv",
+	Args:    cobra.ExactArgs(1),
+	Run:     runSimulate,
+}
+
+func init() {
+	rootCmd.AddCommand(simulateCmd)
+	simulateCmd.Flags().String("csv", "", "Write values of each interval to csv file")
+}
+
+func runSimulate(cmd *cobra.Command, args []string) {
+	// control logic logs would interleave with the results
+	level := "error"
+	if flag := rootCmd.PersistentFlags().Lookup("log"); flag.Changed {
+		level = flag.Value.String()
+	}
+	util.LogLevel(level, nil)
+	log.INFO.Printf("evcc %s", server.FormattedVersion())
+
+	// load config
+	if err := loadConfigFile(cfgFile, &conf); err != nil {
+		log.FATAL.Fatal(err)
+	}
+
+	f, err := os.Open(args[0])
+	if err != nil {
+		log.FATAL.Fatal(err)
+	}
+
+	scenario, err := simulate.ParseScenario(f)
+	f.Close()
+	if err != nil {
+		log.FATAL.Fatalf("%s: %v", args[0], err)
+	}
+
+	sim := simulate.New(scenario)
+
+	site, loadPoints, err := configureSimulation(conf, sim, scenario.Tariffs)
+	if err != nil {
+		log.FATAL.Fatal(err)
+	}
+
+	site.Simulate(sim.Clock())
+
+	res, err := sim.Run(site, loadPoints)
+	if err != nil {
+		log.FATAL.Fatal(err)
+	}
+
+	if err := res.WriteTimeline(os.Stdout); err != nil {
+		log.FATAL.Fatal(err)
+	}
+
+	fmt.Println()
+
+	if err := res.WriteSummary(os.Stdout); err != nil {
+		log.FATAL.Fatal(err)
+	}
+
+	if file, _ := cmd.Flags().GetString("csv"); file != "" {
+		f, err := os.Create(file)
+		if err == nil {
+			err = res.WriteCSV(f)
+			if cerr := f.Close(); err == nil {
+				err = cerr
+			}
+		}
+
+		if err != nil {
+			log.FATAL.Fatal(err)
+		}
+	}
+}
+
+// configureSimulation creates site and loadpoints from the configuration using the simulated devices
+func configureSimulation(conf config, sim *simulate.Simulator, prices simulate.Tariffs) (*core.Site, []*core.LoadPoint, error) {
+	var loadPoints []*core.LoadPoint
+
+	for id, other := range conf.LoadPoints {
+		lpc := make(map[string]interface{}, len(other))
+		for k, v := range other {
+			lpc[k] = v
+		}
+
+		delete(lpc, "meter")
+		delete(lpc, "vehicles")
+		lpc["charger"] = simulate.ChargerName(id)
+		lpc["vehicle"] = simulate.VehicleName(id)
+
+		log := util.NewLogger("lp-" + strconv.Itoa(id+1))
+		lp, err := core.NewLoadPointFromConfig(log, sim, lpc)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed configuring loadpoint: %w", err)
+		}
+
+		loadPoints = append(loadPoints, lp)
+	}
+
+	if len(loadPoints) == 0 {
+		return nil, nil, fmt.Errorf("missing loadpoints")
+	}
+
+	sc := make(map[string]interface{}, len(conf.Site))
+	for k, v := range conf.Site {
+		sc[k] = v
+	}
+
+	sc["meters"] = map[string]interface{}{
+		"grid": simulate.GridMeter,
+		"pv":   simulate.PVMeter,
+	}
+
+	tariffs := tariff.NewTariffs(currency.EUR, &tariff.Fixed{Price: prices.Grid}, &tariff.Fixed{Price: prices.FeedIn})
+
+	site, err := core.NewSiteFromConfig(log, sim, sc, loadPoints, sim.Vehicles(), *tariffs)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed configuring site: %w", err)
+	}
+
+	return site, loadPoints, nil
+}
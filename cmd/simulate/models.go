@@ -0,0 +1,206 @@
+package simulate
+
+import (
+	"errors"
+	"math"
+	"time"
+
+	"github.com/evcc-io/evcc/api"
+	"github.com/evcc-io/evcc/core"
+)
+
+// Vehicle is a simulated vehicle
+type Vehicle struct {
+	title    string
+	capacity float64 // kWh
+	phases   int
+	soc      float64
+}
+
+// NewVehicle creates a simulated vehicle
+func NewVehicle(conf VehicleConfig) *Vehicle {
+	return &Vehicle{
+		title:    conf.Title,
+		capacity: conf.Capacity,
+		phases:   conf.Phases,
+	}
+}
+
+var _ api.Vehicle = (*Vehicle)(nil)
+
+// Title implements the api.Vehicle interface
+func (v *Vehicle) Title() string {
+	return v.title
+}
+
+// Capacity implements the api.Vehicle interface
+func (v *Vehicle) Capacity() int64 {
+	return int64(math.Round(v.capacity))
+}
+
+// Phases implements the api.Vehicle interface
+func (v *Vehicle) Phases() int {
+	return v.phases
+}
+
+// Identifiers implements the api.Vehicle interface
+func (v *Vehicle) Identifiers() []string {
+	return nil
+}
+
+// OnIdentified implements the api.Vehicle interface
+func (v *Vehicle) OnIdentified() api.ActionConfig {
+	return api.ActionConfig{}
+}
+
+// SoC implements the api.Battery interface
+func (v *Vehicle) SoC() (float64, error) {
+	return v.soc, nil
+}
+
+// Charger is a simulated charger with integrated meter
+type Charger struct {
+	enabled  bool
+	current  int64
+	phases   int
+	vehicle  *Vehicle      // connected vehicle
+	energy   float64       // kWh charged in current session
+	duration time.Duration // charging time in current session
+}
+
+// NewCharger creates a simulated charger, switchable chargers support 1p/3p phase switching
+func NewCharger(conf ChargerConfig) api.Charger {
+	c := &Charger{phases: 3}
+
+	if conf.Phases1p3p {
+		return &SwitchableCharger{c}
+	}
+
+	return c
+}
+
+var _ api.Charger = (*Charger)(nil)
+
+// Status implements the api.Charger interface
+func (c *Charger) Status() (api.ChargeStatus, error) {
+	switch {
+	case c.vehicle == nil:
+		return api.StatusA, nil
+	case c.enabled && c.current > 0 && c.vehicle.soc < 100:
+		return api.StatusC, nil
+	default:
+		return api.StatusB, nil
+	}
+}
+
+// Enabled implements the api.Charger interface
+func (c *Charger) Enabled() (bool, error) {
+	return c.enabled, nil
+}
+
+// Enable implements the api.Charger interface
+func (c *Charger) Enable(enable bool) error {
+	c.enabled = enable
+	return nil
+}
+
+// MaxCurrent implements the api.Charger interface
+func (c *Charger) MaxCurrent(current int64) error {
+	c.current = current
+	return nil
+}
+
+var _ api.Meter = (*Charger)(nil)
+
+// CurrentPower implements the api.Meter interface
+func (c *Charger) CurrentPower() (float64, error) {
+	return c.power(), nil
+}
+
+var _ api.ChargeRater = (*Charger)(nil)
+
+// ChargedEnergy implements the api.ChargeRater interface
+func (c *Charger) ChargedEnergy() (float64, error) {
+	return c.energy, nil
+}
+
+var _ api.ChargeTimer = (*Charger)(nil)
+
+// ChargingTime implements the api.ChargeTimer interface
+func (c *Charger) ChargingTime() (time.Duration, error) {
+	return c.duration, nil
+}
+
+// power returns the charge power using the phases of charger and vehicle
+func (c *Charger) power() float64 {
+	if status, _ := c.Status(); status != api.StatusC {
+		return 0
+	}
+
+	phases := c.phases
+	if c.vehicle.phases < phases {
+		phases = c.vehicle.phases
+	}
+
+	return float64(c.current) * float64(phases) * core.Voltage
+}
+
+// connect attaches the vehicle and starts a new session
+func (c *Charger) connect(v *Vehicle) {
+	c.vehicle = v
+	c.energy = 0
+	c.duration = 0
+}
+
+// disconnect detaches the vehicle
+func (c *Charger) disconnect() {
+	c.vehicle = nil
+}
+
+// charge transfers the charge power for duration d to the vehicle and returns the energy in kWh
+func (c *Charger) charge(d time.Duration) float64 {
+	power := c.power()
+	if power == 0 {
+		return 0
+	}
+
+	energy := power * d.Hours() / 1e3
+
+	// vehicle stops charging when full
+	if remaining := (100 - c.vehicle.soc) / 100 * c.vehicle.capacity; energy > remaining {
+		energy = remaining
+	}
+
+	c.vehicle.soc = math.Min(100, c.vehicle.soc+energy/c.vehicle.capacity*100)
+	c.energy += energy
+	c.duration += d
+
+	return energy
+}
+
+// SwitchableCharger is a simulated charger supporting phase switching
+type SwitchableCharger struct {
+	*Charger
+}
+
+var _ api.PhaseSwitcher = (*SwitchableCharger)(nil)
+
+// Phases1p3p implements the api.PhaseSwitcher interface
+func (c *SwitchableCharger) Phases1p3p(phases int) error {
+	if phases != 1 && phases != 3 {
+		return errors.New("invalid phases")
+	}
+
+	c.phases = phases
+	return nil
+}
+
+// Meter is a simulated meter
+type Meter func() float64
+
+var _ api.Meter = (Meter)(nil)
+
+// CurrentPower implements the api.Meter interface
+func (m Meter) CurrentPower() (float64, error) {
+	return m(), nil
+}
@@ -0,0 +1,150 @@
+package simulate
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"strconv"
+	"text/tabwriter"
+	"time"
+)
+
+// Result is the outcome of a simulation
+type Result struct {
+	Tariffs Tariffs
+	Events  []Event
+	Steps   []Step
+	Totals  Totals
+}
+
+// Event is a vehicle or control decision of a loadpoint
+type Event struct {
+	Time      time.Time
+	LoadPoint int
+	Message   string
+}
+
+// Step are the site and loadpoint values of a simulation interval
+type Step struct {
+	Time           time.Time
+	PV, Home, Grid float64 // W
+	LoadPoints     []LoadPointStep
+}
+
+// LoadPointStep are the loadpoint values of a simulation interval
+type LoadPointStep struct {
+	Power   float64 // W
+	Current int64
+	Phases  int
+	SoC     float64
+}
+
+// Totals is the energy balance in kWh
+type Totals struct {
+	PV, Home, Charged float64
+	Import, Export    float64
+	SelfConsumed      float64
+	LoadPoints        []LoadPointTotals
+}
+
+// LoadPointTotals is the charged energy in kWh and its grid cost
+type LoadPointTotals struct {
+	Charged, Solar float64
+	Cost           float64
+	SoC            float64 // final vehicle soc
+}
+
+// Cost returns the grid cost minus the feed-in compensation
+func (r Result) Cost() float64 {
+	return r.Totals.Import*r.Tariffs.Grid - r.Totals.Export*r.Tariffs.FeedIn
+}
+
+func (r *Result) event(ts time.Time, id int, format string, args ...interface{}) {
+	r.Events = append(r.Events, Event{
+		Time:      ts,
+		LoadPoint: id,
+		Message:   fmt.Sprintf(format, args...),
+	})
+}
+
+func percent(val, total float64) float64 {
+	if total == 0 {
+		return 0
+	}
+	return 100 * val / total
+}
+
+// WriteTimeline writes the events
+func (r Result) WriteTimeline(w io.Writer) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+
+	for _, ev := range r.Events {
+		fmt.Fprintf(tw, "%s\tlp-%d\t%s\n", ev.Time.Format("2006-01-02 15:04:05"), ev.LoadPoint+1, ev.Message)
+	}
+
+	return tw.Flush()
+}
+
+// WriteSummary writes energy split and cost of site and loadpoints
+func (r Result) WriteSummary(w io.Writer) error {
+	t := r.Totals
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', tabwriter.AlignRight)
+
+	fmt.Fprintf(tw, "PV:\t%.1f\tkWh\t\n", t.PV)
+	fmt.Fprintf(tw, "Home:\t%.1f\tkWh\t\n", t.Home)
+	fmt.Fprintf(tw, "Charged:\t%.1f\tkWh\t\n", t.Charged)
+	fmt.Fprintf(tw, "Grid import:\t%.1f\tkWh\t\n", t.Import)
+	fmt.Fprintf(tw, "Grid export:\t%.1f\tkWh\t\n", t.Export)
+	fmt.Fprintf(tw, "Self-consumption:\t%.0f\t%%\t\n", percent(t.SelfConsumed, t.PV))
+	fmt.Fprintf(tw, "Self-sufficiency:\t%.0f\t%%\t\n", percent(t.SelfConsumed, t.Home+t.Charged))
+	fmt.Fprintf(tw, "Cost:\t%.2f\t\t\n", r.Cost())
+	fmt.Fprintln(tw)
+
+	fmt.Fprintln(tw, "Loadpoint\tCharged kWh\tSolar %\tCost\tSoC %\t")
+	for id, lp := range t.LoadPoints {
+		fmt.Fprintf(tw, "lp-%d\t%.1f\t%.0f\t%.2f\t%.0f\t\n", id+1, lp.Charged, percent(lp.Solar, lp.Charged), lp.Cost, lp.SoC)
+	}
+
+	return tw.Flush()
+}
+
+// WriteCSV writes the values of each simulation interval
+func (r Result) WriteCSV(w io.Writer) error {
+	cw := csv.NewWriter(w)
+
+	header := []string{"time", "pv", "home", "grid"}
+	for id := range r.Totals.LoadPoints {
+		for _, col := range []string{"power", "current", "phases", "soc"} {
+			header = append(header, fmt.Sprintf("lp%d_%s", id+1, col))
+		}
+	}
+
+	if err := cw.Write(header); err != nil {
+		return err
+	}
+
+	format := func(f float64) string {
+		return strconv.FormatFloat(f, 'f', 0, 64)
+	}
+
+	for _, step := range r.Steps {
+		row := []string{step.Time.Format(time.RFC3339), format(step.PV), format(step.Home), format(step.Grid)}
+
+		for _, lp := range step.LoadPoints {
+			row = append(row,
+				format(lp.Power),
+				strconv.FormatInt(lp.Current, 10),
+				strconv.Itoa(lp.Phases),
+				strconv.FormatFloat(lp.SoC, 'f', 1, 64),
+			)
+		}
+
+		if err := cw.Write(row); err != nil {
+			return err
+		}
+	}
+
+	cw.Flush()
+
+	return cw.Error()
+}
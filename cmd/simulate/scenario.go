@@ -0,0 +1,144 @@
+package simulate
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Scenario describes the simulated site conditions and vehicle sessions.
+// Times are given as offsets from start, e.g. 7h30m.
+type Scenario struct {
+	Start      time.Time           `yaml:"start"`    // default: today 00:00 local time
+	Duration   time.Duration       `yaml:"duration"` // default: 24h
+	Interval   time.Duration       `yaml:"interval"` // default: 30s
+	Tariffs    Tariffs             `yaml:"tariffs"`
+	Series     []Conditions        `yaml:"series"`
+	LoadPoints []LoadPointScenario `yaml:"loadpoints"` // by loadpoint in configuration order
+}
+
+// Tariffs are the energy prices per kWh
+type Tariffs struct {
+	Grid   float64 `yaml:"grid"`
+	FeedIn float64 `yaml:"feedin"`
+}
+
+// Conditions are the site conditions from offset At until the next entry
+type Conditions struct {
+	At   time.Duration `yaml:"at"`
+	PV   float64       `yaml:"pv"`   // W
+	Home float64       `yaml:"home"` // W
+}
+
+// LoadPointScenario describes charger and vehicle of a loadpoint
+type LoadPointScenario struct {
+	Charger  ChargerConfig `yaml:"charger"`
+	Vehicle  VehicleConfig `yaml:"vehicle"`
+	Sessions []Session     `yaml:"sessions"`
+}
+
+// ChargerConfig describes the simulated charger
+type ChargerConfig struct {
+	Phases1p3p bool `yaml:"phases1p3p"` // charger supports phase switching
+}
+
+// VehicleConfig describes the simulated vehicle
+type VehicleConfig struct {
+	Title    string  `yaml:"title"`
+	Capacity float64 `yaml:"capacity"` // kWh
+	Phases   int     `yaml:"phases"`   // default: 3
+}
+
+// Session is the time a vehicle is connected
+type Session struct {
+	Connect    time.Duration `yaml:"connect"`
+	Disconnect time.Duration `yaml:"disconnect"`
+	SoC        float64       `yaml:"soc"` // % at connect
+}
+
+// ParseScenario reads the scenario and applies defaults
+func ParseScenario(r io.Reader) (Scenario, error) {
+	var res Scenario
+
+	b, err := io.ReadAll(r)
+	if err != nil {
+		return res, err
+	}
+
+	dec := yaml.NewDecoder(bytes.NewReader(b))
+	dec.KnownFields(true)
+
+	if err := dec.Decode(&res); err != nil && !errors.Is(err, io.EOF) {
+		return res, err
+	}
+
+	if res.Start.IsZero() {
+		y, m, d := time.Now().Date()
+		res.Start = time.Date(y, m, d, 0, 0, 0, 0, time.Local)
+	}
+
+	if res.Duration == 0 {
+		res.Duration = 24 * time.Hour
+	}
+
+	if res.Interval == 0 {
+		res.Interval = 30 * time.Second
+	}
+
+	if res.Interval < 0 || res.Duration < 0 {
+		return res, errors.New("duration and interval must be positive")
+	}
+
+	sort.SliceStable(res.Series, func(i, j int) bool {
+		return res.Series[i].At < res.Series[j].At
+	})
+
+	for i, lp := range res.LoadPoints {
+		if lp.Vehicle.Title == "" {
+			res.LoadPoints[i].Vehicle.Title = fmt.Sprintf("vehicle %d", i+1)
+		}
+
+		if lp.Vehicle.Capacity <= 0 {
+			return res, fmt.Errorf("loadpoints[%d].vehicle: missing capacity", i)
+		}
+
+		if lp.Vehicle.Phases == 0 {
+			res.LoadPoints[i].Vehicle.Phases = 3
+		}
+
+		for j, s := range lp.Sessions {
+			if s.Disconnect != 0 && s.Disconnect <= s.Connect {
+				return res, fmt.Errorf("loadpoints[%d].sessions[%d]: disconnect must be after connect", i, j)
+			}
+		}
+	}
+
+	return res, nil
+}
+
+// conditions returns the site conditions at offset
+func (s Scenario) conditions(offset time.Duration) Conditions {
+	var res Conditions
+	for _, c := range s.Series {
+		if c.At > offset {
+			break
+		}
+		res = c
+	}
+	return res
+}
+
+// session returns the index of the vehicle session at offset or -1
+func (lp LoadPointScenario) session(offset time.Duration) int {
+	for i, s := range lp.Sessions {
+		if s.Connect <= offset && (s.Disconnect == 0 || offset < s.Disconnect) {
+			return i
+		}
+	}
+	return -1
+}
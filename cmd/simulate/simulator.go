@@ -0,0 +1,300 @@
+package simulate
+
+import (
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/benbjohnson/clock"
+	"github.com/evcc-io/evcc/api"
+	"github.com/evcc-io/evcc/core"
+)
+
+// names of the simulated site meters
+const (
+	GridMeter = "grid"
+	PVMeter   = "pv"
+)
+
+// ChargerName returns the name of the simulated charger of loadpoint id
+func ChargerName(id int) string {
+	return fmt.Sprintf("charger-%d", id+1)
+}
+
+// VehicleName returns the name of the simulated vehicle of loadpoint id
+func VehicleName(id int) string {
+	return fmt.Sprintf("vehicle-%d", id+1)
+}
+
+// Simulator replays a scenario with simulated meters, chargers and vehicles
+type Simulator struct {
+	scenario Scenario
+	clock    *clock.Mock
+	pv, home float64
+	chargers []api.Charger
+	vehicles []*Vehicle
+	sessions []int // active session index by loadpoint, -1 if disconnected
+}
+
+// New creates a simulator for the scenario
+func New(scenario Scenario) *Simulator {
+	s := &Simulator{
+		scenario: scenario,
+		clock:    clock.NewMock(),
+	}
+
+	s.clock.Set(scenario.Start)
+
+	for _, lp := range scenario.LoadPoints {
+		s.chargers = append(s.chargers, NewCharger(lp.Charger))
+		s.vehicles = append(s.vehicles, NewVehicle(lp.Vehicle))
+		s.sessions = append(s.sessions, -1)
+	}
+
+	return s
+}
+
+// Clock returns the simulated clock
+func (s *Simulator) Clock() clock.Clock {
+	return s.clock
+}
+
+// Meter returns the simulated grid or pv meter
+func (s *Simulator) Meter(name string) api.Meter {
+	switch name {
+	case GridMeter:
+		return Meter(func() float64 {
+			return s.home + s.chargePower() - s.pv
+		})
+	case PVMeter:
+		return Meter(func() float64 {
+			return s.pv
+		})
+	default:
+		panic("invalid meter: " + name)
+	}
+}
+
+// Charger returns the simulated charger by name
+func (s *Simulator) Charger(name string) api.Charger {
+	for id, c := range s.chargers {
+		if ChargerName(id) == name {
+			return c
+		}
+	}
+	panic("invalid charger: " + name)
+}
+
+// Vehicle returns the simulated vehicle by name
+func (s *Simulator) Vehicle(name string) api.Vehicle {
+	for id, v := range s.vehicles {
+		if VehicleName(id) == name {
+			return v
+		}
+	}
+	panic("invalid vehicle: " + name)
+}
+
+// Vehicles returns the simulated vehicles
+func (s *Simulator) Vehicles() []api.Vehicle {
+	res := make([]api.Vehicle, 0, len(s.vehicles))
+	for _, v := range s.vehicles {
+		res = append(res, v)
+	}
+	return res
+}
+
+// charger returns the simulated charger of loadpoint id
+func (s *Simulator) charger(id int) *Charger {
+	switch c := s.chargers[id].(type) {
+	case *SwitchableCharger:
+		return c.Charger
+	default:
+		return c.(*Charger)
+	}
+}
+
+func (s *Simulator) chargePower() float64 {
+	var res float64
+	for id := range s.chargers {
+		res += s.charger(id).power()
+	}
+	return res
+}
+
+// state is the charger state relevant for control decisions
+type state struct {
+	enabled bool
+	current int64
+	phases  int
+	status  api.ChargeStatus
+}
+
+func (s *Simulator) state(id int) state {
+	c := s.charger(id)
+	status, _ := c.Status()
+
+	return state{
+		enabled: c.enabled,
+		current: c.current,
+		phases:  c.phases,
+		status:  status,
+	}
+}
+
+// Run replays the scenario. Like Site.Run, one loadpoint is updated per interval.
+// The site must have been prepared for simulation using the simulator's clock.
+func (s *Simulator) Run(site *core.Site, loadpoints []*core.LoadPoint) (Result, error) {
+	if len(loadpoints) > len(s.chargers) {
+		return Result{}, fmt.Errorf("scenario defines %d of %d loadpoints", len(s.chargers), len(loadpoints))
+	}
+
+	// chargers without phase switching use the configured phases
+	for id, lp := range loadpoints {
+		if _, ok := s.chargers[id].(api.PhaseSwitcher); !ok {
+			s.charger(id).phases = lp.GetPhases()
+		}
+	}
+
+	res := Result{
+		Tariffs: s.scenario.Tariffs,
+		Totals:  Totals{LoadPoints: make([]LoadPointTotals, len(loadpoints))},
+	}
+
+	var next int
+	for offset := time.Duration(0); offset < s.scenario.Duration; offset += s.scenario.Interval {
+		c := s.scenario.conditions(offset)
+		s.pv, s.home = c.PV, c.Home
+
+		for id := range loadpoints {
+			s.plug(id, offset, &res)
+		}
+
+		before := make([]state, len(loadpoints))
+		for id := range loadpoints {
+			before[id] = s.state(id)
+		}
+
+		site.Step(loadpoints[next])
+		next = (next + 1) % len(loadpoints)
+
+		for id := range loadpoints {
+			s.decisions(id, before[id], &res)
+		}
+
+		s.integrate(&res)
+
+		s.clock.Add(s.scenario.Interval)
+	}
+
+	for id := range loadpoints {
+		res.Totals.LoadPoints[id].SoC = s.vehicles[id].soc
+	}
+
+	return res, nil
+}
+
+// plug connects and disconnects the vehicle according to the loadpoint's sessions
+func (s *Simulator) plug(id int, offset time.Duration, res *Result) {
+	session := s.scenario.LoadPoints[id].session(offset)
+	if session == s.sessions[id] {
+		return
+	}
+
+	c, v := s.charger(id), s.vehicles[id]
+
+	if s.sessions[id] >= 0 {
+		res.event(s.clock.Now(), id, "vehicle disconnected (soc %.0f%%, charged %.1fkWh)", v.soc, c.energy)
+		c.disconnect()
+	}
+
+	if session >= 0 {
+		v.soc = s.scenario.LoadPoints[id].Sessions[session].SoC
+		c.connect(v)
+		res.event(s.clock.Now(), id, "vehicle connected (%s, soc %.0f%%)", v.title, v.soc)
+	}
+
+	s.sessions[id] = session
+}
+
+// decisions records the changes of the charger state by the loadpoint
+func (s *Simulator) decisions(id int, before state, res *Result) {
+	after := s.state(id)
+	ts := s.clock.Now()
+
+	enabled := !before.enabled && after.enabled
+
+	switch {
+	case enabled:
+		res.event(ts, id, "charging enabled (%dA, %dp)", after.current, after.phases)
+	case before.enabled && !after.enabled:
+		res.event(ts, id, "charging disabled")
+	case after.enabled && before.current != after.current:
+		res.event(ts, id, "current %dA", after.current)
+	}
+
+	if before.phases != after.phases && !enabled {
+		res.event(ts, id, "switched to %dp", after.phases)
+	}
+
+	if after.status == api.StatusB && after.enabled && s.vehicles[id].soc >= 100 && before.status == api.StatusC {
+		res.event(ts, id, "vehicle full")
+	}
+}
+
+// integrate charges the vehicles and accounts the energy flows of the interval
+func (s *Simulator) integrate(res *Result) {
+	hours := s.scenario.Interval.Hours()
+
+	step := Step{
+		Time: s.clock.Now(),
+		PV:   s.pv,
+		Home: s.home,
+		Grid: s.home + s.chargePower() - s.pv,
+	}
+
+	charged := make([]float64, len(res.Totals.LoadPoints))
+	var chargeEnergy float64
+
+	for id := range charged {
+		c := s.charger(id)
+
+		step.LoadPoints = append(step.LoadPoints, LoadPointStep{
+			Power:   c.power(),
+			Current: c.current,
+			Phases:  c.phases,
+			SoC:     s.vehicles[id].soc,
+		})
+
+		charged[id] = c.charge(s.scenario.Interval)
+		chargeEnergy += charged[id]
+	}
+
+	res.Steps = append(res.Steps, step)
+
+	pv := s.pv * hours / 1e3
+	home := s.home * hours / 1e3
+	load := home + chargeEnergy
+	self := math.Min(pv, load)
+
+	t := &res.Totals
+	t.PV += pv
+	t.Home += home
+	t.Charged += chargeEnergy
+	t.SelfConsumed += self
+	t.Import += load - self
+	t.Export += pv - self
+
+	// solar share of the interval's consumption
+	var share float64
+	if load > 0 {
+		share = self / load
+	}
+
+	for id, energy := range charged {
+		lp := &t.LoadPoints[id]
+		lp.Charged += energy
+		lp.Solar += energy * share
+		lp.Cost += energy * (1 - share) * s.scenario.Tariffs.Grid
+	}
+}
@@ -0,0 +1,109 @@
+package simulate
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/evcc-io/evcc/core"
+	"github.com/evcc-io/evcc/tariff"
+	"github.com/evcc-io/evcc/util"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const scenario = `
+start: 2022-06-01T00:00:00Z
+duration: 12h
+tariffs:
+  grid: 0.3
+  feedin: 0.1
+series:
+- at: 0s
+  home: 500
+- at: 6h
+  pv: 8000
+  home: 500
+loadpoints:
+- vehicle:
+    capacity: 50
+  sessions:
+  - connect: 2h
+    disconnect: 10h
+    soc: 50
+`
+
+func TestParseScenario(t *testing.T) {
+	sc, err := ParseScenario(strings.NewReader(scenario))
+	require.NoError(t, err)
+
+	assert.Equal(t, 30*time.Second, sc.Interval)
+	assert.Equal(t, "vehicle 1", sc.LoadPoints[0].Vehicle.Title)
+	assert.Equal(t, 3, sc.LoadPoints[0].Vehicle.Phases)
+
+	assert.Equal(t, 0.0, sc.conditions(time.Hour).PV)
+	assert.Equal(t, 8000.0, sc.conditions(7*time.Hour).PV)
+
+	assert.Equal(t, -1, sc.LoadPoints[0].session(time.Hour))
+	assert.Equal(t, 0, sc.LoadPoints[0].session(2*time.Hour))
+	assert.Equal(t, -1, sc.LoadPoints[0].session(10*time.Hour))
+
+	_, err = ParseScenario(strings.NewReader("foo: bar"))
+	assert.Error(t, err)
+
+	_, err = ParseScenario(strings.NewReader("loadpoints:\n- sessions: []"))
+	assert.Error(t, err, "missing capacity")
+}
+
+func TestSimulatePV(t *testing.T) {
+	sc, err := ParseScenario(strings.NewReader(scenario))
+	require.NoError(t, err)
+
+	sim := New(sc)
+
+	lp, err := core.NewLoadPointFromConfig(util.NewLogger("lp-1"), sim, map[string]interface{}{
+		"charger":    ChargerName(0),
+		"vehicle":    VehicleName(0),
+		"mode":       "pv",
+		"phases":     3,
+		"mincurrent": 6,
+		"maxcurrent": 16,
+	})
+	require.NoError(t, err)
+
+	site, err := core.NewSiteFromConfig(util.NewLogger("site"), sim, map[string]interface{}{
+		"meters": map[string]interface{}{"grid": GridMeter, "pv": PVMeter},
+	}, []*core.LoadPoint{lp}, sim.Vehicles(), tariff.Tariffs{})
+	require.NoError(t, err)
+
+	site.Simulate(sim.Clock())
+
+	res, err := sim.Run(site, []*core.LoadPoint{lp})
+	require.NoError(t, err)
+
+	require.Len(t, res.Steps, 12*60*2)
+	require.GreaterOrEqual(t, len(res.Events), 2)
+	assert.Equal(t, sc.Start.Add(2*time.Hour), res.Events[0].Time)
+	assert.Contains(t, res.Events[0].Message, "vehicle connected")
+
+	// charging starts with pv surplus only
+	var enabled *Event
+	for i, ev := range res.Events {
+		if strings.HasPrefix(ev.Message, "charging enabled") {
+			enabled = &res.Events[i]
+			break
+		}
+	}
+	require.NotNil(t, enabled)
+	assert.False(t, enabled.Time.Before(sc.Start.Add(6*time.Hour)))
+
+	lpt := res.Totals.LoadPoints[0]
+	assert.Greater(t, lpt.Charged, 0.0)
+	assert.InDelta(t, lpt.Charged, res.Totals.Charged, 1e-6)
+	assert.Greater(t, lpt.SoC, 50.0)
+	assert.InDelta(t, 50+lpt.Charged/50*100, lpt.SoC, 1e-6)
+
+	// night consumption is imported
+	assert.InDelta(t, 3.0, res.Totals.Import, 0.1)
+	assert.InDelta(t, res.Totals.Import*0.3-res.Totals.Export*0.1, res.Cost(), 1e-6)
+}
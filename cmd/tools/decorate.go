@@ -24,6 +24,49 @@ type dynamicType struct {
 
 type typeStruct struct {
 	Type, ShortType, Signature, Function, VarName string
+	Params, Args, Return                          string
+}
+
+// parseSignature splits a function signature like func(int) (error) into
+// function parameters with names, call arguments and return types
+func parseSignature(signature string) (params, args, ret string, err error) {
+	sig := strings.TrimSpace(strings.TrimPrefix(signature, "func"))
+	if !strings.HasPrefix(sig, "(") {
+		return "", "", "", fmt.Errorf("invalid signature: %s", signature)
+	}
+
+	end, depth := -1, 0
+	for i, c := range sig {
+		if c == '(' {
+			depth++
+		} else if c == ')' {
+			if depth--; depth == 0 {
+				end = i
+				break
+			}
+		}
+	}
+
+	if end < 0 {
+		return "", "", "", fmt.Errorf("invalid signature: %s", signature)
+	}
+
+	if types := strings.TrimSpace(sig[1:end]); types != "" {
+		var p, a []string
+		for i, typ := range strings.Split(types, ",") {
+			name := fmt.Sprintf("p%d", i)
+			p = append(p, name+" "+strings.TrimSpace(typ))
+			a = append(a, name)
+		}
+		params, args = strings.Join(p, ", "), strings.Join(a, ", ")
+	}
+
+	ret = strings.TrimSpace(sig[end+1:])
+	if strings.HasPrefix(ret, "(") && strings.HasSuffix(ret, ")") && !strings.Contains(ret, ",") {
+		ret = strings.TrimSpace(ret[1 : len(ret)-1])
+	}
+
+	return params, args, ret, nil
 }
 
 func generate(out io.Writer, packageName, functionName, baseType string, dynamicTypes ...dynamicType) error {
@@ -70,8 +113,15 @@ func generate(out io.Writer, packageName, functionName, baseType string, dynamic
 		return err
 	}
 
+	var usesTime bool
 	for _, dt := range dynamicTypes {
 		parts := strings.SplitN(dt.typ, ".", 2)
+		usesTime = usesTime || strings.Contains(dt.signature, "time.")
+
+		params, args, ret, err := parseSignature(dt.signature)
+		if err != nil {
+			return err
+		}
 
 		types[dt.typ] = typeStruct{
 			Type:      dt.typ,
@@ -79,6 +129,9 @@ func generate(out io.Writer, packageName, functionName, baseType string, dynamic
 			VarName:   strings.ToLower(parts[1][:1]) + parts[1][1:],
 			Signature: dt.signature,
 			Function:  dt.function,
+			Params:    params,
+			Args:      args,
+			Return:    ret,
 		}
 
 		combos = append(combos, dt.typ)
@@ -99,6 +152,7 @@ func generate(out io.Writer, packageName, functionName, baseType string, dynamic
 		Package, Function   string
 		BaseType, ShortBase string
 		ReturnType          string
+		Time                bool
 		Types               map[string]typeStruct
 		Combinations        [][]string
 	}{
@@ -108,6 +162,7 @@ func generate(out io.Writer, packageName, functionName, baseType string, dynamic
 		BaseType:     baseType,
 		ShortBase:    shortBase,
 		ReturnType:   returnType,
+		Time:         usesTime,
 		Types:        types,
 		Combinations: combinations.All(combos),
 	}
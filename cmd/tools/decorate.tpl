@@ -3,6 +3,9 @@ package {{.Package}}
 // Code generated by github.com/evcc-io/evcc/cmd/tools/decorate.go. DO NOT EDIT.
 
 import (
+{{- if .Time}}
+	"time"
+{{end}}
 	"{{.API}}"
 )
 
@@ -33,7 +36,7 @@ import (
 		}
 {{- end -}}
 
-func {{.Function}}(base {{.BaseType}}{{range ordered}}, {{.VarName}} func() {{slice .Signature 7}}{{end}}) {{.ReturnType}} {
+func {{.Function}}(base {{.BaseType}}{{range ordered}}, {{.VarName}} {{.Signature}}{{end}}) {{.ReturnType}} {
 {{- $basetype := .BaseType}}
 {{- $shortbase := .ShortBase}}
 {{- $prefix := .Function}}
@@ -56,8 +59,8 @@ type {{$prefix}}{{.ShortType}}Impl struct {
 	{{.VarName}} {{.Signature}}
 }
 
-func (impl *{{$prefix}}{{.ShortType}}Impl) {{.Function}}{{slice .Signature 4}} {
-	return impl.{{.VarName}}()
+func (impl *{{$prefix}}{{.ShortType}}Impl) {{.Function}}({{.Params}}) {{.Return}} {
+	{{if .Return}}return {{end}}impl.{{.VarName}}({{.Args}})
 }
 
 {{end}}
@@ -26,6 +26,25 @@ func (c *Coordinator) GetVehicles() []api.Vehicle {
 	return c.vehicles
 }
 
+// SetVehicles replaces the vehicles and releases vehicles no longer available
+func (c *Coordinator) SetVehicles(vehicles []api.Vehicle) {
+	c.vehicles = vehicles
+
+	for tracked := range c.tracked {
+		var found bool
+		for _, v := range vehicles {
+			if v == tracked {
+				found = true
+				break
+			}
+		}
+
+		if !found {
+			delete(c.tracked, tracked)
+		}
+	}
+}
+
 func (c *Coordinator) acquire(owner loadpoint.API, vehicle api.Vehicle) {
 	if o, ok := c.tracked[vehicle]; ok && o != owner {
 		o.SetVehicle(nil)
@@ -0,0 +1,51 @@
+package energy
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"strconv"
+)
+
+// WriteCSV writes the entries as csv with one row per period and columns per site and loadpoint total
+func WriteCSV(w io.Writer, entries []Entry) error {
+	var lps int
+	for _, e := range entries {
+		if len(e.LoadPoints) > lps {
+			lps = len(e.LoadPoints)
+		}
+	}
+
+	header := []string{"period", "pv", "gridImport", "gridExport", "batteryCharge", "batteryDischarge", "home"}
+	for i := 1; i <= lps; i++ {
+		header = append(header, fmt.Sprintf("lp%dSolar", i), fmt.Sprintf("lp%dGrid", i))
+	}
+
+	cw := csv.NewWriter(w)
+	if err := cw.Write(header); err != nil {
+		return err
+	}
+
+	kwh := func(f float64) string {
+		return strconv.FormatFloat(f, 'f', 3, 64)
+	}
+
+	for _, e := range entries {
+		row := []string{e.Period, kwh(e.PV), kwh(e.GridImport), kwh(e.GridExport), kwh(e.BatteryCharge), kwh(e.BatteryDischarge), kwh(e.Home)}
+
+		for i := 0; i < lps; i++ {
+			var c Charged
+			if i < len(e.LoadPoints) {
+				c = e.LoadPoints[i]
+			}
+			row = append(row, kwh(c.Solar), kwh(c.Grid))
+		}
+
+		if err := cw.Write(row); err != nil {
+			return err
+		}
+	}
+
+	cw.Flush()
+	return cw.Error()
+}
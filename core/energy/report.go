@@ -0,0 +1,357 @@
+package energy
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/evcc-io/evcc/util"
+)
+
+// Config is the energy report configuration
+type Config struct {
+	Disable bool   // disable energy report
+	File    string // storage file, default ~/.evcc/energy.json
+}
+
+const (
+	maxGap       = 5 * time.Minute // don't integrate across longer gaps, e.g. downtime
+	saveInterval = time.Hour
+)
+
+// Period is the report granularity
+type Period string
+
+// Periods
+const (
+	Day   Period = "day"
+	Month Period = "month"
+)
+
+// ParsePeriod converts string to Period
+func ParsePeriod(s string) (Period, error) {
+	switch p := Period(s); p {
+	case Day, Month:
+		return p, nil
+	default:
+		return "", fmt.Errorf("invalid period: %s", s)
+	}
+}
+
+// layout returns the period's key layout
+func (p Period) layout() string {
+	if p == Month {
+		return "2006-01"
+	}
+	return "2006-01-02"
+}
+
+// Charged is the loadpoint energy in kWh split by source
+type Charged struct {
+	Solar float64 `json:"solar"`
+	Grid  float64 `json:"grid"`
+}
+
+// Totals are the energy totals of a period in kWh
+type Totals struct {
+	PV               float64   `json:"pv"`
+	GridImport       float64   `json:"gridImport"`
+	GridExport       float64   `json:"gridExport"`
+	BatteryCharge    float64   `json:"batteryCharge"`
+	BatteryDischarge float64   `json:"batteryDischarge"`
+	Home             float64   `json:"home"`
+	LoadPoints       []Charged `json:"loadpoints"`
+}
+
+// Map returns the site totals by name
+func (t Totals) Map() map[string]float64 {
+	return map[string]float64{
+		"pv":               t.PV,
+		"gridImport":       t.GridImport,
+		"gridExport":       t.GridExport,
+		"batteryCharge":    t.BatteryCharge,
+		"batteryDischarge": t.BatteryDischarge,
+		"home":             t.Home,
+	}
+}
+
+// Map returns the loadpoint totals by name
+func (c Charged) Map() map[string]float64 {
+	return map[string]float64{
+		"solar": c.Solar,
+		"grid":  c.Grid,
+		"total": c.Solar + c.Grid,
+	}
+}
+
+// Entry are the totals of a single period
+type Entry struct {
+	Period string `json:"period"`
+	Totals
+}
+
+// Measurement contains the site values of a single update.
+// Grid and battery registers count imported and discharged energy.
+type Measurement struct {
+	Time                                        time.Time
+	PVPower, GridPower, BatteryPower, HomePower float64    // W
+	PVEnergy, GridEnergy, BatteryEnergy         *float64   // meter registers in kWh if available
+	ChargePower                                 []float64  // W by loadpoint
+	ChargeEnergy                                []*float64 // charge meter registers in kWh by loadpoint if available
+}
+
+// Report accumulates energy totals per day and month
+type Report struct {
+	mu     sync.Mutex
+	log    *util.Logger
+	file   string
+	saved  time.Time
+	last   *Measurement
+	totals map[Period]map[string]*Totals
+}
+
+// DefaultFile returns the default storage file in the user's home directory
+func DefaultFile() (string, error) {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(home, ".evcc", "energy.json"), nil
+}
+
+// NewReport creates a report and loads existing totals from file. If file is empty, totals are not persisted.
+func NewReport(file string) (*Report, error) {
+	r := &Report{
+		log:  util.NewLogger("energy"),
+		file: file,
+		totals: map[Period]map[string]*Totals{
+			Day:   make(map[string]*Totals),
+			Month: make(map[string]*Totals),
+		},
+	}
+
+	if file == "" {
+		return r, nil
+	}
+
+	if err := os.MkdirAll(filepath.Dir(file), 0o755); err != nil {
+		return nil, err
+	}
+
+	b, err := os.ReadFile(file)
+	if errors.Is(err, os.ErrNotExist) {
+		return r, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if err := json.Unmarshal(b, &r.totals); err != nil {
+		return nil, fmt.Errorf("%s: %w", file, err)
+	}
+
+	for _, p := range []Period{Day, Month} {
+		if r.totals[p] == nil {
+			r.totals[p] = make(map[string]*Totals)
+		}
+	}
+
+	return r, nil
+}
+
+// integrate returns the energy in kWh of the power values at the interval bounds
+func integrate(a, b float64, h float64) float64 {
+	return (a + b) / 2 * h / 1e3
+}
+
+// registerDelta returns the register increase or false if registers are unavailable or were reset
+func registerDelta(a, b *float64) (float64, bool) {
+	if a == nil || b == nil || *b < *a {
+		return 0, false
+	}
+	return *b - *a, true
+}
+
+// SelfShare returns the share of self-produced energy of the site consumption
+func SelfShare(gridPower, pvPower, batteryPower float64) float64 {
+	batteryDischarge := math.Max(0, batteryPower)
+	batteryCharge := math.Min(0, batteryPower) * -1
+	pvConsumption := math.Min(pvPower, pvPower+gridPower-batteryCharge)
+
+	gridImport := math.Max(0, gridPower)
+	selfConsumption := math.Max(0, batteryDischarge+pvConsumption+batteryCharge)
+
+	share := selfConsumption / (gridImport + selfConsumption)
+	if math.IsNaN(share) {
+		return 0
+	}
+
+	return share
+}
+
+// Add integrates the energy since the previous measurement
+func (r *Report) Add(m Measurement) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	last := r.last
+	r.last = &m
+
+	if last == nil {
+		return
+	}
+
+	dt := m.Time.Sub(last.Time)
+	if dt <= 0 || dt > maxGap {
+		return
+	}
+	h := dt.Hours()
+
+	var t Totals
+
+	if delta, ok := registerDelta(last.PVEnergy, m.PVEnergy); ok {
+		t.PV = delta
+	} else {
+		t.PV = integrate(math.Max(0, last.PVPower), math.Max(0, m.PVPower), h)
+	}
+
+	if delta, ok := registerDelta(last.GridEnergy, m.GridEnergy); ok {
+		t.GridImport = delta
+	} else {
+		t.GridImport = integrate(math.Max(0, last.GridPower), math.Max(0, m.GridPower), h)
+	}
+	t.GridExport = integrate(math.Max(0, -last.GridPower), math.Max(0, -m.GridPower), h)
+
+	if delta, ok := registerDelta(last.BatteryEnergy, m.BatteryEnergy); ok {
+		t.BatteryDischarge = delta
+	} else {
+		t.BatteryDischarge = integrate(math.Max(0, last.BatteryPower), math.Max(0, m.BatteryPower), h)
+	}
+	t.BatteryCharge = integrate(math.Max(0, -last.BatteryPower), math.Max(0, -m.BatteryPower), h)
+
+	t.Home = integrate(last.HomePower, m.HomePower, h)
+
+	share := SelfShare(m.GridPower, m.PVPower, m.BatteryPower)
+	for i, p := range m.ChargePower {
+		var prev float64
+		if i < len(last.ChargePower) {
+			prev = last.ChargePower[i]
+		}
+
+		e := integrate(math.Max(0, prev), math.Max(0, p), h)
+		if i < len(last.ChargeEnergy) && i < len(m.ChargeEnergy) {
+			if delta, ok := registerDelta(last.ChargeEnergy[i], m.ChargeEnergy[i]); ok {
+				e = delta
+			}
+		}
+
+		t.LoadPoints = append(t.LoadPoints, Charged{Solar: e * share, Grid: e * (1 - share)})
+	}
+
+	for _, p := range []Period{Day, Month} {
+		key := m.Time.Local().Format(p.layout())
+
+		total, ok := r.totals[p][key]
+		if !ok {
+			total = new(Totals)
+			r.totals[p][key] = total
+		}
+
+		total.add(t)
+	}
+
+	if m.Time.Sub(r.saved) > saveInterval || m.Time.Local().Day() != last.Time.Local().Day() {
+		if err := r.save(); err != nil {
+			r.log.ERROR.Println(err)
+		}
+		r.saved = m.Time
+	}
+}
+
+func (t *Totals) add(o Totals) {
+	t.PV += o.PV
+	t.GridImport += o.GridImport
+	t.GridExport += o.GridExport
+	t.BatteryCharge += o.BatteryCharge
+	t.BatteryDischarge += o.BatteryDischarge
+	t.Home += o.Home
+
+	for len(t.LoadPoints) < len(o.LoadPoints) {
+		t.LoadPoints = append(t.LoadPoints, Charged{})
+	}
+
+	for i, c := range o.LoadPoints {
+		t.LoadPoints[i].Solar += c.Solar
+		t.LoadPoints[i].Grid += c.Grid
+	}
+}
+
+// Current returns the totals of the period containing ts
+func (r *Report) Current(p Period, ts time.Time) Totals {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var res Totals
+	if t, ok := r.totals[p][ts.Local().Format(p.layout())]; ok {
+		res.add(*t)
+	}
+
+	return res
+}
+
+// Entries returns the totals of the periods between from and to inclusive, empty bounds are unlimited
+func (r *Report) Entries(p Period, from, to string) []Entry {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	res := []Entry{}
+	for key, t := range r.totals[p] {
+		if (from != "" && key < from) || (to != "" && key > to) {
+			continue
+		}
+
+		e := Entry{Period: key}
+		e.add(*t)
+		res = append(res, e)
+	}
+
+	sort.Slice(res, func(i, j int) bool { return res[i].Period < res[j].Period })
+
+	return res
+}
+
+// save writes the totals to file, must be called with lock held
+func (r *Report) save() error {
+	if r.file == "" {
+		return nil
+	}
+
+	b, err := json.Marshal(r.totals)
+	if err != nil {
+		return err
+	}
+
+	// write atomically
+	tmp := r.file + ".tmp"
+	if err := os.WriteFile(tmp, b, 0o644); err != nil {
+		return err
+	}
+
+	return os.Rename(tmp, r.file)
+}
+
+// Shutdown saves the totals
+func (r *Report) Shutdown() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err := r.save(); err != nil {
+		r.log.ERROR.Println(err)
+	}
+}
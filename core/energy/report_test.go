@@ -0,0 +1,162 @@
+package energy
+
+import (
+	"bytes"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSelfShare(t *testing.T) {
+	tc := []struct {
+		grid, pv, battery, share float64
+	}{
+		{0, 0, 0, 0},
+		{1000, 0, 0, 0},
+		{-1000, 2000, 0, 1},
+		{500, 500, 0, 0.5},
+		{0, 0, 1000, 1},
+		{500, 0, 500, 0.5},
+	}
+
+	for _, tc := range tc {
+		assert.Equal(t, tc.share, SelfShare(tc.grid, tc.pv, tc.battery), "%+v", tc)
+	}
+}
+
+func TestReportIntegration(t *testing.T) {
+	r, err := NewReport("")
+	require.NoError(t, err)
+
+	ts := time.Date(2022, 10, 1, 12, 0, 0, 0, time.Local)
+
+	// 1h of constant values in 1 minute steps
+	for i := 0; i <= 60; i++ {
+		r.Add(Measurement{
+			Time:         ts.Add(time.Duration(i) * time.Minute),
+			PVPower:      3000,
+			GridPower:    -1000,
+			BatteryPower: -500,
+			HomePower:    500,
+			ChargePower:  []float64{1000},
+		})
+	}
+
+	day := r.Current(Day, ts)
+	assert.InDelta(t, 3, day.PV, 1e-9)
+	assert.InDelta(t, 0, day.GridImport, 1e-9)
+	assert.InDelta(t, 1, day.GridExport, 1e-9)
+	assert.InDelta(t, 0.5, day.BatteryCharge, 1e-9)
+	assert.InDelta(t, 0, day.BatteryDischarge, 1e-9)
+	assert.InDelta(t, 0.5, day.Home, 1e-9)
+	require.Len(t, day.LoadPoints, 1)
+	assert.InDelta(t, 1, day.LoadPoints[0].Solar, 1e-9)
+	assert.InDelta(t, 0, day.LoadPoints[0].Grid, 1e-9)
+
+	assert.Equal(t, day, r.Current(Month, ts))
+	assert.Equal(t, Totals{}, r.Current(Day, ts.AddDate(0, 0, 1)))
+}
+
+func TestReportRegisters(t *testing.T) {
+	r, err := NewReport("")
+	require.NoError(t, err)
+
+	ts := time.Date(2022, 10, 1, 12, 0, 0, 0, time.Local)
+	ptr := func(f float64) *float64 { return &f }
+
+	// registers take precedence over power
+	r.Add(Measurement{Time: ts, GridPower: 1000, GridEnergy: ptr(100), PVEnergy: ptr(50)})
+	r.Add(Measurement{Time: ts.Add(time.Minute), GridPower: 1000, GridEnergy: ptr(102), PVEnergy: ptr(51)})
+
+	// register reset falls back to power
+	r.Add(Measurement{Time: ts.Add(2 * time.Minute), GridPower: 1000, GridEnergy: ptr(0)})
+
+	// gaps are not integrated
+	r.Add(Measurement{Time: ts.Add(time.Hour), GridPower: 1000})
+
+	day := r.Current(Day, ts)
+	assert.InDelta(t, 2+1.0/60, day.GridImport, 1e-9)
+	assert.InDelta(t, 1, day.PV, 1e-9)
+}
+
+func TestReportBatteryAndChargeRegisters(t *testing.T) {
+	r, err := NewReport("")
+	require.NoError(t, err)
+
+	ts := time.Date(2022, 10, 1, 12, 0, 0, 0, time.Local)
+	ptr := func(f float64) *float64 { return &f }
+
+	// loadpoints without register use power
+	r.Add(Measurement{
+		Time:          ts,
+		BatteryPower:  600,
+		BatteryEnergy: ptr(10),
+		ChargePower:   []float64{6000, 6000},
+		ChargeEnergy:  []*float64{ptr(20), nil},
+	})
+	r.Add(Measurement{
+		Time:          ts.Add(time.Minute),
+		BatteryPower:  600,
+		BatteryEnergy: ptr(10.5),
+		ChargePower:   []float64{6000, 6000},
+		ChargeEnergy:  []*float64{ptr(20.2), nil},
+	})
+
+	day := r.Current(Day, ts)
+	assert.InDelta(t, 0.5, day.BatteryDischarge, 1e-9)
+	require.Len(t, day.LoadPoints, 2)
+	assert.InDelta(t, 0.2, day.LoadPoints[0].Solar+day.LoadPoints[0].Grid, 1e-9)
+	assert.InDelta(t, 0.1, day.LoadPoints[1].Solar+day.LoadPoints[1].Grid, 1e-9)
+}
+
+func TestReportEntries(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "energy.json")
+
+	r, err := NewReport(file)
+	require.NoError(t, err)
+
+	for _, ts := range []time.Time{
+		time.Date(2022, 9, 30, 23, 58, 0, 0, time.Local),
+		time.Date(2022, 9, 30, 23, 59, 0, 0, time.Local),
+		time.Date(2022, 10, 1, 0, 0, 0, 0, time.Local),
+		time.Date(2022, 10, 1, 0, 1, 0, 0, time.Local),
+	} {
+		r.Add(Measurement{Time: ts, HomePower: 600})
+	}
+
+	days := r.Entries(Day, "", "")
+	require.Len(t, days, 2)
+	assert.Equal(t, "2022-09-30", days[0].Period)
+	assert.InDelta(t, 0.01, days[0].Home, 1e-9)
+	assert.Equal(t, "2022-10-01", days[1].Period)
+	assert.InDelta(t, 0.02, days[1].Home, 1e-9)
+
+	assert.Len(t, r.Entries(Day, "2022-10-01", ""), 1)
+	assert.Len(t, r.Entries(Day, "", "2022-09-30"), 1)
+	assert.Len(t, r.Entries(Month, "2022-10", "2022-10"), 1)
+
+	// persisted on day change and shutdown
+	r.Shutdown()
+
+	r2, err := NewReport(file)
+	require.NoError(t, err)
+	assert.Equal(t, r.Entries(Month, "", ""), r2.Entries(Month, "", ""))
+}
+
+func TestWriteCSV(t *testing.T) {
+	entries := []Entry{
+		{Period: "2022-10-01", Totals: Totals{PV: 10, Home: 5, LoadPoints: []Charged{{Solar: 1, Grid: 2}}}},
+		{Period: "2022-10-02", Totals: Totals{PV: 1.2345}},
+	}
+
+	var b bytes.Buffer
+	require.NoError(t, WriteCSV(&b, entries))
+
+	expected := "period,pv,gridImport,gridExport,batteryCharge,batteryDischarge,home,lp1Solar,lp1Grid\n" +
+		"2022-10-01,10.000,0.000,0.000,0.000,0.000,5.000,1.000,2.000\n" +
+		"2022-10-02,1.234,0.000,0.000,0.000,0.000,0.000,0.000,0.000\n"
+	assert.Equal(t, expected, b.String())
+}
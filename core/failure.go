@@ -0,0 +1,31 @@
+package core
+
+import "time"
+
+// failureTimer tracks how long an operation has been failing
+type failureTimer struct {
+	since    time.Time
+	notified bool
+}
+
+// fail records a failure and returns true exactly once when the failure has lasted longer than timeout
+func (t *failureTimer) fail(now time.Time, timeout time.Duration) bool {
+	if t.since.IsZero() {
+		t.since = now
+	}
+
+	if t.notified || now.Sub(t.since) < timeout {
+		return false
+	}
+
+	t.notified = true
+	return true
+}
+
+// ok resets the failure timer and returns true if the failure had been notified
+func (t *failureTimer) ok() bool {
+	notified := t.notified
+	t.since = time.Time{}
+	t.notified = false
+	return notified
+}
@@ -0,0 +1,41 @@
+package core
+
+import (
+	"testing"
+	"time"
+)
+
+func TestFailureTimer(t *testing.T) {
+	var ft failureTimer
+
+	now := time.Now()
+	timeout := 5 * time.Minute
+
+	if ft.fail(now, timeout) {
+		t.Error("unexpected notification on first failure")
+	}
+
+	if ft.fail(now.Add(timeout-time.Second), timeout) {
+		t.Error("unexpected notification before timeout")
+	}
+
+	if !ft.fail(now.Add(timeout), timeout) {
+		t.Error("missing notification after timeout")
+	}
+
+	if ft.fail(now.Add(2*timeout), timeout) {
+		t.Error("unexpected repeated notification")
+	}
+
+	if !ft.ok() {
+		t.Error("missing recovery after notification")
+	}
+
+	if ft.ok() {
+		t.Error("unexpected recovery without failure")
+	}
+
+	if ft.fail(now.Add(3*timeout), timeout) {
+		t.Error("unexpected notification after recovery")
+	}
+}
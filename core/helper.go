@@ -1,10 +1,15 @@
 package core
 
 import (
+	"errors"
+	"net/http"
+
 	"github.com/avast/retry-go/v3"
 	"github.com/evcc-io/evcc/api"
 	"github.com/evcc-io/evcc/util"
+	"github.com/evcc-io/evcc/util/request"
 	"github.com/samber/lo"
+	"golang.org/x/oauth2"
 )
 
 var (
@@ -46,3 +51,22 @@ func vehicleTitles(vehicles []api.Vehicle) []string {
 		return v.Title()
 	})
 }
+
+// authFailure returns true if the error indicates rejected or missing credentials
+func authFailure(err error) bool {
+	if errors.Is(err, api.ErrMissingCredentials) {
+		return true
+	}
+
+	var se request.StatusError
+	if errors.As(err, &se) {
+		return se.HasStatus(http.StatusUnauthorized, http.StatusForbidden)
+	}
+
+	var re *oauth2.RetrieveError
+	if errors.As(err, &re) && re.Response != nil {
+		return re.Response.StatusCode == http.StatusBadRequest || re.Response.StatusCode == http.StatusUnauthorized
+	}
+
+	return false
+}
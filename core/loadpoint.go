@@ -29,14 +29,17 @@ import (
 )
 
 const (
-	evChargeStart         = "start"      // update chargeTimer
-	evChargeStop          = "stop"       // update chargeTimer
-	evChargeCurrent       = "current"    // update fakeChargeMeter
-	evChargePower         = "power"      // update chargeRater
-	evVehicleConnect      = "connect"    // vehicle connected
-	evVehicleDisconnect   = "disconnect" // vehicle disconnected
-	evVehicleSoC          = "soc"        // vehicle soc progress
-	evVehicleUnidentified = "guest"      // vehicle unidentified
+	evChargeStart         = "start"        // update chargeTimer
+	evChargeStop          = "stop"         // update chargeTimer
+	evChargeCurrent       = "current"      // update fakeChargeMeter
+	evChargePower         = "power"        // update chargeRater
+	evVehicleConnect      = "connect"      // vehicle connected
+	evVehicleDisconnect   = "disconnect"   // vehicle disconnected
+	evVehicleSoC          = "soc"          // vehicle soc progress
+	evVehicleUnidentified = "guest"        // vehicle unidentified
+	evVehicleAuthFailure  = "authfailure"  // vehicle api authentication failed
+	evChargerFault        = "fault"        // charger status E/F
+	evMeterFailure        = "meterfailure" // meter read failure
 
 	pvTimer   = "pv"
 	pvEnable  = "enable"
@@ -125,6 +128,7 @@ type LoadPoint struct {
 	vehicleDetect       time.Time // Vehicle connected timestamp
 	vehicleDetectTicker *clock.Ticker
 	vehicleIdentifier   string
+	vehicleAuthFailed   bool // Vehicle api authentication failure notified
 
 	charger     api.Charger
 	chargeTimer api.ChargeTimer
@@ -132,6 +136,7 @@ type LoadPoint struct {
 
 	chargeMeter    api.Meter   // Charger usage meter
 	vehicle        api.Vehicle // Currently active vehicle
+	vehicleTitle   string      // Title of the active vehicle
 	defaultVehicle api.Vehicle // Default vehicle (disables detection)
 	coordinator    coordinator.API
 	socEstimator   *soc.Estimator
@@ -140,6 +145,8 @@ type LoadPoint struct {
 	// cached state
 	status         api.ChargeStatus       // Charger status
 	remoteDemand   loadpoint.RemoteDemand // External status demand
+	powerLimit     float64                // Site-imposed power limit
+	powerLimits    map[string]float64     // Loadpoint power limits by source
 	chargePower    float64                // Charging power
 	chargeCurrents []float64              // Phase currents
 	connectedTime  time.Time              // Time when vehicle was connected
@@ -263,7 +270,7 @@ func NewLoadPoint(log *util.Logger) *LoadPoint {
 	}
 
 	// allow target charge handler to access loadpoint
-	lp.socTimer = soc.NewTimer(lp.log, &adapter{LoadPoint: lp})
+	lp.socTimer = soc.NewTimer(lp.log, &adapter{LoadPoint: lp}, clock)
 
 	return lp
 }
@@ -343,6 +350,11 @@ func (lp *LoadPoint) configureChargerType(charger api.Charger) {
 
 // pushEvent sends push messages to clients
 func (lp *LoadPoint) pushEvent(event string) {
+	// test helper
+	if lp.pushChan == nil {
+		return
+	}
+
 	lp.pushChan <- push.Event{Event: event}
 }
 
@@ -569,8 +581,61 @@ func (lp *LoadPoint) syncCharger() {
 	}
 }
 
+// setPowerLimit sets the site-imposed power limit. Zero removes the limit.
+func (lp *LoadPoint) setPowerLimit(power float64) {
+	if lp.powerLimit != power {
+		lp.powerLimit = power
+		lp.publish("powerLimit", lp.effectivePowerLimit())
+	}
+}
+
+// effectivePowerLimit returns the lowest of site and source power limits or zero if not limited
+func (lp *LoadPoint) effectivePowerLimit() float64 {
+	lp.Lock()
+	defer lp.Unlock()
+	return lp.lowestPowerLimit()
+}
+
+// lowestPowerLimit returns the lowest power limit. Must be called with lock held.
+func (lp *LoadPoint) lowestPowerLimit() float64 {
+	limit := lp.powerLimit
+	for _, l := range lp.powerLimits {
+		if limit == 0 || l < limit {
+			limit = l
+		}
+	}
+
+	return limit
+}
+
+// powerDemand returns the power the loadpoint may use when sharing a site power limit.
+// Charging loadpoints demand their max power, loadpoints ready to start their min power.
+func (lp *LoadPoint) powerDemand() float64 {
+	switch {
+	case lp.charging():
+		return lp.GetMaxPower()
+	case lp.connected() && !lp.enabled && lp.GetMode() != api.ModeOff:
+		return Voltage * lp.GetMinCurrent() * float64(lp.activePhases())
+	default:
+		return 0
+	}
+}
+
 // setLimit applies charger current limits and enables/disables accordingly
 func (lp *LoadPoint) setLimit(chargeCurrent float64, force bool) error {
+	// apply site and source power limits
+	if powerLimit := lp.effectivePowerLimit(); powerLimit > 0 && chargeCurrent > 0 {
+		if limit := powerToCurrent(powerLimit, lp.activePhases()); chargeCurrent > limit {
+			lp.log.DEBUG.Printf("power limit %.0fW: %.3gA", powerLimit, limit)
+			chargeCurrent = limit
+
+			// power limits must be met immediately, don't wait for the contactor guard
+			if limit < lp.GetMinCurrent() {
+				force = true
+			}
+		}
+	}
+
 	// set current
 	if chargeCurrent != lp.chargeCurrent && chargeCurrent >= lp.GetMinCurrent() {
 		var err error
@@ -802,13 +867,17 @@ func (lp *LoadPoint) setActiveVehicle(vehicle api.Vehicle) {
 	}
 	lp.log.INFO.Printf("vehicle updated: %s -> %s", from, to)
 
+	lp.vehicleAuthFailed = false
+
 	if lp.vehicle = vehicle; vehicle != nil {
 		lp.socUpdated = time.Time{}
 
 		lp.socEstimator = soc.NewEstimator(lp.log, lp.charger, vehicle, lp.SoC.Estimate)
 
+		lp.vehicleTitle = lp.vehicle.Title()
+
 		lp.publish("vehiclePresent", true)
-		lp.publish("vehicleTitle", lp.vehicle.Title())
+		lp.publish("vehicleTitle", lp.vehicleTitle)
 		lp.publish("vehicleCapacity", lp.vehicle.Capacity())
 
 		// unblock api
@@ -821,6 +890,7 @@ func (lp *LoadPoint) setActiveVehicle(vehicle api.Vehicle) {
 		lp.progress.Reset()
 	} else {
 		lp.socEstimator = nil
+		lp.vehicleTitle = ""
 
 		lp.publish("vehiclePresent", false)
 		lp.publish("vehicleTitle", "")
@@ -975,6 +1045,12 @@ func (lp *LoadPoint) updateChargerStatus() error {
 			lp.bus.Publish(evVehicleConnect)
 		}
 
+		// changed to E/F - charger fault
+		if status == api.StatusE || status == api.StatusF {
+			lp.log.ERROR.Printf("charger fault: status %s", status)
+			lp.pushEvent(evChargerFault)
+		}
+
 		// changed to C - start/stop charging cycle - handle before disconnect to update energy
 		if lp.charging() {
 			lp.bus.Publish(evChargeStart)
@@ -1316,7 +1392,7 @@ func (lp *LoadPoint) pvMaxCurrent(mode api.ChargeMode, sitePower float64, batter
 }
 
 // UpdateChargePower updates charge meter power
-func (lp *LoadPoint) UpdateChargePower() {
+func (lp *LoadPoint) UpdateChargePower() error {
 	err := retry.Do(func() error {
 		value, err := lp.chargeMeter.CurrentPower()
 		if err != nil {
@@ -1340,6 +1416,8 @@ func (lp *LoadPoint) UpdateChargePower() {
 	if err != nil {
 		lp.log.ERROR.Printf("charge meter: %v", err)
 	}
+
+	return err
 }
 
 // updateChargeCurrents uses MeterCurrent interface to count phases with current >=1A
@@ -1460,12 +1538,20 @@ func (lp *LoadPoint) publishSoCAndRange() {
 
 			// trigger message after variables are updated
 			lp.bus.Publish(evVehicleSoC, f)
+
+			lp.vehicleAuthFailed = false
 		} else {
 			if errors.Is(err, api.ErrMustRetry) {
 				lp.socUpdated = time.Time{}
 			} else {
 				lp.log.ERROR.Printf("vehicle soc: %v", err)
 			}
+
+			// notify once per vehicle authentication failure
+			if authFailure(err) && !lp.vehicleAuthFailed {
+				lp.vehicleAuthFailed = true
+				lp.pushEvent(evVehicleAuthFailure)
+			}
 		}
 
 		return
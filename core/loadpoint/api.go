@@ -46,6 +46,8 @@ type API interface {
 	// SetPhases sets the enabled phases
 	SetPhases(int) error
 
+	// GetTargetTime returns the target charging deadline or zero if not set
+	GetTargetTime() time.Time
 	// SetTargetCharge sets the charge targetSoC
 	SetTargetCharge(time.Time, int)
 	// RemoteControl sets remote status demand
@@ -69,6 +71,8 @@ type API interface {
 	GetMinPower() float64
 	// GetMaxPower returns the max charging power taking active phases into account
 	GetMaxPower() float64
+	// SetPowerLimit limits loadpoint power on behalf of source. Zero removes the source's limit.
+	SetPowerLimit(source string, power float64)
 
 	//
 	// charge progress
@@ -78,11 +82,19 @@ type API interface {
 	GetRemainingDuration() time.Duration
 	// GetRemainingEnergy is the remaining charge energy in Wh
 	GetRemainingEnergy() float64
+	// GetChargedEnergy is the energy charged since the vehicle connected in Wh
+	GetChargedEnergy() float64
 
 	//
 	// vehicles
 	//
 
+	// GetVehicleSoC returns the active vehicle's soc
+	GetVehicleSoC() float64
+	// GetVehicleCapacity returns the active vehicle's capacity in kWh or zero if unknown
+	GetVehicleCapacity() float64
+	// GetVehicle gets the active vehicle
+	GetVehicle() api.Vehicle
 	// SetVehicle sets the active vehicle
 	SetVehicle(vehicle api.Vehicle)
 	// StartVehicleDetection allows triggering vehicle detection for debugging purposes
@@ -14,6 +14,10 @@ func (a *adapter) Publish(key string, val interface{}) {
 	a.LoadPoint.publish(key, val)
 }
 
+func (a *adapter) PushEvent(event string) {
+	a.LoadPoint.pushEvent(event)
+}
+
 func (a *adapter) SocEstimator() *soc.Estimator {
 	return a.LoadPoint.socEstimator
 }
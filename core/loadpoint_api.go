@@ -127,6 +127,13 @@ func (lp *LoadPoint) SetPhases(phases int) error {
 	return nil
 }
 
+// GetTargetTime returns the target charging deadline or zero if not set
+func (lp *LoadPoint) GetTargetTime() time.Time {
+	lp.Lock()
+	defer lp.Unlock()
+	return lp.socTimer.Time
+}
+
 // SetTargetCharge sets loadpoint charge targetSoC
 func (lp *LoadPoint) SetTargetCharge(finishAt time.Time, soc int) {
 	lp.Lock()
@@ -164,6 +171,31 @@ func (lp *LoadPoint) RemoteControl(source string, demand loadpoint.RemoteDemand)
 	}
 }
 
+// SetPowerLimit limits loadpoint power on behalf of source. Zero removes the source's limit.
+func (lp *LoadPoint) SetPowerLimit(source string, power float64) {
+	lp.Lock()
+	defer lp.Unlock()
+
+	prev := lp.powerLimits[source]
+	if power == prev {
+		return
+	}
+
+	if power > 0 {
+		if lp.powerLimits == nil {
+			lp.powerLimits = make(map[string]float64)
+		}
+		lp.powerLimits[source] = power
+		lp.log.DEBUG.Printf("power limit: %.0fW (%s)", power, source)
+	} else {
+		delete(lp.powerLimits, source)
+		lp.log.DEBUG.Printf("power limit removed (%s)", source)
+	}
+
+	lp.publish("powerLimit", lp.lowestPowerLimit())
+	lp.requestUpdate()
+}
+
 // HasChargeMeter determines if a physical charge meter is attached
 func (lp *LoadPoint) HasChargeMeter() bool {
 	_, isWrapped := lp.chargeMeter.(*wrapper.ChargeMeter)
@@ -260,6 +292,39 @@ func (lp *LoadPoint) GetRemainingEnergy() float64 {
 	return lp.chargeRemainingEnergy
 }
 
+// GetChargedEnergy is the energy charged since the vehicle connected in Wh
+func (lp *LoadPoint) GetChargedEnergy() float64 {
+	lp.Lock()
+	defer lp.Unlock()
+	return lp.chargedEnergy
+}
+
+// GetVehicleSoC returns the active vehicle's soc
+func (lp *LoadPoint) GetVehicleSoC() float64 {
+	lp.Lock()
+	defer lp.Unlock()
+	return lp.vehicleSoc
+}
+
+// GetVehicleCapacity returns the active vehicle's capacity in kWh or zero if unknown
+func (lp *LoadPoint) GetVehicleCapacity() float64 {
+	lp.Lock()
+	defer lp.Unlock()
+
+	if lp.vehicle == nil {
+		return 0
+	}
+
+	return float64(lp.vehicle.Capacity())
+}
+
+// GetVehicle gets the active vehicle
+func (lp *LoadPoint) GetVehicle() api.Vehicle {
+	lp.Lock()
+	defer lp.Unlock()
+	return lp.vehicle
+}
+
 // SetVehicle sets the active vehicle
 func (lp *LoadPoint) SetVehicle(vehicle api.Vehicle) {
 	// TODO develop universal locking approach
@@ -0,0 +1,104 @@
+package core
+
+import (
+	"errors"
+
+	"github.com/evcc-io/evcc/api"
+	"github.com/evcc-io/evcc/core/loadpoint"
+	"github.com/evcc-io/evcc/core/soc"
+	"github.com/evcc-io/evcc/core/wrapper"
+)
+
+// chargerCapabilities returns the charger capabilities the loadpoint setup depends on
+func chargerCapabilities(charger api.Charger) [4]bool {
+	_, meter := charger.(api.Meter)
+	_, rater := charger.(api.ChargeRater)
+	_, timer := charger.(api.ChargeTimer)
+	_, phases := charger.(api.PhaseSwitcher)
+	return [4]bool{meter, rater, timer, phases}
+}
+
+// checkReload verifies that rebuilt devices can be replaced without recreating the loadpoint
+func (lp *LoadPoint) checkReload(devices Devices) error {
+	if charger, ok := devices.Chargers[lp.charger]; ok && chargerCapabilities(charger) != chargerCapabilities(lp.charger) {
+		return errors.New("charger capabilities changed, restart required")
+	}
+	return nil
+}
+
+// reload replaces rebuilt devices while keeping the session state
+func (lp *LoadPoint) reload(devices Devices, vehicles []api.Vehicle) {
+	lp.Lock()
+
+	var updated, chargerReloaded, vehicleReloaded bool
+
+	if charger, ok := devices.Chargers[lp.charger]; ok {
+		old := lp.charger
+		lp.charger = charger
+		updated, chargerReloaded = true, true
+
+		// capabilities are unchanged as verified by checkReload
+		if m, ok := old.(api.Meter); ok && lp.chargeMeter == m {
+			lp.chargeMeter, _ = charger.(api.Meter)
+		}
+		if rt, ok := old.(api.ChargeRater); ok && lp.chargeRater == rt {
+			lp.chargeRater, _ = charger.(api.ChargeRater)
+		}
+		if ct, ok := old.(api.ChargeTimer); ok && lp.chargeTimer == ct {
+			lp.chargeTimer, _ = charger.(api.ChargeTimer)
+		}
+
+		lp.log.INFO.Println("charger reloaded")
+	}
+
+	if meter, ok := devices.Meters[lp.chargeMeter]; ok {
+		lp.chargeMeter = meter
+		lp.log.INFO.Println("charge meter reloaded")
+	}
+
+	// charge rater reads the charge meter's energy
+	if rt, ok := lp.chargeRater.(*wrapper.ChargeRater); ok {
+		rt.SetMeter(lp.chargeMeter)
+	}
+
+	if vehicle, ok := devices.Vehicles[lp.defaultVehicle]; ok {
+		lp.defaultVehicle = vehicle
+	}
+
+	var available bool
+	for _, v := range vehicles {
+		available = available || v == lp.vehicle
+	}
+
+	if vehicle, ok := devices.Vehicles[lp.vehicle]; ok {
+		lp.coordinator.Release(lp.vehicle)
+		lp.coordinator.Acquire(vehicle)
+		lp.vehicle = vehicle
+		lp.vehicleTitle = vehicle.Title()
+		updated, vehicleReloaded = true, true
+
+		lp.publish("vehicleTitle", lp.vehicleTitle)
+		lp.publish("vehicleCapacity", vehicle.Capacity())
+
+		lp.log.INFO.Printf("vehicle reloaded: %s", lp.vehicleTitle)
+	}
+
+	// estimator depends on charger and vehicle
+	if updated && lp.vehicle != nil {
+		lp.socEstimator = soc.NewEstimator(lp.log, lp.charger, lp.vehicle, lp.SoC.Estimate)
+	}
+
+	charger, vehicle, defaultVehicle := lp.charger, lp.vehicle, lp.defaultVehicle
+
+	lp.Unlock()
+
+	// allow charger to access loadpoint
+	if ctrl, ok := charger.(loadpoint.Controller); chargerReloaded && ok {
+		ctrl.LoadpointControl(lp)
+	}
+
+	// vehicle removed from configuration
+	if !vehicleReloaded && vehicle != nil && !available {
+		lp.setActiveVehicle(defaultVehicle)
+	}
+}
@@ -770,3 +770,40 @@ func TestMinSoC(t *testing.T) {
 		}
 	}
 }
+
+func TestPowerLimitDisablesImmediately(t *testing.T) {
+	clock := clock.NewMock()
+	ctrl := gomock.NewController(t)
+	charger := mock.NewMockCharger(ctrl)
+
+	Voltage = 230 // V
+
+	lp := &LoadPoint{
+		log:           util.NewLogger("foo"),
+		bus:           evbus.New(),
+		clock:         clock,
+		charger:       charger,
+		wakeUpTimer:   NewTimer(),
+		MinCurrent:    minA,
+		MaxCurrent:    maxA,
+		GuardDuration: 5 * time.Minute,
+		phases:        3,
+		enabled:       true,
+		chargeCurrent: maxA,
+		guardUpdated:  clock.Now(),
+	}
+
+	// limit above min current reduces current
+	lp.setPowerLimit(3 * 230 * 10)
+	charger.EXPECT().MaxCurrent(int64(10)).Return(nil)
+	if err := lp.setLimit(maxA, false); err != nil || !lp.enabled {
+		t.Errorf("expected enabled, got %v: %v", lp.enabled, err)
+	}
+
+	// limit below min current disables within guard duration
+	lp.setPowerLimit(2000)
+	charger.EXPECT().Enable(false).Return(nil)
+	if err := lp.setLimit(maxA, false); err != nil || lp.enabled {
+		t.Errorf("expected disabled, got %v: %v", lp.enabled, err)
+	}
+}
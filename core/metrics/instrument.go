@@ -0,0 +1,89 @@
+package metrics
+
+import (
+	"reflect"
+	"time"
+
+	"github.com/evcc-io/evcc/api"
+)
+
+//go:generate go run ../../cmd/tools/decorate.go -f decorateMeter -b api.Meter -t "api.MeterEnergy,TotalEnergy,func() (float64, error)" -t "api.MeterCurrent,Currents,func() (float64, float64, float64, error)" -t "api.Battery,SoC,func() (float64, error)"
+
+// device records the requests of an instrumented device
+type device struct {
+	typ, name string
+}
+
+func (d *device) observe(start time.Time, err error) {
+	Observe(d.typ, d.name, start, err)
+}
+
+func observed[T any](d *device, fn func() (T, error)) (T, error) {
+	start := time.Now()
+	res, err := fn()
+	d.observe(start, err)
+	return res, err
+}
+
+func observedErr(d *device, fn func() error) error {
+	start := time.Now()
+	err := fn()
+	d.observe(start, err)
+	return err
+}
+
+// retains returns the instrumented device if it provides all methods of the implementation.
+// Otherwise the implementation is returned uninstrumented to keep type assertions intact.
+func retains[T any](impl, instrumented T) T {
+	it := reflect.TypeOf(instrumented)
+
+	typ := reflect.TypeOf(impl)
+	for i := 0; i < typ.NumMethod(); i++ {
+		if _, ok := it.MethodByName(typ.Method(i).Name); !ok {
+			return impl
+		}
+	}
+
+	return instrumented
+}
+
+// InstrumentMeter returns the meter recording its requests. Meters with optional
+// interfaces that cannot be retained are returned uninstrumented.
+func InstrumentMeter(name string, m api.Meter) api.Meter {
+	d := &device{typ: Meter, name: name}
+
+	var totalEnergy func() (float64, error)
+	if mm, ok := m.(api.MeterEnergy); ok {
+		totalEnergy = func() (float64, error) {
+			return observed(d, mm.TotalEnergy)
+		}
+	}
+
+	var currents func() (float64, float64, float64, error)
+	if mm, ok := m.(api.MeterCurrent); ok {
+		currents = func() (float64, float64, float64, error) {
+			start := time.Now()
+			l1, l2, l3, err := mm.Currents()
+			d.observe(start, err)
+			return l1, l2, l3, err
+		}
+	}
+
+	var soc func() (float64, error)
+	if mm, ok := m.(api.Battery); ok {
+		soc = func() (float64, error) {
+			return observed(d, mm.SoC)
+		}
+	}
+
+	return retains(m, decorateMeter(&meter{d, m}, totalEnergy, currents, soc))
+}
+
+type meter struct {
+	*device
+	api.Meter
+}
+
+func (m *meter) CurrentPower() (float64, error) {
+	return observed(m.device, m.Meter.CurrentPower)
+}
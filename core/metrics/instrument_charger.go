@@ -0,0 +1,103 @@
+package metrics
+
+import (
+	"time"
+
+	"github.com/evcc-io/evcc/api"
+)
+
+//go:generate go run ../../cmd/tools/decorate.go -f decorateCharger -b api.Charger -t "api.Meter,CurrentPower,func() (float64, error)" -t "api.MeterEnergy,TotalEnergy,func() (float64, error)" -t "api.MeterCurrent,Currents,func() (float64, float64, float64, error)" -t "api.ChargerEx,MaxCurrentMillis,func(float64) error" -t "api.PhaseSwitcher,Phases1p3p,func(int) error" -t "api.ChargeRater,ChargedEnergy,func() (float64, error)" -t "api.Identifier,Identify,func() (string, error)" -t "api.Diagnosis,Diagnose,func()"
+
+// InstrumentCharger returns the charger recording its requests. Chargers with optional
+// interfaces that cannot be retained are returned uninstrumented.
+func InstrumentCharger(name string, c api.Charger) api.Charger {
+	d := &device{typ: Charger, name: name}
+
+	var currentPower func() (float64, error)
+	if cc, ok := c.(api.Meter); ok {
+		currentPower = func() (float64, error) {
+			return observed(d, cc.CurrentPower)
+		}
+	}
+
+	var totalEnergy func() (float64, error)
+	if cc, ok := c.(api.MeterEnergy); ok {
+		totalEnergy = func() (float64, error) {
+			return observed(d, cc.TotalEnergy)
+		}
+	}
+
+	var currents func() (float64, float64, float64, error)
+	if cc, ok := c.(api.MeterCurrent); ok {
+		currents = func() (float64, float64, float64, error) {
+			start := time.Now()
+			l1, l2, l3, err := cc.Currents()
+			d.observe(start, err)
+			return l1, l2, l3, err
+		}
+	}
+
+	var maxCurrentMillis func(float64) error
+	if cc, ok := c.(api.ChargerEx); ok {
+		maxCurrentMillis = func(current float64) error {
+			return observedErr(d, func() error {
+				return cc.MaxCurrentMillis(current)
+			})
+		}
+	}
+
+	var phases1p3p func(int) error
+	if cc, ok := c.(api.PhaseSwitcher); ok {
+		phases1p3p = func(phases int) error {
+			return observedErr(d, func() error {
+				return cc.Phases1p3p(phases)
+			})
+		}
+	}
+
+	var chargedEnergy func() (float64, error)
+	if cc, ok := c.(api.ChargeRater); ok {
+		chargedEnergy = func() (float64, error) {
+			return observed(d, cc.ChargedEnergy)
+		}
+	}
+
+	var identify func() (string, error)
+	if cc, ok := c.(api.Identifier); ok {
+		identify = func() (string, error) {
+			return observed(d, cc.Identify)
+		}
+	}
+
+	var diagnose func()
+	if cc, ok := c.(api.Diagnosis); ok {
+		diagnose = cc.Diagnose
+	}
+
+	return retains(c, decorateCharger(&charger{d, c}, currentPower, totalEnergy, currents, maxCurrentMillis, phases1p3p, chargedEnergy, identify, diagnose))
+}
+
+type charger struct {
+	*device
+	api.Charger
+}
+
+func (c *charger) Status() (api.ChargeStatus, error) {
+	return observed(c.device, c.Charger.Status)
+}
+
+func (c *charger) Enabled() (bool, error) {
+	return observed(c.device, c.Charger.Enabled)
+}
+
+func (c *charger) Enable(enable bool) error {
+	return observedErr(c.device, func() error {
+		return c.Charger.Enable(enable)
+	})
+}
+
+func (c *charger) MaxCurrent(current int64) error {
+	return observedErr(c.device, func() error {
+		return c.Charger.MaxCurrent(current)
+	})
+}
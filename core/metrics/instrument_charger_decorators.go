@@ -0,0 +1,5961 @@
+package metrics
+
+// Code generated by github.com/evcc-io/evcc/cmd/tools/decorate.go. DO NOT EDIT.
+
+import (
+	"github.com/evcc-io/evcc/api"
+)
+
+func decorateCharger(base api.Charger, meter func() (float64, error), meterEnergy func() (float64, error), meterCurrent func() (float64, float64, float64, error), chargerEx func(float64) error, phaseSwitcher func(int) error, chargeRater func() (float64, error), identifier func() (string, error), diagnosis func()) api.Charger {
+	switch {
+	case chargeRater == nil && chargerEx == nil && diagnosis == nil && identifier == nil && meter == nil && meterCurrent == nil && meterEnergy == nil && phaseSwitcher == nil:
+		return base
+
+	case chargeRater == nil && chargerEx == nil && diagnosis == nil && identifier == nil && meter != nil && meterCurrent == nil && meterEnergy == nil && phaseSwitcher == nil:
+		return &struct {
+			api.Charger
+			api.Meter
+		}{
+			Charger: base,
+			Meter: &decorateChargerMeterImpl{
+				meter: meter,
+			},
+		}
+
+	case chargeRater == nil && chargerEx == nil && diagnosis == nil && identifier == nil && meter == nil && meterCurrent == nil && meterEnergy != nil && phaseSwitcher == nil:
+		return &struct {
+			api.Charger
+			api.MeterEnergy
+		}{
+			Charger: base,
+			MeterEnergy: &decorateChargerMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+		}
+
+	case chargeRater == nil && chargerEx == nil && diagnosis == nil && identifier == nil && meter != nil && meterCurrent == nil && meterEnergy != nil && phaseSwitcher == nil:
+		return &struct {
+			api.Charger
+			api.Meter
+			api.MeterEnergy
+		}{
+			Charger: base,
+			Meter: &decorateChargerMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decorateChargerMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+		}
+
+	case chargeRater == nil && chargerEx == nil && diagnosis == nil && identifier == nil && meter == nil && meterCurrent != nil && meterEnergy == nil && phaseSwitcher == nil:
+		return &struct {
+			api.Charger
+			api.MeterCurrent
+		}{
+			Charger: base,
+			MeterCurrent: &decorateChargerMeterCurrentImpl{
+				meterCurrent: meterCurrent,
+			},
+		}
+
+	case chargeRater == nil && chargerEx == nil && diagnosis == nil && identifier == nil && meter != nil && meterCurrent != nil && meterEnergy == nil && phaseSwitcher == nil:
+		return &struct {
+			api.Charger
+			api.Meter
+			api.MeterCurrent
+		}{
+			Charger: base,
+			Meter: &decorateChargerMeterImpl{
+				meter: meter,
+			},
+			MeterCurrent: &decorateChargerMeterCurrentImpl{
+				meterCurrent: meterCurrent,
+			},
+		}
+
+	case chargeRater == nil && chargerEx == nil && diagnosis == nil && identifier == nil && meter == nil && meterCurrent != nil && meterEnergy != nil && phaseSwitcher == nil:
+		return &struct {
+			api.Charger
+			api.MeterCurrent
+			api.MeterEnergy
+		}{
+			Charger: base,
+			MeterCurrent: &decorateChargerMeterCurrentImpl{
+				meterCurrent: meterCurrent,
+			},
+			MeterEnergy: &decorateChargerMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+		}
+
+	case chargeRater == nil && chargerEx == nil && diagnosis == nil && identifier == nil && meter != nil && meterCurrent != nil && meterEnergy != nil && phaseSwitcher == nil:
+		return &struct {
+			api.Charger
+			api.Meter
+			api.MeterCurrent
+			api.MeterEnergy
+		}{
+			Charger: base,
+			Meter: &decorateChargerMeterImpl{
+				meter: meter,
+			},
+			MeterCurrent: &decorateChargerMeterCurrentImpl{
+				meterCurrent: meterCurrent,
+			},
+			MeterEnergy: &decorateChargerMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+		}
+
+	case chargeRater == nil && chargerEx != nil && diagnosis == nil && identifier == nil && meter == nil && meterCurrent == nil && meterEnergy == nil && phaseSwitcher == nil:
+		return &struct {
+			api.Charger
+			api.ChargerEx
+		}{
+			Charger: base,
+			ChargerEx: &decorateChargerChargerExImpl{
+				chargerEx: chargerEx,
+			},
+		}
+
+	case chargeRater == nil && chargerEx != nil && diagnosis == nil && identifier == nil && meter != nil && meterCurrent == nil && meterEnergy == nil && phaseSwitcher == nil:
+		return &struct {
+			api.Charger
+			api.ChargerEx
+			api.Meter
+		}{
+			Charger: base,
+			ChargerEx: &decorateChargerChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Meter: &decorateChargerMeterImpl{
+				meter: meter,
+			},
+		}
+
+	case chargeRater == nil && chargerEx != nil && diagnosis == nil && identifier == nil && meter == nil && meterCurrent == nil && meterEnergy != nil && phaseSwitcher == nil:
+		return &struct {
+			api.Charger
+			api.ChargerEx
+			api.MeterEnergy
+		}{
+			Charger: base,
+			ChargerEx: &decorateChargerChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			MeterEnergy: &decorateChargerMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+		}
+
+	case chargeRater == nil && chargerEx != nil && diagnosis == nil && identifier == nil && meter != nil && meterCurrent == nil && meterEnergy != nil && phaseSwitcher == nil:
+		return &struct {
+			api.Charger
+			api.ChargerEx
+			api.Meter
+			api.MeterEnergy
+		}{
+			Charger: base,
+			ChargerEx: &decorateChargerChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Meter: &decorateChargerMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decorateChargerMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+		}
+
+	case chargeRater == nil && chargerEx != nil && diagnosis == nil && identifier == nil && meter == nil && meterCurrent != nil && meterEnergy == nil && phaseSwitcher == nil:
+		return &struct {
+			api.Charger
+			api.ChargerEx
+			api.MeterCurrent
+		}{
+			Charger: base,
+			ChargerEx: &decorateChargerChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			MeterCurrent: &decorateChargerMeterCurrentImpl{
+				meterCurrent: meterCurrent,
+			},
+		}
+
+	case chargeRater == nil && chargerEx != nil && diagnosis == nil && identifier == nil && meter != nil && meterCurrent != nil && meterEnergy == nil && phaseSwitcher == nil:
+		return &struct {
+			api.Charger
+			api.ChargerEx
+			api.Meter
+			api.MeterCurrent
+		}{
+			Charger: base,
+			ChargerEx: &decorateChargerChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Meter: &decorateChargerMeterImpl{
+				meter: meter,
+			},
+			MeterCurrent: &decorateChargerMeterCurrentImpl{
+				meterCurrent: meterCurrent,
+			},
+		}
+
+	case chargeRater == nil && chargerEx != nil && diagnosis == nil && identifier == nil && meter == nil && meterCurrent != nil && meterEnergy != nil && phaseSwitcher == nil:
+		return &struct {
+			api.Charger
+			api.ChargerEx
+			api.MeterCurrent
+			api.MeterEnergy
+		}{
+			Charger: base,
+			ChargerEx: &decorateChargerChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			MeterCurrent: &decorateChargerMeterCurrentImpl{
+				meterCurrent: meterCurrent,
+			},
+			MeterEnergy: &decorateChargerMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+		}
+
+	case chargeRater == nil && chargerEx != nil && diagnosis == nil && identifier == nil && meter != nil && meterCurrent != nil && meterEnergy != nil && phaseSwitcher == nil:
+		return &struct {
+			api.Charger
+			api.ChargerEx
+			api.Meter
+			api.MeterCurrent
+			api.MeterEnergy
+		}{
+			Charger: base,
+			ChargerEx: &decorateChargerChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Meter: &decorateChargerMeterImpl{
+				meter: meter,
+			},
+			MeterCurrent: &decorateChargerMeterCurrentImpl{
+				meterCurrent: meterCurrent,
+			},
+			MeterEnergy: &decorateChargerMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+		}
+
+	case chargeRater == nil && chargerEx == nil && diagnosis == nil && identifier == nil && meter == nil && meterCurrent == nil && meterEnergy == nil && phaseSwitcher != nil:
+		return &struct {
+			api.Charger
+			api.PhaseSwitcher
+		}{
+			Charger: base,
+			PhaseSwitcher: &decorateChargerPhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+		}
+
+	case chargeRater == nil && chargerEx == nil && diagnosis == nil && identifier == nil && meter != nil && meterCurrent == nil && meterEnergy == nil && phaseSwitcher != nil:
+		return &struct {
+			api.Charger
+			api.Meter
+			api.PhaseSwitcher
+		}{
+			Charger: base,
+			Meter: &decorateChargerMeterImpl{
+				meter: meter,
+			},
+			PhaseSwitcher: &decorateChargerPhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+		}
+
+	case chargeRater == nil && chargerEx == nil && diagnosis == nil && identifier == nil && meter == nil && meterCurrent == nil && meterEnergy != nil && phaseSwitcher != nil:
+		return &struct {
+			api.Charger
+			api.MeterEnergy
+			api.PhaseSwitcher
+		}{
+			Charger: base,
+			MeterEnergy: &decorateChargerMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseSwitcher: &decorateChargerPhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+		}
+
+	case chargeRater == nil && chargerEx == nil && diagnosis == nil && identifier == nil && meter != nil && meterCurrent == nil && meterEnergy != nil && phaseSwitcher != nil:
+		return &struct {
+			api.Charger
+			api.Meter
+			api.MeterEnergy
+			api.PhaseSwitcher
+		}{
+			Charger: base,
+			Meter: &decorateChargerMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decorateChargerMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseSwitcher: &decorateChargerPhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+		}
+
+	case chargeRater == nil && chargerEx == nil && diagnosis == nil && identifier == nil && meter == nil && meterCurrent != nil && meterEnergy == nil && phaseSwitcher != nil:
+		return &struct {
+			api.Charger
+			api.MeterCurrent
+			api.PhaseSwitcher
+		}{
+			Charger: base,
+			MeterCurrent: &decorateChargerMeterCurrentImpl{
+				meterCurrent: meterCurrent,
+			},
+			PhaseSwitcher: &decorateChargerPhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+		}
+
+	case chargeRater == nil && chargerEx == nil && diagnosis == nil && identifier == nil && meter != nil && meterCurrent != nil && meterEnergy == nil && phaseSwitcher != nil:
+		return &struct {
+			api.Charger
+			api.Meter
+			api.MeterCurrent
+			api.PhaseSwitcher
+		}{
+			Charger: base,
+			Meter: &decorateChargerMeterImpl{
+				meter: meter,
+			},
+			MeterCurrent: &decorateChargerMeterCurrentImpl{
+				meterCurrent: meterCurrent,
+			},
+			PhaseSwitcher: &decorateChargerPhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+		}
+
+	case chargeRater == nil && chargerEx == nil && diagnosis == nil && identifier == nil && meter == nil && meterCurrent != nil && meterEnergy != nil && phaseSwitcher != nil:
+		return &struct {
+			api.Charger
+			api.MeterCurrent
+			api.MeterEnergy
+			api.PhaseSwitcher
+		}{
+			Charger: base,
+			MeterCurrent: &decorateChargerMeterCurrentImpl{
+				meterCurrent: meterCurrent,
+			},
+			MeterEnergy: &decorateChargerMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseSwitcher: &decorateChargerPhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+		}
+
+	case chargeRater == nil && chargerEx == nil && diagnosis == nil && identifier == nil && meter != nil && meterCurrent != nil && meterEnergy != nil && phaseSwitcher != nil:
+		return &struct {
+			api.Charger
+			api.Meter
+			api.MeterCurrent
+			api.MeterEnergy
+			api.PhaseSwitcher
+		}{
+			Charger: base,
+			Meter: &decorateChargerMeterImpl{
+				meter: meter,
+			},
+			MeterCurrent: &decorateChargerMeterCurrentImpl{
+				meterCurrent: meterCurrent,
+			},
+			MeterEnergy: &decorateChargerMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseSwitcher: &decorateChargerPhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+		}
+
+	case chargeRater == nil && chargerEx != nil && diagnosis == nil && identifier == nil && meter == nil && meterCurrent == nil && meterEnergy == nil && phaseSwitcher != nil:
+		return &struct {
+			api.Charger
+			api.ChargerEx
+			api.PhaseSwitcher
+		}{
+			Charger: base,
+			ChargerEx: &decorateChargerChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			PhaseSwitcher: &decorateChargerPhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+		}
+
+	case chargeRater == nil && chargerEx != nil && diagnosis == nil && identifier == nil && meter != nil && meterCurrent == nil && meterEnergy == nil && phaseSwitcher != nil:
+		return &struct {
+			api.Charger
+			api.ChargerEx
+			api.Meter
+			api.PhaseSwitcher
+		}{
+			Charger: base,
+			ChargerEx: &decorateChargerChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Meter: &decorateChargerMeterImpl{
+				meter: meter,
+			},
+			PhaseSwitcher: &decorateChargerPhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+		}
+
+	case chargeRater == nil && chargerEx != nil && diagnosis == nil && identifier == nil && meter == nil && meterCurrent == nil && meterEnergy != nil && phaseSwitcher != nil:
+		return &struct {
+			api.Charger
+			api.ChargerEx
+			api.MeterEnergy
+			api.PhaseSwitcher
+		}{
+			Charger: base,
+			ChargerEx: &decorateChargerChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			MeterEnergy: &decorateChargerMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseSwitcher: &decorateChargerPhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+		}
+
+	case chargeRater == nil && chargerEx != nil && diagnosis == nil && identifier == nil && meter != nil && meterCurrent == nil && meterEnergy != nil && phaseSwitcher != nil:
+		return &struct {
+			api.Charger
+			api.ChargerEx
+			api.Meter
+			api.MeterEnergy
+			api.PhaseSwitcher
+		}{
+			Charger: base,
+			ChargerEx: &decorateChargerChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Meter: &decorateChargerMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decorateChargerMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseSwitcher: &decorateChargerPhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+		}
+
+	case chargeRater == nil && chargerEx != nil && diagnosis == nil && identifier == nil && meter == nil && meterCurrent != nil && meterEnergy == nil && phaseSwitcher != nil:
+		return &struct {
+			api.Charger
+			api.ChargerEx
+			api.MeterCurrent
+			api.PhaseSwitcher
+		}{
+			Charger: base,
+			ChargerEx: &decorateChargerChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			MeterCurrent: &decorateChargerMeterCurrentImpl{
+				meterCurrent: meterCurrent,
+			},
+			PhaseSwitcher: &decorateChargerPhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+		}
+
+	case chargeRater == nil && chargerEx != nil && diagnosis == nil && identifier == nil && meter != nil && meterCurrent != nil && meterEnergy == nil && phaseSwitcher != nil:
+		return &struct {
+			api.Charger
+			api.ChargerEx
+			api.Meter
+			api.MeterCurrent
+			api.PhaseSwitcher
+		}{
+			Charger: base,
+			ChargerEx: &decorateChargerChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Meter: &decorateChargerMeterImpl{
+				meter: meter,
+			},
+			MeterCurrent: &decorateChargerMeterCurrentImpl{
+				meterCurrent: meterCurrent,
+			},
+			PhaseSwitcher: &decorateChargerPhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+		}
+
+	case chargeRater == nil && chargerEx != nil && diagnosis == nil && identifier == nil && meter == nil && meterCurrent != nil && meterEnergy != nil && phaseSwitcher != nil:
+		return &struct {
+			api.Charger
+			api.ChargerEx
+			api.MeterCurrent
+			api.MeterEnergy
+			api.PhaseSwitcher
+		}{
+			Charger: base,
+			ChargerEx: &decorateChargerChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			MeterCurrent: &decorateChargerMeterCurrentImpl{
+				meterCurrent: meterCurrent,
+			},
+			MeterEnergy: &decorateChargerMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseSwitcher: &decorateChargerPhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+		}
+
+	case chargeRater == nil && chargerEx != nil && diagnosis == nil && identifier == nil && meter != nil && meterCurrent != nil && meterEnergy != nil && phaseSwitcher != nil:
+		return &struct {
+			api.Charger
+			api.ChargerEx
+			api.Meter
+			api.MeterCurrent
+			api.MeterEnergy
+			api.PhaseSwitcher
+		}{
+			Charger: base,
+			ChargerEx: &decorateChargerChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Meter: &decorateChargerMeterImpl{
+				meter: meter,
+			},
+			MeterCurrent: &decorateChargerMeterCurrentImpl{
+				meterCurrent: meterCurrent,
+			},
+			MeterEnergy: &decorateChargerMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseSwitcher: &decorateChargerPhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+		}
+
+	case chargeRater != nil && chargerEx == nil && diagnosis == nil && identifier == nil && meter == nil && meterCurrent == nil && meterEnergy == nil && phaseSwitcher == nil:
+		return &struct {
+			api.Charger
+			api.ChargeRater
+		}{
+			Charger: base,
+			ChargeRater: &decorateChargerChargeRaterImpl{
+				chargeRater: chargeRater,
+			},
+		}
+
+	case chargeRater != nil && chargerEx == nil && diagnosis == nil && identifier == nil && meter != nil && meterCurrent == nil && meterEnergy == nil && phaseSwitcher == nil:
+		return &struct {
+			api.Charger
+			api.ChargeRater
+			api.Meter
+		}{
+			Charger: base,
+			ChargeRater: &decorateChargerChargeRaterImpl{
+				chargeRater: chargeRater,
+			},
+			Meter: &decorateChargerMeterImpl{
+				meter: meter,
+			},
+		}
+
+	case chargeRater != nil && chargerEx == nil && diagnosis == nil && identifier == nil && meter == nil && meterCurrent == nil && meterEnergy != nil && phaseSwitcher == nil:
+		return &struct {
+			api.Charger
+			api.ChargeRater
+			api.MeterEnergy
+		}{
+			Charger: base,
+			ChargeRater: &decorateChargerChargeRaterImpl{
+				chargeRater: chargeRater,
+			},
+			MeterEnergy: &decorateChargerMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+		}
+
+	case chargeRater != nil && chargerEx == nil && diagnosis == nil && identifier == nil && meter != nil && meterCurrent == nil && meterEnergy != nil && phaseSwitcher == nil:
+		return &struct {
+			api.Charger
+			api.ChargeRater
+			api.Meter
+			api.MeterEnergy
+		}{
+			Charger: base,
+			ChargeRater: &decorateChargerChargeRaterImpl{
+				chargeRater: chargeRater,
+			},
+			Meter: &decorateChargerMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decorateChargerMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+		}
+
+	case chargeRater != nil && chargerEx == nil && diagnosis == nil && identifier == nil && meter == nil && meterCurrent != nil && meterEnergy == nil && phaseSwitcher == nil:
+		return &struct {
+			api.Charger
+			api.ChargeRater
+			api.MeterCurrent
+		}{
+			Charger: base,
+			ChargeRater: &decorateChargerChargeRaterImpl{
+				chargeRater: chargeRater,
+			},
+			MeterCurrent: &decorateChargerMeterCurrentImpl{
+				meterCurrent: meterCurrent,
+			},
+		}
+
+	case chargeRater != nil && chargerEx == nil && diagnosis == nil && identifier == nil && meter != nil && meterCurrent != nil && meterEnergy == nil && phaseSwitcher == nil:
+		return &struct {
+			api.Charger
+			api.ChargeRater
+			api.Meter
+			api.MeterCurrent
+		}{
+			Charger: base,
+			ChargeRater: &decorateChargerChargeRaterImpl{
+				chargeRater: chargeRater,
+			},
+			Meter: &decorateChargerMeterImpl{
+				meter: meter,
+			},
+			MeterCurrent: &decorateChargerMeterCurrentImpl{
+				meterCurrent: meterCurrent,
+			},
+		}
+
+	case chargeRater != nil && chargerEx == nil && diagnosis == nil && identifier == nil && meter == nil && meterCurrent != nil && meterEnergy != nil && phaseSwitcher == nil:
+		return &struct {
+			api.Charger
+			api.ChargeRater
+			api.MeterCurrent
+			api.MeterEnergy
+		}{
+			Charger: base,
+			ChargeRater: &decorateChargerChargeRaterImpl{
+				chargeRater: chargeRater,
+			},
+			MeterCurrent: &decorateChargerMeterCurrentImpl{
+				meterCurrent: meterCurrent,
+			},
+			MeterEnergy: &decorateChargerMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+		}
+
+	case chargeRater != nil && chargerEx == nil && diagnosis == nil && identifier == nil && meter != nil && meterCurrent != nil && meterEnergy != nil && phaseSwitcher == nil:
+		return &struct {
+			api.Charger
+			api.ChargeRater
+			api.Meter
+			api.MeterCurrent
+			api.MeterEnergy
+		}{
+			Charger: base,
+			ChargeRater: &decorateChargerChargeRaterImpl{
+				chargeRater: chargeRater,
+			},
+			Meter: &decorateChargerMeterImpl{
+				meter: meter,
+			},
+			MeterCurrent: &decorateChargerMeterCurrentImpl{
+				meterCurrent: meterCurrent,
+			},
+			MeterEnergy: &decorateChargerMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+		}
+
+	case chargeRater != nil && chargerEx != nil && diagnosis == nil && identifier == nil && meter == nil && meterCurrent == nil && meterEnergy == nil && phaseSwitcher == nil:
+		return &struct {
+			api.Charger
+			api.ChargeRater
+			api.ChargerEx
+		}{
+			Charger: base,
+			ChargeRater: &decorateChargerChargeRaterImpl{
+				chargeRater: chargeRater,
+			},
+			ChargerEx: &decorateChargerChargerExImpl{
+				chargerEx: chargerEx,
+			},
+		}
+
+	case chargeRater != nil && chargerEx != nil && diagnosis == nil && identifier == nil && meter != nil && meterCurrent == nil && meterEnergy == nil && phaseSwitcher == nil:
+		return &struct {
+			api.Charger
+			api.ChargeRater
+			api.ChargerEx
+			api.Meter
+		}{
+			Charger: base,
+			ChargeRater: &decorateChargerChargeRaterImpl{
+				chargeRater: chargeRater,
+			},
+			ChargerEx: &decorateChargerChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Meter: &decorateChargerMeterImpl{
+				meter: meter,
+			},
+		}
+
+	case chargeRater != nil && chargerEx != nil && diagnosis == nil && identifier == nil && meter == nil && meterCurrent == nil && meterEnergy != nil && phaseSwitcher == nil:
+		return &struct {
+			api.Charger
+			api.ChargeRater
+			api.ChargerEx
+			api.MeterEnergy
+		}{
+			Charger: base,
+			ChargeRater: &decorateChargerChargeRaterImpl{
+				chargeRater: chargeRater,
+			},
+			ChargerEx: &decorateChargerChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			MeterEnergy: &decorateChargerMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+		}
+
+	case chargeRater != nil && chargerEx != nil && diagnosis == nil && identifier == nil && meter != nil && meterCurrent == nil && meterEnergy != nil && phaseSwitcher == nil:
+		return &struct {
+			api.Charger
+			api.ChargeRater
+			api.ChargerEx
+			api.Meter
+			api.MeterEnergy
+		}{
+			Charger: base,
+			ChargeRater: &decorateChargerChargeRaterImpl{
+				chargeRater: chargeRater,
+			},
+			ChargerEx: &decorateChargerChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Meter: &decorateChargerMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decorateChargerMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+		}
+
+	case chargeRater != nil && chargerEx != nil && diagnosis == nil && identifier == nil && meter == nil && meterCurrent != nil && meterEnergy == nil && phaseSwitcher == nil:
+		return &struct {
+			api.Charger
+			api.ChargeRater
+			api.ChargerEx
+			api.MeterCurrent
+		}{
+			Charger: base,
+			ChargeRater: &decorateChargerChargeRaterImpl{
+				chargeRater: chargeRater,
+			},
+			ChargerEx: &decorateChargerChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			MeterCurrent: &decorateChargerMeterCurrentImpl{
+				meterCurrent: meterCurrent,
+			},
+		}
+
+	case chargeRater != nil && chargerEx != nil && diagnosis == nil && identifier == nil && meter != nil && meterCurrent != nil && meterEnergy == nil && phaseSwitcher == nil:
+		return &struct {
+			api.Charger
+			api.ChargeRater
+			api.ChargerEx
+			api.Meter
+			api.MeterCurrent
+		}{
+			Charger: base,
+			ChargeRater: &decorateChargerChargeRaterImpl{
+				chargeRater: chargeRater,
+			},
+			ChargerEx: &decorateChargerChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Meter: &decorateChargerMeterImpl{
+				meter: meter,
+			},
+			MeterCurrent: &decorateChargerMeterCurrentImpl{
+				meterCurrent: meterCurrent,
+			},
+		}
+
+	case chargeRater != nil && chargerEx != nil && diagnosis == nil && identifier == nil && meter == nil && meterCurrent != nil && meterEnergy != nil && phaseSwitcher == nil:
+		return &struct {
+			api.Charger
+			api.ChargeRater
+			api.ChargerEx
+			api.MeterCurrent
+			api.MeterEnergy
+		}{
+			Charger: base,
+			ChargeRater: &decorateChargerChargeRaterImpl{
+				chargeRater: chargeRater,
+			},
+			ChargerEx: &decorateChargerChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			MeterCurrent: &decorateChargerMeterCurrentImpl{
+				meterCurrent: meterCurrent,
+			},
+			MeterEnergy: &decorateChargerMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+		}
+
+	case chargeRater != nil && chargerEx != nil && diagnosis == nil && identifier == nil && meter != nil && meterCurrent != nil && meterEnergy != nil && phaseSwitcher == nil:
+		return &struct {
+			api.Charger
+			api.ChargeRater
+			api.ChargerEx
+			api.Meter
+			api.MeterCurrent
+			api.MeterEnergy
+		}{
+			Charger: base,
+			ChargeRater: &decorateChargerChargeRaterImpl{
+				chargeRater: chargeRater,
+			},
+			ChargerEx: &decorateChargerChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Meter: &decorateChargerMeterImpl{
+				meter: meter,
+			},
+			MeterCurrent: &decorateChargerMeterCurrentImpl{
+				meterCurrent: meterCurrent,
+			},
+			MeterEnergy: &decorateChargerMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+		}
+
+	case chargeRater != nil && chargerEx == nil && diagnosis == nil && identifier == nil && meter == nil && meterCurrent == nil && meterEnergy == nil && phaseSwitcher != nil:
+		return &struct {
+			api.Charger
+			api.ChargeRater
+			api.PhaseSwitcher
+		}{
+			Charger: base,
+			ChargeRater: &decorateChargerChargeRaterImpl{
+				chargeRater: chargeRater,
+			},
+			PhaseSwitcher: &decorateChargerPhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+		}
+
+	case chargeRater != nil && chargerEx == nil && diagnosis == nil && identifier == nil && meter != nil && meterCurrent == nil && meterEnergy == nil && phaseSwitcher != nil:
+		return &struct {
+			api.Charger
+			api.ChargeRater
+			api.Meter
+			api.PhaseSwitcher
+		}{
+			Charger: base,
+			ChargeRater: &decorateChargerChargeRaterImpl{
+				chargeRater: chargeRater,
+			},
+			Meter: &decorateChargerMeterImpl{
+				meter: meter,
+			},
+			PhaseSwitcher: &decorateChargerPhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+		}
+
+	case chargeRater != nil && chargerEx == nil && diagnosis == nil && identifier == nil && meter == nil && meterCurrent == nil && meterEnergy != nil && phaseSwitcher != nil:
+		return &struct {
+			api.Charger
+			api.ChargeRater
+			api.MeterEnergy
+			api.PhaseSwitcher
+		}{
+			Charger: base,
+			ChargeRater: &decorateChargerChargeRaterImpl{
+				chargeRater: chargeRater,
+			},
+			MeterEnergy: &decorateChargerMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseSwitcher: &decorateChargerPhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+		}
+
+	case chargeRater != nil && chargerEx == nil && diagnosis == nil && identifier == nil && meter != nil && meterCurrent == nil && meterEnergy != nil && phaseSwitcher != nil:
+		return &struct {
+			api.Charger
+			api.ChargeRater
+			api.Meter
+			api.MeterEnergy
+			api.PhaseSwitcher
+		}{
+			Charger: base,
+			ChargeRater: &decorateChargerChargeRaterImpl{
+				chargeRater: chargeRater,
+			},
+			Meter: &decorateChargerMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decorateChargerMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseSwitcher: &decorateChargerPhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+		}
+
+	case chargeRater != nil && chargerEx == nil && diagnosis == nil && identifier == nil && meter == nil && meterCurrent != nil && meterEnergy == nil && phaseSwitcher != nil:
+		return &struct {
+			api.Charger
+			api.ChargeRater
+			api.MeterCurrent
+			api.PhaseSwitcher
+		}{
+			Charger: base,
+			ChargeRater: &decorateChargerChargeRaterImpl{
+				chargeRater: chargeRater,
+			},
+			MeterCurrent: &decorateChargerMeterCurrentImpl{
+				meterCurrent: meterCurrent,
+			},
+			PhaseSwitcher: &decorateChargerPhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+		}
+
+	case chargeRater != nil && chargerEx == nil && diagnosis == nil && identifier == nil && meter != nil && meterCurrent != nil && meterEnergy == nil && phaseSwitcher != nil:
+		return &struct {
+			api.Charger
+			api.ChargeRater
+			api.Meter
+			api.MeterCurrent
+			api.PhaseSwitcher
+		}{
+			Charger: base,
+			ChargeRater: &decorateChargerChargeRaterImpl{
+				chargeRater: chargeRater,
+			},
+			Meter: &decorateChargerMeterImpl{
+				meter: meter,
+			},
+			MeterCurrent: &decorateChargerMeterCurrentImpl{
+				meterCurrent: meterCurrent,
+			},
+			PhaseSwitcher: &decorateChargerPhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+		}
+
+	case chargeRater != nil && chargerEx == nil && diagnosis == nil && identifier == nil && meter == nil && meterCurrent != nil && meterEnergy != nil && phaseSwitcher != nil:
+		return &struct {
+			api.Charger
+			api.ChargeRater
+			api.MeterCurrent
+			api.MeterEnergy
+			api.PhaseSwitcher
+		}{
+			Charger: base,
+			ChargeRater: &decorateChargerChargeRaterImpl{
+				chargeRater: chargeRater,
+			},
+			MeterCurrent: &decorateChargerMeterCurrentImpl{
+				meterCurrent: meterCurrent,
+			},
+			MeterEnergy: &decorateChargerMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseSwitcher: &decorateChargerPhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+		}
+
+	case chargeRater != nil && chargerEx == nil && diagnosis == nil && identifier == nil && meter != nil && meterCurrent != nil && meterEnergy != nil && phaseSwitcher != nil:
+		return &struct {
+			api.Charger
+			api.ChargeRater
+			api.Meter
+			api.MeterCurrent
+			api.MeterEnergy
+			api.PhaseSwitcher
+		}{
+			Charger: base,
+			ChargeRater: &decorateChargerChargeRaterImpl{
+				chargeRater: chargeRater,
+			},
+			Meter: &decorateChargerMeterImpl{
+				meter: meter,
+			},
+			MeterCurrent: &decorateChargerMeterCurrentImpl{
+				meterCurrent: meterCurrent,
+			},
+			MeterEnergy: &decorateChargerMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseSwitcher: &decorateChargerPhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+		}
+
+	case chargeRater != nil && chargerEx != nil && diagnosis == nil && identifier == nil && meter == nil && meterCurrent == nil && meterEnergy == nil && phaseSwitcher != nil:
+		return &struct {
+			api.Charger
+			api.ChargeRater
+			api.ChargerEx
+			api.PhaseSwitcher
+		}{
+			Charger: base,
+			ChargeRater: &decorateChargerChargeRaterImpl{
+				chargeRater: chargeRater,
+			},
+			ChargerEx: &decorateChargerChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			PhaseSwitcher: &decorateChargerPhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+		}
+
+	case chargeRater != nil && chargerEx != nil && diagnosis == nil && identifier == nil && meter != nil && meterCurrent == nil && meterEnergy == nil && phaseSwitcher != nil:
+		return &struct {
+			api.Charger
+			api.ChargeRater
+			api.ChargerEx
+			api.Meter
+			api.PhaseSwitcher
+		}{
+			Charger: base,
+			ChargeRater: &decorateChargerChargeRaterImpl{
+				chargeRater: chargeRater,
+			},
+			ChargerEx: &decorateChargerChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Meter: &decorateChargerMeterImpl{
+				meter: meter,
+			},
+			PhaseSwitcher: &decorateChargerPhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+		}
+
+	case chargeRater != nil && chargerEx != nil && diagnosis == nil && identifier == nil && meter == nil && meterCurrent == nil && meterEnergy != nil && phaseSwitcher != nil:
+		return &struct {
+			api.Charger
+			api.ChargeRater
+			api.ChargerEx
+			api.MeterEnergy
+			api.PhaseSwitcher
+		}{
+			Charger: base,
+			ChargeRater: &decorateChargerChargeRaterImpl{
+				chargeRater: chargeRater,
+			},
+			ChargerEx: &decorateChargerChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			MeterEnergy: &decorateChargerMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseSwitcher: &decorateChargerPhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+		}
+
+	case chargeRater != nil && chargerEx != nil && diagnosis == nil && identifier == nil && meter != nil && meterCurrent == nil && meterEnergy != nil && phaseSwitcher != nil:
+		return &struct {
+			api.Charger
+			api.ChargeRater
+			api.ChargerEx
+			api.Meter
+			api.MeterEnergy
+			api.PhaseSwitcher
+		}{
+			Charger: base,
+			ChargeRater: &decorateChargerChargeRaterImpl{
+				chargeRater: chargeRater,
+			},
+			ChargerEx: &decorateChargerChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Meter: &decorateChargerMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decorateChargerMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseSwitcher: &decorateChargerPhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+		}
+
+	case chargeRater != nil && chargerEx != nil && diagnosis == nil && identifier == nil && meter == nil && meterCurrent != nil && meterEnergy == nil && phaseSwitcher != nil:
+		return &struct {
+			api.Charger
+			api.ChargeRater
+			api.ChargerEx
+			api.MeterCurrent
+			api.PhaseSwitcher
+		}{
+			Charger: base,
+			ChargeRater: &decorateChargerChargeRaterImpl{
+				chargeRater: chargeRater,
+			},
+			ChargerEx: &decorateChargerChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			MeterCurrent: &decorateChargerMeterCurrentImpl{
+				meterCurrent: meterCurrent,
+			},
+			PhaseSwitcher: &decorateChargerPhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+		}
+
+	case chargeRater != nil && chargerEx != nil && diagnosis == nil && identifier == nil && meter != nil && meterCurrent != nil && meterEnergy == nil && phaseSwitcher != nil:
+		return &struct {
+			api.Charger
+			api.ChargeRater
+			api.ChargerEx
+			api.Meter
+			api.MeterCurrent
+			api.PhaseSwitcher
+		}{
+			Charger: base,
+			ChargeRater: &decorateChargerChargeRaterImpl{
+				chargeRater: chargeRater,
+			},
+			ChargerEx: &decorateChargerChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Meter: &decorateChargerMeterImpl{
+				meter: meter,
+			},
+			MeterCurrent: &decorateChargerMeterCurrentImpl{
+				meterCurrent: meterCurrent,
+			},
+			PhaseSwitcher: &decorateChargerPhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+		}
+
+	case chargeRater != nil && chargerEx != nil && diagnosis == nil && identifier == nil && meter == nil && meterCurrent != nil && meterEnergy != nil && phaseSwitcher != nil:
+		return &struct {
+			api.Charger
+			api.ChargeRater
+			api.ChargerEx
+			api.MeterCurrent
+			api.MeterEnergy
+			api.PhaseSwitcher
+		}{
+			Charger: base,
+			ChargeRater: &decorateChargerChargeRaterImpl{
+				chargeRater: chargeRater,
+			},
+			ChargerEx: &decorateChargerChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			MeterCurrent: &decorateChargerMeterCurrentImpl{
+				meterCurrent: meterCurrent,
+			},
+			MeterEnergy: &decorateChargerMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseSwitcher: &decorateChargerPhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+		}
+
+	case chargeRater != nil && chargerEx != nil && diagnosis == nil && identifier == nil && meter != nil && meterCurrent != nil && meterEnergy != nil && phaseSwitcher != nil:
+		return &struct {
+			api.Charger
+			api.ChargeRater
+			api.ChargerEx
+			api.Meter
+			api.MeterCurrent
+			api.MeterEnergy
+			api.PhaseSwitcher
+		}{
+			Charger: base,
+			ChargeRater: &decorateChargerChargeRaterImpl{
+				chargeRater: chargeRater,
+			},
+			ChargerEx: &decorateChargerChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Meter: &decorateChargerMeterImpl{
+				meter: meter,
+			},
+			MeterCurrent: &decorateChargerMeterCurrentImpl{
+				meterCurrent: meterCurrent,
+			},
+			MeterEnergy: &decorateChargerMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseSwitcher: &decorateChargerPhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+		}
+
+	case chargeRater == nil && chargerEx == nil && diagnosis == nil && identifier != nil && meter == nil && meterCurrent == nil && meterEnergy == nil && phaseSwitcher == nil:
+		return &struct {
+			api.Charger
+			api.Identifier
+		}{
+			Charger: base,
+			Identifier: &decorateChargerIdentifierImpl{
+				identifier: identifier,
+			},
+		}
+
+	case chargeRater == nil && chargerEx == nil && diagnosis == nil && identifier != nil && meter != nil && meterCurrent == nil && meterEnergy == nil && phaseSwitcher == nil:
+		return &struct {
+			api.Charger
+			api.Identifier
+			api.Meter
+		}{
+			Charger: base,
+			Identifier: &decorateChargerIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decorateChargerMeterImpl{
+				meter: meter,
+			},
+		}
+
+	case chargeRater == nil && chargerEx == nil && diagnosis == nil && identifier != nil && meter == nil && meterCurrent == nil && meterEnergy != nil && phaseSwitcher == nil:
+		return &struct {
+			api.Charger
+			api.Identifier
+			api.MeterEnergy
+		}{
+			Charger: base,
+			Identifier: &decorateChargerIdentifierImpl{
+				identifier: identifier,
+			},
+			MeterEnergy: &decorateChargerMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+		}
+
+	case chargeRater == nil && chargerEx == nil && diagnosis == nil && identifier != nil && meter != nil && meterCurrent == nil && meterEnergy != nil && phaseSwitcher == nil:
+		return &struct {
+			api.Charger
+			api.Identifier
+			api.Meter
+			api.MeterEnergy
+		}{
+			Charger: base,
+			Identifier: &decorateChargerIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decorateChargerMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decorateChargerMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+		}
+
+	case chargeRater == nil && chargerEx == nil && diagnosis == nil && identifier != nil && meter == nil && meterCurrent != nil && meterEnergy == nil && phaseSwitcher == nil:
+		return &struct {
+			api.Charger
+			api.Identifier
+			api.MeterCurrent
+		}{
+			Charger: base,
+			Identifier: &decorateChargerIdentifierImpl{
+				identifier: identifier,
+			},
+			MeterCurrent: &decorateChargerMeterCurrentImpl{
+				meterCurrent: meterCurrent,
+			},
+		}
+
+	case chargeRater == nil && chargerEx == nil && diagnosis == nil && identifier != nil && meter != nil && meterCurrent != nil && meterEnergy == nil && phaseSwitcher == nil:
+		return &struct {
+			api.Charger
+			api.Identifier
+			api.Meter
+			api.MeterCurrent
+		}{
+			Charger: base,
+			Identifier: &decorateChargerIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decorateChargerMeterImpl{
+				meter: meter,
+			},
+			MeterCurrent: &decorateChargerMeterCurrentImpl{
+				meterCurrent: meterCurrent,
+			},
+		}
+
+	case chargeRater == nil && chargerEx == nil && diagnosis == nil && identifier != nil && meter == nil && meterCurrent != nil && meterEnergy != nil && phaseSwitcher == nil:
+		return &struct {
+			api.Charger
+			api.Identifier
+			api.MeterCurrent
+			api.MeterEnergy
+		}{
+			Charger: base,
+			Identifier: &decorateChargerIdentifierImpl{
+				identifier: identifier,
+			},
+			MeterCurrent: &decorateChargerMeterCurrentImpl{
+				meterCurrent: meterCurrent,
+			},
+			MeterEnergy: &decorateChargerMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+		}
+
+	case chargeRater == nil && chargerEx == nil && diagnosis == nil && identifier != nil && meter != nil && meterCurrent != nil && meterEnergy != nil && phaseSwitcher == nil:
+		return &struct {
+			api.Charger
+			api.Identifier
+			api.Meter
+			api.MeterCurrent
+			api.MeterEnergy
+		}{
+			Charger: base,
+			Identifier: &decorateChargerIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decorateChargerMeterImpl{
+				meter: meter,
+			},
+			MeterCurrent: &decorateChargerMeterCurrentImpl{
+				meterCurrent: meterCurrent,
+			},
+			MeterEnergy: &decorateChargerMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+		}
+
+	case chargeRater == nil && chargerEx != nil && diagnosis == nil && identifier != nil && meter == nil && meterCurrent == nil && meterEnergy == nil && phaseSwitcher == nil:
+		return &struct {
+			api.Charger
+			api.ChargerEx
+			api.Identifier
+		}{
+			Charger: base,
+			ChargerEx: &decorateChargerChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Identifier: &decorateChargerIdentifierImpl{
+				identifier: identifier,
+			},
+		}
+
+	case chargeRater == nil && chargerEx != nil && diagnosis == nil && identifier != nil && meter != nil && meterCurrent == nil && meterEnergy == nil && phaseSwitcher == nil:
+		return &struct {
+			api.Charger
+			api.ChargerEx
+			api.Identifier
+			api.Meter
+		}{
+			Charger: base,
+			ChargerEx: &decorateChargerChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Identifier: &decorateChargerIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decorateChargerMeterImpl{
+				meter: meter,
+			},
+		}
+
+	case chargeRater == nil && chargerEx != nil && diagnosis == nil && identifier != nil && meter == nil && meterCurrent == nil && meterEnergy != nil && phaseSwitcher == nil:
+		return &struct {
+			api.Charger
+			api.ChargerEx
+			api.Identifier
+			api.MeterEnergy
+		}{
+			Charger: base,
+			ChargerEx: &decorateChargerChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Identifier: &decorateChargerIdentifierImpl{
+				identifier: identifier,
+			},
+			MeterEnergy: &decorateChargerMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+		}
+
+	case chargeRater == nil && chargerEx != nil && diagnosis == nil && identifier != nil && meter != nil && meterCurrent == nil && meterEnergy != nil && phaseSwitcher == nil:
+		return &struct {
+			api.Charger
+			api.ChargerEx
+			api.Identifier
+			api.Meter
+			api.MeterEnergy
+		}{
+			Charger: base,
+			ChargerEx: &decorateChargerChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Identifier: &decorateChargerIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decorateChargerMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decorateChargerMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+		}
+
+	case chargeRater == nil && chargerEx != nil && diagnosis == nil && identifier != nil && meter == nil && meterCurrent != nil && meterEnergy == nil && phaseSwitcher == nil:
+		return &struct {
+			api.Charger
+			api.ChargerEx
+			api.Identifier
+			api.MeterCurrent
+		}{
+			Charger: base,
+			ChargerEx: &decorateChargerChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Identifier: &decorateChargerIdentifierImpl{
+				identifier: identifier,
+			},
+			MeterCurrent: &decorateChargerMeterCurrentImpl{
+				meterCurrent: meterCurrent,
+			},
+		}
+
+	case chargeRater == nil && chargerEx != nil && diagnosis == nil && identifier != nil && meter != nil && meterCurrent != nil && meterEnergy == nil && phaseSwitcher == nil:
+		return &struct {
+			api.Charger
+			api.ChargerEx
+			api.Identifier
+			api.Meter
+			api.MeterCurrent
+		}{
+			Charger: base,
+			ChargerEx: &decorateChargerChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Identifier: &decorateChargerIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decorateChargerMeterImpl{
+				meter: meter,
+			},
+			MeterCurrent: &decorateChargerMeterCurrentImpl{
+				meterCurrent: meterCurrent,
+			},
+		}
+
+	case chargeRater == nil && chargerEx != nil && diagnosis == nil && identifier != nil && meter == nil && meterCurrent != nil && meterEnergy != nil && phaseSwitcher == nil:
+		return &struct {
+			api.Charger
+			api.ChargerEx
+			api.Identifier
+			api.MeterCurrent
+			api.MeterEnergy
+		}{
+			Charger: base,
+			ChargerEx: &decorateChargerChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Identifier: &decorateChargerIdentifierImpl{
+				identifier: identifier,
+			},
+			MeterCurrent: &decorateChargerMeterCurrentImpl{
+				meterCurrent: meterCurrent,
+			},
+			MeterEnergy: &decorateChargerMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+		}
+
+	case chargeRater == nil && chargerEx != nil && diagnosis == nil && identifier != nil && meter != nil && meterCurrent != nil && meterEnergy != nil && phaseSwitcher == nil:
+		return &struct {
+			api.Charger
+			api.ChargerEx
+			api.Identifier
+			api.Meter
+			api.MeterCurrent
+			api.MeterEnergy
+		}{
+			Charger: base,
+			ChargerEx: &decorateChargerChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Identifier: &decorateChargerIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decorateChargerMeterImpl{
+				meter: meter,
+			},
+			MeterCurrent: &decorateChargerMeterCurrentImpl{
+				meterCurrent: meterCurrent,
+			},
+			MeterEnergy: &decorateChargerMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+		}
+
+	case chargeRater == nil && chargerEx == nil && diagnosis == nil && identifier != nil && meter == nil && meterCurrent == nil && meterEnergy == nil && phaseSwitcher != nil:
+		return &struct {
+			api.Charger
+			api.Identifier
+			api.PhaseSwitcher
+		}{
+			Charger: base,
+			Identifier: &decorateChargerIdentifierImpl{
+				identifier: identifier,
+			},
+			PhaseSwitcher: &decorateChargerPhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+		}
+
+	case chargeRater == nil && chargerEx == nil && diagnosis == nil && identifier != nil && meter != nil && meterCurrent == nil && meterEnergy == nil && phaseSwitcher != nil:
+		return &struct {
+			api.Charger
+			api.Identifier
+			api.Meter
+			api.PhaseSwitcher
+		}{
+			Charger: base,
+			Identifier: &decorateChargerIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decorateChargerMeterImpl{
+				meter: meter,
+			},
+			PhaseSwitcher: &decorateChargerPhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+		}
+
+	case chargeRater == nil && chargerEx == nil && diagnosis == nil && identifier != nil && meter == nil && meterCurrent == nil && meterEnergy != nil && phaseSwitcher != nil:
+		return &struct {
+			api.Charger
+			api.Identifier
+			api.MeterEnergy
+			api.PhaseSwitcher
+		}{
+			Charger: base,
+			Identifier: &decorateChargerIdentifierImpl{
+				identifier: identifier,
+			},
+			MeterEnergy: &decorateChargerMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseSwitcher: &decorateChargerPhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+		}
+
+	case chargeRater == nil && chargerEx == nil && diagnosis == nil && identifier != nil && meter != nil && meterCurrent == nil && meterEnergy != nil && phaseSwitcher != nil:
+		return &struct {
+			api.Charger
+			api.Identifier
+			api.Meter
+			api.MeterEnergy
+			api.PhaseSwitcher
+		}{
+			Charger: base,
+			Identifier: &decorateChargerIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decorateChargerMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decorateChargerMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseSwitcher: &decorateChargerPhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+		}
+
+	case chargeRater == nil && chargerEx == nil && diagnosis == nil && identifier != nil && meter == nil && meterCurrent != nil && meterEnergy == nil && phaseSwitcher != nil:
+		return &struct {
+			api.Charger
+			api.Identifier
+			api.MeterCurrent
+			api.PhaseSwitcher
+		}{
+			Charger: base,
+			Identifier: &decorateChargerIdentifierImpl{
+				identifier: identifier,
+			},
+			MeterCurrent: &decorateChargerMeterCurrentImpl{
+				meterCurrent: meterCurrent,
+			},
+			PhaseSwitcher: &decorateChargerPhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+		}
+
+	case chargeRater == nil && chargerEx == nil && diagnosis == nil && identifier != nil && meter != nil && meterCurrent != nil && meterEnergy == nil && phaseSwitcher != nil:
+		return &struct {
+			api.Charger
+			api.Identifier
+			api.Meter
+			api.MeterCurrent
+			api.PhaseSwitcher
+		}{
+			Charger: base,
+			Identifier: &decorateChargerIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decorateChargerMeterImpl{
+				meter: meter,
+			},
+			MeterCurrent: &decorateChargerMeterCurrentImpl{
+				meterCurrent: meterCurrent,
+			},
+			PhaseSwitcher: &decorateChargerPhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+		}
+
+	case chargeRater == nil && chargerEx == nil && diagnosis == nil && identifier != nil && meter == nil && meterCurrent != nil && meterEnergy != nil && phaseSwitcher != nil:
+		return &struct {
+			api.Charger
+			api.Identifier
+			api.MeterCurrent
+			api.MeterEnergy
+			api.PhaseSwitcher
+		}{
+			Charger: base,
+			Identifier: &decorateChargerIdentifierImpl{
+				identifier: identifier,
+			},
+			MeterCurrent: &decorateChargerMeterCurrentImpl{
+				meterCurrent: meterCurrent,
+			},
+			MeterEnergy: &decorateChargerMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseSwitcher: &decorateChargerPhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+		}
+
+	case chargeRater == nil && chargerEx == nil && diagnosis == nil && identifier != nil && meter != nil && meterCurrent != nil && meterEnergy != nil && phaseSwitcher != nil:
+		return &struct {
+			api.Charger
+			api.Identifier
+			api.Meter
+			api.MeterCurrent
+			api.MeterEnergy
+			api.PhaseSwitcher
+		}{
+			Charger: base,
+			Identifier: &decorateChargerIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decorateChargerMeterImpl{
+				meter: meter,
+			},
+			MeterCurrent: &decorateChargerMeterCurrentImpl{
+				meterCurrent: meterCurrent,
+			},
+			MeterEnergy: &decorateChargerMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseSwitcher: &decorateChargerPhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+		}
+
+	case chargeRater == nil && chargerEx != nil && diagnosis == nil && identifier != nil && meter == nil && meterCurrent == nil && meterEnergy == nil && phaseSwitcher != nil:
+		return &struct {
+			api.Charger
+			api.ChargerEx
+			api.Identifier
+			api.PhaseSwitcher
+		}{
+			Charger: base,
+			ChargerEx: &decorateChargerChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Identifier: &decorateChargerIdentifierImpl{
+				identifier: identifier,
+			},
+			PhaseSwitcher: &decorateChargerPhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+		}
+
+	case chargeRater == nil && chargerEx != nil && diagnosis == nil && identifier != nil && meter != nil && meterCurrent == nil && meterEnergy == nil && phaseSwitcher != nil:
+		return &struct {
+			api.Charger
+			api.ChargerEx
+			api.Identifier
+			api.Meter
+			api.PhaseSwitcher
+		}{
+			Charger: base,
+			ChargerEx: &decorateChargerChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Identifier: &decorateChargerIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decorateChargerMeterImpl{
+				meter: meter,
+			},
+			PhaseSwitcher: &decorateChargerPhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+		}
+
+	case chargeRater == nil && chargerEx != nil && diagnosis == nil && identifier != nil && meter == nil && meterCurrent == nil && meterEnergy != nil && phaseSwitcher != nil:
+		return &struct {
+			api.Charger
+			api.ChargerEx
+			api.Identifier
+			api.MeterEnergy
+			api.PhaseSwitcher
+		}{
+			Charger: base,
+			ChargerEx: &decorateChargerChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Identifier: &decorateChargerIdentifierImpl{
+				identifier: identifier,
+			},
+			MeterEnergy: &decorateChargerMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseSwitcher: &decorateChargerPhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+		}
+
+	case chargeRater == nil && chargerEx != nil && diagnosis == nil && identifier != nil && meter != nil && meterCurrent == nil && meterEnergy != nil && phaseSwitcher != nil:
+		return &struct {
+			api.Charger
+			api.ChargerEx
+			api.Identifier
+			api.Meter
+			api.MeterEnergy
+			api.PhaseSwitcher
+		}{
+			Charger: base,
+			ChargerEx: &decorateChargerChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Identifier: &decorateChargerIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decorateChargerMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decorateChargerMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseSwitcher: &decorateChargerPhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+		}
+
+	case chargeRater == nil && chargerEx != nil && diagnosis == nil && identifier != nil && meter == nil && meterCurrent != nil && meterEnergy == nil && phaseSwitcher != nil:
+		return &struct {
+			api.Charger
+			api.ChargerEx
+			api.Identifier
+			api.MeterCurrent
+			api.PhaseSwitcher
+		}{
+			Charger: base,
+			ChargerEx: &decorateChargerChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Identifier: &decorateChargerIdentifierImpl{
+				identifier: identifier,
+			},
+			MeterCurrent: &decorateChargerMeterCurrentImpl{
+				meterCurrent: meterCurrent,
+			},
+			PhaseSwitcher: &decorateChargerPhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+		}
+
+	case chargeRater == nil && chargerEx != nil && diagnosis == nil && identifier != nil && meter != nil && meterCurrent != nil && meterEnergy == nil && phaseSwitcher != nil:
+		return &struct {
+			api.Charger
+			api.ChargerEx
+			api.Identifier
+			api.Meter
+			api.MeterCurrent
+			api.PhaseSwitcher
+		}{
+			Charger: base,
+			ChargerEx: &decorateChargerChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Identifier: &decorateChargerIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decorateChargerMeterImpl{
+				meter: meter,
+			},
+			MeterCurrent: &decorateChargerMeterCurrentImpl{
+				meterCurrent: meterCurrent,
+			},
+			PhaseSwitcher: &decorateChargerPhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+		}
+
+	case chargeRater == nil && chargerEx != nil && diagnosis == nil && identifier != nil && meter == nil && meterCurrent != nil && meterEnergy != nil && phaseSwitcher != nil:
+		return &struct {
+			api.Charger
+			api.ChargerEx
+			api.Identifier
+			api.MeterCurrent
+			api.MeterEnergy
+			api.PhaseSwitcher
+		}{
+			Charger: base,
+			ChargerEx: &decorateChargerChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Identifier: &decorateChargerIdentifierImpl{
+				identifier: identifier,
+			},
+			MeterCurrent: &decorateChargerMeterCurrentImpl{
+				meterCurrent: meterCurrent,
+			},
+			MeterEnergy: &decorateChargerMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseSwitcher: &decorateChargerPhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+		}
+
+	case chargeRater == nil && chargerEx != nil && diagnosis == nil && identifier != nil && meter != nil && meterCurrent != nil && meterEnergy != nil && phaseSwitcher != nil:
+		return &struct {
+			api.Charger
+			api.ChargerEx
+			api.Identifier
+			api.Meter
+			api.MeterCurrent
+			api.MeterEnergy
+			api.PhaseSwitcher
+		}{
+			Charger: base,
+			ChargerEx: &decorateChargerChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Identifier: &decorateChargerIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decorateChargerMeterImpl{
+				meter: meter,
+			},
+			MeterCurrent: &decorateChargerMeterCurrentImpl{
+				meterCurrent: meterCurrent,
+			},
+			MeterEnergy: &decorateChargerMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseSwitcher: &decorateChargerPhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+		}
+
+	case chargeRater != nil && chargerEx == nil && diagnosis == nil && identifier != nil && meter == nil && meterCurrent == nil && meterEnergy == nil && phaseSwitcher == nil:
+		return &struct {
+			api.Charger
+			api.ChargeRater
+			api.Identifier
+		}{
+			Charger: base,
+			ChargeRater: &decorateChargerChargeRaterImpl{
+				chargeRater: chargeRater,
+			},
+			Identifier: &decorateChargerIdentifierImpl{
+				identifier: identifier,
+			},
+		}
+
+	case chargeRater != nil && chargerEx == nil && diagnosis == nil && identifier != nil && meter != nil && meterCurrent == nil && meterEnergy == nil && phaseSwitcher == nil:
+		return &struct {
+			api.Charger
+			api.ChargeRater
+			api.Identifier
+			api.Meter
+		}{
+			Charger: base,
+			ChargeRater: &decorateChargerChargeRaterImpl{
+				chargeRater: chargeRater,
+			},
+			Identifier: &decorateChargerIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decorateChargerMeterImpl{
+				meter: meter,
+			},
+		}
+
+	case chargeRater != nil && chargerEx == nil && diagnosis == nil && identifier != nil && meter == nil && meterCurrent == nil && meterEnergy != nil && phaseSwitcher == nil:
+		return &struct {
+			api.Charger
+			api.ChargeRater
+			api.Identifier
+			api.MeterEnergy
+		}{
+			Charger: base,
+			ChargeRater: &decorateChargerChargeRaterImpl{
+				chargeRater: chargeRater,
+			},
+			Identifier: &decorateChargerIdentifierImpl{
+				identifier: identifier,
+			},
+			MeterEnergy: &decorateChargerMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+		}
+
+	case chargeRater != nil && chargerEx == nil && diagnosis == nil && identifier != nil && meter != nil && meterCurrent == nil && meterEnergy != nil && phaseSwitcher == nil:
+		return &struct {
+			api.Charger
+			api.ChargeRater
+			api.Identifier
+			api.Meter
+			api.MeterEnergy
+		}{
+			Charger: base,
+			ChargeRater: &decorateChargerChargeRaterImpl{
+				chargeRater: chargeRater,
+			},
+			Identifier: &decorateChargerIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decorateChargerMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decorateChargerMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+		}
+
+	case chargeRater != nil && chargerEx == nil && diagnosis == nil && identifier != nil && meter == nil && meterCurrent != nil && meterEnergy == nil && phaseSwitcher == nil:
+		return &struct {
+			api.Charger
+			api.ChargeRater
+			api.Identifier
+			api.MeterCurrent
+		}{
+			Charger: base,
+			ChargeRater: &decorateChargerChargeRaterImpl{
+				chargeRater: chargeRater,
+			},
+			Identifier: &decorateChargerIdentifierImpl{
+				identifier: identifier,
+			},
+			MeterCurrent: &decorateChargerMeterCurrentImpl{
+				meterCurrent: meterCurrent,
+			},
+		}
+
+	case chargeRater != nil && chargerEx == nil && diagnosis == nil && identifier != nil && meter != nil && meterCurrent != nil && meterEnergy == nil && phaseSwitcher == nil:
+		return &struct {
+			api.Charger
+			api.ChargeRater
+			api.Identifier
+			api.Meter
+			api.MeterCurrent
+		}{
+			Charger: base,
+			ChargeRater: &decorateChargerChargeRaterImpl{
+				chargeRater: chargeRater,
+			},
+			Identifier: &decorateChargerIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decorateChargerMeterImpl{
+				meter: meter,
+			},
+			MeterCurrent: &decorateChargerMeterCurrentImpl{
+				meterCurrent: meterCurrent,
+			},
+		}
+
+	case chargeRater != nil && chargerEx == nil && diagnosis == nil && identifier != nil && meter == nil && meterCurrent != nil && meterEnergy != nil && phaseSwitcher == nil:
+		return &struct {
+			api.Charger
+			api.ChargeRater
+			api.Identifier
+			api.MeterCurrent
+			api.MeterEnergy
+		}{
+			Charger: base,
+			ChargeRater: &decorateChargerChargeRaterImpl{
+				chargeRater: chargeRater,
+			},
+			Identifier: &decorateChargerIdentifierImpl{
+				identifier: identifier,
+			},
+			MeterCurrent: &decorateChargerMeterCurrentImpl{
+				meterCurrent: meterCurrent,
+			},
+			MeterEnergy: &decorateChargerMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+		}
+
+	case chargeRater != nil && chargerEx == nil && diagnosis == nil && identifier != nil && meter != nil && meterCurrent != nil && meterEnergy != nil && phaseSwitcher == nil:
+		return &struct {
+			api.Charger
+			api.ChargeRater
+			api.Identifier
+			api.Meter
+			api.MeterCurrent
+			api.MeterEnergy
+		}{
+			Charger: base,
+			ChargeRater: &decorateChargerChargeRaterImpl{
+				chargeRater: chargeRater,
+			},
+			Identifier: &decorateChargerIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decorateChargerMeterImpl{
+				meter: meter,
+			},
+			MeterCurrent: &decorateChargerMeterCurrentImpl{
+				meterCurrent: meterCurrent,
+			},
+			MeterEnergy: &decorateChargerMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+		}
+
+	case chargeRater != nil && chargerEx != nil && diagnosis == nil && identifier != nil && meter == nil && meterCurrent == nil && meterEnergy == nil && phaseSwitcher == nil:
+		return &struct {
+			api.Charger
+			api.ChargeRater
+			api.ChargerEx
+			api.Identifier
+		}{
+			Charger: base,
+			ChargeRater: &decorateChargerChargeRaterImpl{
+				chargeRater: chargeRater,
+			},
+			ChargerEx: &decorateChargerChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Identifier: &decorateChargerIdentifierImpl{
+				identifier: identifier,
+			},
+		}
+
+	case chargeRater != nil && chargerEx != nil && diagnosis == nil && identifier != nil && meter != nil && meterCurrent == nil && meterEnergy == nil && phaseSwitcher == nil:
+		return &struct {
+			api.Charger
+			api.ChargeRater
+			api.ChargerEx
+			api.Identifier
+			api.Meter
+		}{
+			Charger: base,
+			ChargeRater: &decorateChargerChargeRaterImpl{
+				chargeRater: chargeRater,
+			},
+			ChargerEx: &decorateChargerChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Identifier: &decorateChargerIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decorateChargerMeterImpl{
+				meter: meter,
+			},
+		}
+
+	case chargeRater != nil && chargerEx != nil && diagnosis == nil && identifier != nil && meter == nil && meterCurrent == nil && meterEnergy != nil && phaseSwitcher == nil:
+		return &struct {
+			api.Charger
+			api.ChargeRater
+			api.ChargerEx
+			api.Identifier
+			api.MeterEnergy
+		}{
+			Charger: base,
+			ChargeRater: &decorateChargerChargeRaterImpl{
+				chargeRater: chargeRater,
+			},
+			ChargerEx: &decorateChargerChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Identifier: &decorateChargerIdentifierImpl{
+				identifier: identifier,
+			},
+			MeterEnergy: &decorateChargerMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+		}
+
+	case chargeRater != nil && chargerEx != nil && diagnosis == nil && identifier != nil && meter != nil && meterCurrent == nil && meterEnergy != nil && phaseSwitcher == nil:
+		return &struct {
+			api.Charger
+			api.ChargeRater
+			api.ChargerEx
+			api.Identifier
+			api.Meter
+			api.MeterEnergy
+		}{
+			Charger: base,
+			ChargeRater: &decorateChargerChargeRaterImpl{
+				chargeRater: chargeRater,
+			},
+			ChargerEx: &decorateChargerChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Identifier: &decorateChargerIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decorateChargerMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decorateChargerMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+		}
+
+	case chargeRater != nil && chargerEx != nil && diagnosis == nil && identifier != nil && meter == nil && meterCurrent != nil && meterEnergy == nil && phaseSwitcher == nil:
+		return &struct {
+			api.Charger
+			api.ChargeRater
+			api.ChargerEx
+			api.Identifier
+			api.MeterCurrent
+		}{
+			Charger: base,
+			ChargeRater: &decorateChargerChargeRaterImpl{
+				chargeRater: chargeRater,
+			},
+			ChargerEx: &decorateChargerChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Identifier: &decorateChargerIdentifierImpl{
+				identifier: identifier,
+			},
+			MeterCurrent: &decorateChargerMeterCurrentImpl{
+				meterCurrent: meterCurrent,
+			},
+		}
+
+	case chargeRater != nil && chargerEx != nil && diagnosis == nil && identifier != nil && meter != nil && meterCurrent != nil && meterEnergy == nil && phaseSwitcher == nil:
+		return &struct {
+			api.Charger
+			api.ChargeRater
+			api.ChargerEx
+			api.Identifier
+			api.Meter
+			api.MeterCurrent
+		}{
+			Charger: base,
+			ChargeRater: &decorateChargerChargeRaterImpl{
+				chargeRater: chargeRater,
+			},
+			ChargerEx: &decorateChargerChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Identifier: &decorateChargerIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decorateChargerMeterImpl{
+				meter: meter,
+			},
+			MeterCurrent: &decorateChargerMeterCurrentImpl{
+				meterCurrent: meterCurrent,
+			},
+		}
+
+	case chargeRater != nil && chargerEx != nil && diagnosis == nil && identifier != nil && meter == nil && meterCurrent != nil && meterEnergy != nil && phaseSwitcher == nil:
+		return &struct {
+			api.Charger
+			api.ChargeRater
+			api.ChargerEx
+			api.Identifier
+			api.MeterCurrent
+			api.MeterEnergy
+		}{
+			Charger: base,
+			ChargeRater: &decorateChargerChargeRaterImpl{
+				chargeRater: chargeRater,
+			},
+			ChargerEx: &decorateChargerChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Identifier: &decorateChargerIdentifierImpl{
+				identifier: identifier,
+			},
+			MeterCurrent: &decorateChargerMeterCurrentImpl{
+				meterCurrent: meterCurrent,
+			},
+			MeterEnergy: &decorateChargerMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+		}
+
+	case chargeRater != nil && chargerEx != nil && diagnosis == nil && identifier != nil && meter != nil && meterCurrent != nil && meterEnergy != nil && phaseSwitcher == nil:
+		return &struct {
+			api.Charger
+			api.ChargeRater
+			api.ChargerEx
+			api.Identifier
+			api.Meter
+			api.MeterCurrent
+			api.MeterEnergy
+		}{
+			Charger: base,
+			ChargeRater: &decorateChargerChargeRaterImpl{
+				chargeRater: chargeRater,
+			},
+			ChargerEx: &decorateChargerChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Identifier: &decorateChargerIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decorateChargerMeterImpl{
+				meter: meter,
+			},
+			MeterCurrent: &decorateChargerMeterCurrentImpl{
+				meterCurrent: meterCurrent,
+			},
+			MeterEnergy: &decorateChargerMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+		}
+
+	case chargeRater != nil && chargerEx == nil && diagnosis == nil && identifier != nil && meter == nil && meterCurrent == nil && meterEnergy == nil && phaseSwitcher != nil:
+		return &struct {
+			api.Charger
+			api.ChargeRater
+			api.Identifier
+			api.PhaseSwitcher
+		}{
+			Charger: base,
+			ChargeRater: &decorateChargerChargeRaterImpl{
+				chargeRater: chargeRater,
+			},
+			Identifier: &decorateChargerIdentifierImpl{
+				identifier: identifier,
+			},
+			PhaseSwitcher: &decorateChargerPhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+		}
+
+	case chargeRater != nil && chargerEx == nil && diagnosis == nil && identifier != nil && meter != nil && meterCurrent == nil && meterEnergy == nil && phaseSwitcher != nil:
+		return &struct {
+			api.Charger
+			api.ChargeRater
+			api.Identifier
+			api.Meter
+			api.PhaseSwitcher
+		}{
+			Charger: base,
+			ChargeRater: &decorateChargerChargeRaterImpl{
+				chargeRater: chargeRater,
+			},
+			Identifier: &decorateChargerIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decorateChargerMeterImpl{
+				meter: meter,
+			},
+			PhaseSwitcher: &decorateChargerPhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+		}
+
+	case chargeRater != nil && chargerEx == nil && diagnosis == nil && identifier != nil && meter == nil && meterCurrent == nil && meterEnergy != nil && phaseSwitcher != nil:
+		return &struct {
+			api.Charger
+			api.ChargeRater
+			api.Identifier
+			api.MeterEnergy
+			api.PhaseSwitcher
+		}{
+			Charger: base,
+			ChargeRater: &decorateChargerChargeRaterImpl{
+				chargeRater: chargeRater,
+			},
+			Identifier: &decorateChargerIdentifierImpl{
+				identifier: identifier,
+			},
+			MeterEnergy: &decorateChargerMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseSwitcher: &decorateChargerPhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+		}
+
+	case chargeRater != nil && chargerEx == nil && diagnosis == nil && identifier != nil && meter != nil && meterCurrent == nil && meterEnergy != nil && phaseSwitcher != nil:
+		return &struct {
+			api.Charger
+			api.ChargeRater
+			api.Identifier
+			api.Meter
+			api.MeterEnergy
+			api.PhaseSwitcher
+		}{
+			Charger: base,
+			ChargeRater: &decorateChargerChargeRaterImpl{
+				chargeRater: chargeRater,
+			},
+			Identifier: &decorateChargerIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decorateChargerMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decorateChargerMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseSwitcher: &decorateChargerPhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+		}
+
+	case chargeRater != nil && chargerEx == nil && diagnosis == nil && identifier != nil && meter == nil && meterCurrent != nil && meterEnergy == nil && phaseSwitcher != nil:
+		return &struct {
+			api.Charger
+			api.ChargeRater
+			api.Identifier
+			api.MeterCurrent
+			api.PhaseSwitcher
+		}{
+			Charger: base,
+			ChargeRater: &decorateChargerChargeRaterImpl{
+				chargeRater: chargeRater,
+			},
+			Identifier: &decorateChargerIdentifierImpl{
+				identifier: identifier,
+			},
+			MeterCurrent: &decorateChargerMeterCurrentImpl{
+				meterCurrent: meterCurrent,
+			},
+			PhaseSwitcher: &decorateChargerPhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+		}
+
+	case chargeRater != nil && chargerEx == nil && diagnosis == nil && identifier != nil && meter != nil && meterCurrent != nil && meterEnergy == nil && phaseSwitcher != nil:
+		return &struct {
+			api.Charger
+			api.ChargeRater
+			api.Identifier
+			api.Meter
+			api.MeterCurrent
+			api.PhaseSwitcher
+		}{
+			Charger: base,
+			ChargeRater: &decorateChargerChargeRaterImpl{
+				chargeRater: chargeRater,
+			},
+			Identifier: &decorateChargerIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decorateChargerMeterImpl{
+				meter: meter,
+			},
+			MeterCurrent: &decorateChargerMeterCurrentImpl{
+				meterCurrent: meterCurrent,
+			},
+			PhaseSwitcher: &decorateChargerPhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+		}
+
+	case chargeRater != nil && chargerEx == nil && diagnosis == nil && identifier != nil && meter == nil && meterCurrent != nil && meterEnergy != nil && phaseSwitcher != nil:
+		return &struct {
+			api.Charger
+			api.ChargeRater
+			api.Identifier
+			api.MeterCurrent
+			api.MeterEnergy
+			api.PhaseSwitcher
+		}{
+			Charger: base,
+			ChargeRater: &decorateChargerChargeRaterImpl{
+				chargeRater: chargeRater,
+			},
+			Identifier: &decorateChargerIdentifierImpl{
+				identifier: identifier,
+			},
+			MeterCurrent: &decorateChargerMeterCurrentImpl{
+				meterCurrent: meterCurrent,
+			},
+			MeterEnergy: &decorateChargerMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseSwitcher: &decorateChargerPhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+		}
+
+	case chargeRater != nil && chargerEx == nil && diagnosis == nil && identifier != nil && meter != nil && meterCurrent != nil && meterEnergy != nil && phaseSwitcher != nil:
+		return &struct {
+			api.Charger
+			api.ChargeRater
+			api.Identifier
+			api.Meter
+			api.MeterCurrent
+			api.MeterEnergy
+			api.PhaseSwitcher
+		}{
+			Charger: base,
+			ChargeRater: &decorateChargerChargeRaterImpl{
+				chargeRater: chargeRater,
+			},
+			Identifier: &decorateChargerIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decorateChargerMeterImpl{
+				meter: meter,
+			},
+			MeterCurrent: &decorateChargerMeterCurrentImpl{
+				meterCurrent: meterCurrent,
+			},
+			MeterEnergy: &decorateChargerMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseSwitcher: &decorateChargerPhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+		}
+
+	case chargeRater != nil && chargerEx != nil && diagnosis == nil && identifier != nil && meter == nil && meterCurrent == nil && meterEnergy == nil && phaseSwitcher != nil:
+		return &struct {
+			api.Charger
+			api.ChargeRater
+			api.ChargerEx
+			api.Identifier
+			api.PhaseSwitcher
+		}{
+			Charger: base,
+			ChargeRater: &decorateChargerChargeRaterImpl{
+				chargeRater: chargeRater,
+			},
+			ChargerEx: &decorateChargerChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Identifier: &decorateChargerIdentifierImpl{
+				identifier: identifier,
+			},
+			PhaseSwitcher: &decorateChargerPhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+		}
+
+	case chargeRater != nil && chargerEx != nil && diagnosis == nil && identifier != nil && meter != nil && meterCurrent == nil && meterEnergy == nil && phaseSwitcher != nil:
+		return &struct {
+			api.Charger
+			api.ChargeRater
+			api.ChargerEx
+			api.Identifier
+			api.Meter
+			api.PhaseSwitcher
+		}{
+			Charger: base,
+			ChargeRater: &decorateChargerChargeRaterImpl{
+				chargeRater: chargeRater,
+			},
+			ChargerEx: &decorateChargerChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Identifier: &decorateChargerIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decorateChargerMeterImpl{
+				meter: meter,
+			},
+			PhaseSwitcher: &decorateChargerPhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+		}
+
+	case chargeRater != nil && chargerEx != nil && diagnosis == nil && identifier != nil && meter == nil && meterCurrent == nil && meterEnergy != nil && phaseSwitcher != nil:
+		return &struct {
+			api.Charger
+			api.ChargeRater
+			api.ChargerEx
+			api.Identifier
+			api.MeterEnergy
+			api.PhaseSwitcher
+		}{
+			Charger: base,
+			ChargeRater: &decorateChargerChargeRaterImpl{
+				chargeRater: chargeRater,
+			},
+			ChargerEx: &decorateChargerChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Identifier: &decorateChargerIdentifierImpl{
+				identifier: identifier,
+			},
+			MeterEnergy: &decorateChargerMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseSwitcher: &decorateChargerPhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+		}
+
+	case chargeRater != nil && chargerEx != nil && diagnosis == nil && identifier != nil && meter != nil && meterCurrent == nil && meterEnergy != nil && phaseSwitcher != nil:
+		return &struct {
+			api.Charger
+			api.ChargeRater
+			api.ChargerEx
+			api.Identifier
+			api.Meter
+			api.MeterEnergy
+			api.PhaseSwitcher
+		}{
+			Charger: base,
+			ChargeRater: &decorateChargerChargeRaterImpl{
+				chargeRater: chargeRater,
+			},
+			ChargerEx: &decorateChargerChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Identifier: &decorateChargerIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decorateChargerMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decorateChargerMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseSwitcher: &decorateChargerPhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+		}
+
+	case chargeRater != nil && chargerEx != nil && diagnosis == nil && identifier != nil && meter == nil && meterCurrent != nil && meterEnergy == nil && phaseSwitcher != nil:
+		return &struct {
+			api.Charger
+			api.ChargeRater
+			api.ChargerEx
+			api.Identifier
+			api.MeterCurrent
+			api.PhaseSwitcher
+		}{
+			Charger: base,
+			ChargeRater: &decorateChargerChargeRaterImpl{
+				chargeRater: chargeRater,
+			},
+			ChargerEx: &decorateChargerChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Identifier: &decorateChargerIdentifierImpl{
+				identifier: identifier,
+			},
+			MeterCurrent: &decorateChargerMeterCurrentImpl{
+				meterCurrent: meterCurrent,
+			},
+			PhaseSwitcher: &decorateChargerPhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+		}
+
+	case chargeRater != nil && chargerEx != nil && diagnosis == nil && identifier != nil && meter != nil && meterCurrent != nil && meterEnergy == nil && phaseSwitcher != nil:
+		return &struct {
+			api.Charger
+			api.ChargeRater
+			api.ChargerEx
+			api.Identifier
+			api.Meter
+			api.MeterCurrent
+			api.PhaseSwitcher
+		}{
+			Charger: base,
+			ChargeRater: &decorateChargerChargeRaterImpl{
+				chargeRater: chargeRater,
+			},
+			ChargerEx: &decorateChargerChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Identifier: &decorateChargerIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decorateChargerMeterImpl{
+				meter: meter,
+			},
+			MeterCurrent: &decorateChargerMeterCurrentImpl{
+				meterCurrent: meterCurrent,
+			},
+			PhaseSwitcher: &decorateChargerPhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+		}
+
+	case chargeRater != nil && chargerEx != nil && diagnosis == nil && identifier != nil && meter == nil && meterCurrent != nil && meterEnergy != nil && phaseSwitcher != nil:
+		return &struct {
+			api.Charger
+			api.ChargeRater
+			api.ChargerEx
+			api.Identifier
+			api.MeterCurrent
+			api.MeterEnergy
+			api.PhaseSwitcher
+		}{
+			Charger: base,
+			ChargeRater: &decorateChargerChargeRaterImpl{
+				chargeRater: chargeRater,
+			},
+			ChargerEx: &decorateChargerChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Identifier: &decorateChargerIdentifierImpl{
+				identifier: identifier,
+			},
+			MeterCurrent: &decorateChargerMeterCurrentImpl{
+				meterCurrent: meterCurrent,
+			},
+			MeterEnergy: &decorateChargerMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseSwitcher: &decorateChargerPhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+		}
+
+	case chargeRater != nil && chargerEx != nil && diagnosis == nil && identifier != nil && meter != nil && meterCurrent != nil && meterEnergy != nil && phaseSwitcher != nil:
+		return &struct {
+			api.Charger
+			api.ChargeRater
+			api.ChargerEx
+			api.Identifier
+			api.Meter
+			api.MeterCurrent
+			api.MeterEnergy
+			api.PhaseSwitcher
+		}{
+			Charger: base,
+			ChargeRater: &decorateChargerChargeRaterImpl{
+				chargeRater: chargeRater,
+			},
+			ChargerEx: &decorateChargerChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Identifier: &decorateChargerIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decorateChargerMeterImpl{
+				meter: meter,
+			},
+			MeterCurrent: &decorateChargerMeterCurrentImpl{
+				meterCurrent: meterCurrent,
+			},
+			MeterEnergy: &decorateChargerMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseSwitcher: &decorateChargerPhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+		}
+
+	case chargeRater == nil && chargerEx == nil && diagnosis != nil && identifier == nil && meter == nil && meterCurrent == nil && meterEnergy == nil && phaseSwitcher == nil:
+		return &struct {
+			api.Charger
+			api.Diagnosis
+		}{
+			Charger: base,
+			Diagnosis: &decorateChargerDiagnosisImpl{
+				diagnosis: diagnosis,
+			},
+		}
+
+	case chargeRater == nil && chargerEx == nil && diagnosis != nil && identifier == nil && meter != nil && meterCurrent == nil && meterEnergy == nil && phaseSwitcher == nil:
+		return &struct {
+			api.Charger
+			api.Diagnosis
+			api.Meter
+		}{
+			Charger: base,
+			Diagnosis: &decorateChargerDiagnosisImpl{
+				diagnosis: diagnosis,
+			},
+			Meter: &decorateChargerMeterImpl{
+				meter: meter,
+			},
+		}
+
+	case chargeRater == nil && chargerEx == nil && diagnosis != nil && identifier == nil && meter == nil && meterCurrent == nil && meterEnergy != nil && phaseSwitcher == nil:
+		return &struct {
+			api.Charger
+			api.Diagnosis
+			api.MeterEnergy
+		}{
+			Charger: base,
+			Diagnosis: &decorateChargerDiagnosisImpl{
+				diagnosis: diagnosis,
+			},
+			MeterEnergy: &decorateChargerMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+		}
+
+	case chargeRater == nil && chargerEx == nil && diagnosis != nil && identifier == nil && meter != nil && meterCurrent == nil && meterEnergy != nil && phaseSwitcher == nil:
+		return &struct {
+			api.Charger
+			api.Diagnosis
+			api.Meter
+			api.MeterEnergy
+		}{
+			Charger: base,
+			Diagnosis: &decorateChargerDiagnosisImpl{
+				diagnosis: diagnosis,
+			},
+			Meter: &decorateChargerMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decorateChargerMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+		}
+
+	case chargeRater == nil && chargerEx == nil && diagnosis != nil && identifier == nil && meter == nil && meterCurrent != nil && meterEnergy == nil && phaseSwitcher == nil:
+		return &struct {
+			api.Charger
+			api.Diagnosis
+			api.MeterCurrent
+		}{
+			Charger: base,
+			Diagnosis: &decorateChargerDiagnosisImpl{
+				diagnosis: diagnosis,
+			},
+			MeterCurrent: &decorateChargerMeterCurrentImpl{
+				meterCurrent: meterCurrent,
+			},
+		}
+
+	case chargeRater == nil && chargerEx == nil && diagnosis != nil && identifier == nil && meter != nil && meterCurrent != nil && meterEnergy == nil && phaseSwitcher == nil:
+		return &struct {
+			api.Charger
+			api.Diagnosis
+			api.Meter
+			api.MeterCurrent
+		}{
+			Charger: base,
+			Diagnosis: &decorateChargerDiagnosisImpl{
+				diagnosis: diagnosis,
+			},
+			Meter: &decorateChargerMeterImpl{
+				meter: meter,
+			},
+			MeterCurrent: &decorateChargerMeterCurrentImpl{
+				meterCurrent: meterCurrent,
+			},
+		}
+
+	case chargeRater == nil && chargerEx == nil && diagnosis != nil && identifier == nil && meter == nil && meterCurrent != nil && meterEnergy != nil && phaseSwitcher == nil:
+		return &struct {
+			api.Charger
+			api.Diagnosis
+			api.MeterCurrent
+			api.MeterEnergy
+		}{
+			Charger: base,
+			Diagnosis: &decorateChargerDiagnosisImpl{
+				diagnosis: diagnosis,
+			},
+			MeterCurrent: &decorateChargerMeterCurrentImpl{
+				meterCurrent: meterCurrent,
+			},
+			MeterEnergy: &decorateChargerMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+		}
+
+	case chargeRater == nil && chargerEx == nil && diagnosis != nil && identifier == nil && meter != nil && meterCurrent != nil && meterEnergy != nil && phaseSwitcher == nil:
+		return &struct {
+			api.Charger
+			api.Diagnosis
+			api.Meter
+			api.MeterCurrent
+			api.MeterEnergy
+		}{
+			Charger: base,
+			Diagnosis: &decorateChargerDiagnosisImpl{
+				diagnosis: diagnosis,
+			},
+			Meter: &decorateChargerMeterImpl{
+				meter: meter,
+			},
+			MeterCurrent: &decorateChargerMeterCurrentImpl{
+				meterCurrent: meterCurrent,
+			},
+			MeterEnergy: &decorateChargerMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+		}
+
+	case chargeRater == nil && chargerEx != nil && diagnosis != nil && identifier == nil && meter == nil && meterCurrent == nil && meterEnergy == nil && phaseSwitcher == nil:
+		return &struct {
+			api.Charger
+			api.ChargerEx
+			api.Diagnosis
+		}{
+			Charger: base,
+			ChargerEx: &decorateChargerChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Diagnosis: &decorateChargerDiagnosisImpl{
+				diagnosis: diagnosis,
+			},
+		}
+
+	case chargeRater == nil && chargerEx != nil && diagnosis != nil && identifier == nil && meter != nil && meterCurrent == nil && meterEnergy == nil && phaseSwitcher == nil:
+		return &struct {
+			api.Charger
+			api.ChargerEx
+			api.Diagnosis
+			api.Meter
+		}{
+			Charger: base,
+			ChargerEx: &decorateChargerChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Diagnosis: &decorateChargerDiagnosisImpl{
+				diagnosis: diagnosis,
+			},
+			Meter: &decorateChargerMeterImpl{
+				meter: meter,
+			},
+		}
+
+	case chargeRater == nil && chargerEx != nil && diagnosis != nil && identifier == nil && meter == nil && meterCurrent == nil && meterEnergy != nil && phaseSwitcher == nil:
+		return &struct {
+			api.Charger
+			api.ChargerEx
+			api.Diagnosis
+			api.MeterEnergy
+		}{
+			Charger: base,
+			ChargerEx: &decorateChargerChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Diagnosis: &decorateChargerDiagnosisImpl{
+				diagnosis: diagnosis,
+			},
+			MeterEnergy: &decorateChargerMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+		}
+
+	case chargeRater == nil && chargerEx != nil && diagnosis != nil && identifier == nil && meter != nil && meterCurrent == nil && meterEnergy != nil && phaseSwitcher == nil:
+		return &struct {
+			api.Charger
+			api.ChargerEx
+			api.Diagnosis
+			api.Meter
+			api.MeterEnergy
+		}{
+			Charger: base,
+			ChargerEx: &decorateChargerChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Diagnosis: &decorateChargerDiagnosisImpl{
+				diagnosis: diagnosis,
+			},
+			Meter: &decorateChargerMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decorateChargerMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+		}
+
+	case chargeRater == nil && chargerEx != nil && diagnosis != nil && identifier == nil && meter == nil && meterCurrent != nil && meterEnergy == nil && phaseSwitcher == nil:
+		return &struct {
+			api.Charger
+			api.ChargerEx
+			api.Diagnosis
+			api.MeterCurrent
+		}{
+			Charger: base,
+			ChargerEx: &decorateChargerChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Diagnosis: &decorateChargerDiagnosisImpl{
+				diagnosis: diagnosis,
+			},
+			MeterCurrent: &decorateChargerMeterCurrentImpl{
+				meterCurrent: meterCurrent,
+			},
+		}
+
+	case chargeRater == nil && chargerEx != nil && diagnosis != nil && identifier == nil && meter != nil && meterCurrent != nil && meterEnergy == nil && phaseSwitcher == nil:
+		return &struct {
+			api.Charger
+			api.ChargerEx
+			api.Diagnosis
+			api.Meter
+			api.MeterCurrent
+		}{
+			Charger: base,
+			ChargerEx: &decorateChargerChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Diagnosis: &decorateChargerDiagnosisImpl{
+				diagnosis: diagnosis,
+			},
+			Meter: &decorateChargerMeterImpl{
+				meter: meter,
+			},
+			MeterCurrent: &decorateChargerMeterCurrentImpl{
+				meterCurrent: meterCurrent,
+			},
+		}
+
+	case chargeRater == nil && chargerEx != nil && diagnosis != nil && identifier == nil && meter == nil && meterCurrent != nil && meterEnergy != nil && phaseSwitcher == nil:
+		return &struct {
+			api.Charger
+			api.ChargerEx
+			api.Diagnosis
+			api.MeterCurrent
+			api.MeterEnergy
+		}{
+			Charger: base,
+			ChargerEx: &decorateChargerChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Diagnosis: &decorateChargerDiagnosisImpl{
+				diagnosis: diagnosis,
+			},
+			MeterCurrent: &decorateChargerMeterCurrentImpl{
+				meterCurrent: meterCurrent,
+			},
+			MeterEnergy: &decorateChargerMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+		}
+
+	case chargeRater == nil && chargerEx != nil && diagnosis != nil && identifier == nil && meter != nil && meterCurrent != nil && meterEnergy != nil && phaseSwitcher == nil:
+		return &struct {
+			api.Charger
+			api.ChargerEx
+			api.Diagnosis
+			api.Meter
+			api.MeterCurrent
+			api.MeterEnergy
+		}{
+			Charger: base,
+			ChargerEx: &decorateChargerChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Diagnosis: &decorateChargerDiagnosisImpl{
+				diagnosis: diagnosis,
+			},
+			Meter: &decorateChargerMeterImpl{
+				meter: meter,
+			},
+			MeterCurrent: &decorateChargerMeterCurrentImpl{
+				meterCurrent: meterCurrent,
+			},
+			MeterEnergy: &decorateChargerMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+		}
+
+	case chargeRater == nil && chargerEx == nil && diagnosis != nil && identifier == nil && meter == nil && meterCurrent == nil && meterEnergy == nil && phaseSwitcher != nil:
+		return &struct {
+			api.Charger
+			api.Diagnosis
+			api.PhaseSwitcher
+		}{
+			Charger: base,
+			Diagnosis: &decorateChargerDiagnosisImpl{
+				diagnosis: diagnosis,
+			},
+			PhaseSwitcher: &decorateChargerPhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+		}
+
+	case chargeRater == nil && chargerEx == nil && diagnosis != nil && identifier == nil && meter != nil && meterCurrent == nil && meterEnergy == nil && phaseSwitcher != nil:
+		return &struct {
+			api.Charger
+			api.Diagnosis
+			api.Meter
+			api.PhaseSwitcher
+		}{
+			Charger: base,
+			Diagnosis: &decorateChargerDiagnosisImpl{
+				diagnosis: diagnosis,
+			},
+			Meter: &decorateChargerMeterImpl{
+				meter: meter,
+			},
+			PhaseSwitcher: &decorateChargerPhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+		}
+
+	case chargeRater == nil && chargerEx == nil && diagnosis != nil && identifier == nil && meter == nil && meterCurrent == nil && meterEnergy != nil && phaseSwitcher != nil:
+		return &struct {
+			api.Charger
+			api.Diagnosis
+			api.MeterEnergy
+			api.PhaseSwitcher
+		}{
+			Charger: base,
+			Diagnosis: &decorateChargerDiagnosisImpl{
+				diagnosis: diagnosis,
+			},
+			MeterEnergy: &decorateChargerMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseSwitcher: &decorateChargerPhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+		}
+
+	case chargeRater == nil && chargerEx == nil && diagnosis != nil && identifier == nil && meter != nil && meterCurrent == nil && meterEnergy != nil && phaseSwitcher != nil:
+		return &struct {
+			api.Charger
+			api.Diagnosis
+			api.Meter
+			api.MeterEnergy
+			api.PhaseSwitcher
+		}{
+			Charger: base,
+			Diagnosis: &decorateChargerDiagnosisImpl{
+				diagnosis: diagnosis,
+			},
+			Meter: &decorateChargerMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decorateChargerMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseSwitcher: &decorateChargerPhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+		}
+
+	case chargeRater == nil && chargerEx == nil && diagnosis != nil && identifier == nil && meter == nil && meterCurrent != nil && meterEnergy == nil && phaseSwitcher != nil:
+		return &struct {
+			api.Charger
+			api.Diagnosis
+			api.MeterCurrent
+			api.PhaseSwitcher
+		}{
+			Charger: base,
+			Diagnosis: &decorateChargerDiagnosisImpl{
+				diagnosis: diagnosis,
+			},
+			MeterCurrent: &decorateChargerMeterCurrentImpl{
+				meterCurrent: meterCurrent,
+			},
+			PhaseSwitcher: &decorateChargerPhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+		}
+
+	case chargeRater == nil && chargerEx == nil && diagnosis != nil && identifier == nil && meter != nil && meterCurrent != nil && meterEnergy == nil && phaseSwitcher != nil:
+		return &struct {
+			api.Charger
+			api.Diagnosis
+			api.Meter
+			api.MeterCurrent
+			api.PhaseSwitcher
+		}{
+			Charger: base,
+			Diagnosis: &decorateChargerDiagnosisImpl{
+				diagnosis: diagnosis,
+			},
+			Meter: &decorateChargerMeterImpl{
+				meter: meter,
+			},
+			MeterCurrent: &decorateChargerMeterCurrentImpl{
+				meterCurrent: meterCurrent,
+			},
+			PhaseSwitcher: &decorateChargerPhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+		}
+
+	case chargeRater == nil && chargerEx == nil && diagnosis != nil && identifier == nil && meter == nil && meterCurrent != nil && meterEnergy != nil && phaseSwitcher != nil:
+		return &struct {
+			api.Charger
+			api.Diagnosis
+			api.MeterCurrent
+			api.MeterEnergy
+			api.PhaseSwitcher
+		}{
+			Charger: base,
+			Diagnosis: &decorateChargerDiagnosisImpl{
+				diagnosis: diagnosis,
+			},
+			MeterCurrent: &decorateChargerMeterCurrentImpl{
+				meterCurrent: meterCurrent,
+			},
+			MeterEnergy: &decorateChargerMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseSwitcher: &decorateChargerPhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+		}
+
+	case chargeRater == nil && chargerEx == nil && diagnosis != nil && identifier == nil && meter != nil && meterCurrent != nil && meterEnergy != nil && phaseSwitcher != nil:
+		return &struct {
+			api.Charger
+			api.Diagnosis
+			api.Meter
+			api.MeterCurrent
+			api.MeterEnergy
+			api.PhaseSwitcher
+		}{
+			Charger: base,
+			Diagnosis: &decorateChargerDiagnosisImpl{
+				diagnosis: diagnosis,
+			},
+			Meter: &decorateChargerMeterImpl{
+				meter: meter,
+			},
+			MeterCurrent: &decorateChargerMeterCurrentImpl{
+				meterCurrent: meterCurrent,
+			},
+			MeterEnergy: &decorateChargerMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseSwitcher: &decorateChargerPhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+		}
+
+	case chargeRater == nil && chargerEx != nil && diagnosis != nil && identifier == nil && meter == nil && meterCurrent == nil && meterEnergy == nil && phaseSwitcher != nil:
+		return &struct {
+			api.Charger
+			api.ChargerEx
+			api.Diagnosis
+			api.PhaseSwitcher
+		}{
+			Charger: base,
+			ChargerEx: &decorateChargerChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Diagnosis: &decorateChargerDiagnosisImpl{
+				diagnosis: diagnosis,
+			},
+			PhaseSwitcher: &decorateChargerPhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+		}
+
+	case chargeRater == nil && chargerEx != nil && diagnosis != nil && identifier == nil && meter != nil && meterCurrent == nil && meterEnergy == nil && phaseSwitcher != nil:
+		return &struct {
+			api.Charger
+			api.ChargerEx
+			api.Diagnosis
+			api.Meter
+			api.PhaseSwitcher
+		}{
+			Charger: base,
+			ChargerEx: &decorateChargerChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Diagnosis: &decorateChargerDiagnosisImpl{
+				diagnosis: diagnosis,
+			},
+			Meter: &decorateChargerMeterImpl{
+				meter: meter,
+			},
+			PhaseSwitcher: &decorateChargerPhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+		}
+
+	case chargeRater == nil && chargerEx != nil && diagnosis != nil && identifier == nil && meter == nil && meterCurrent == nil && meterEnergy != nil && phaseSwitcher != nil:
+		return &struct {
+			api.Charger
+			api.ChargerEx
+			api.Diagnosis
+			api.MeterEnergy
+			api.PhaseSwitcher
+		}{
+			Charger: base,
+			ChargerEx: &decorateChargerChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Diagnosis: &decorateChargerDiagnosisImpl{
+				diagnosis: diagnosis,
+			},
+			MeterEnergy: &decorateChargerMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseSwitcher: &decorateChargerPhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+		}
+
+	case chargeRater == nil && chargerEx != nil && diagnosis != nil && identifier == nil && meter != nil && meterCurrent == nil && meterEnergy != nil && phaseSwitcher != nil:
+		return &struct {
+			api.Charger
+			api.ChargerEx
+			api.Diagnosis
+			api.Meter
+			api.MeterEnergy
+			api.PhaseSwitcher
+		}{
+			Charger: base,
+			ChargerEx: &decorateChargerChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Diagnosis: &decorateChargerDiagnosisImpl{
+				diagnosis: diagnosis,
+			},
+			Meter: &decorateChargerMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decorateChargerMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseSwitcher: &decorateChargerPhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+		}
+
+	case chargeRater == nil && chargerEx != nil && diagnosis != nil && identifier == nil && meter == nil && meterCurrent != nil && meterEnergy == nil && phaseSwitcher != nil:
+		return &struct {
+			api.Charger
+			api.ChargerEx
+			api.Diagnosis
+			api.MeterCurrent
+			api.PhaseSwitcher
+		}{
+			Charger: base,
+			ChargerEx: &decorateChargerChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Diagnosis: &decorateChargerDiagnosisImpl{
+				diagnosis: diagnosis,
+			},
+			MeterCurrent: &decorateChargerMeterCurrentImpl{
+				meterCurrent: meterCurrent,
+			},
+			PhaseSwitcher: &decorateChargerPhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+		}
+
+	case chargeRater == nil && chargerEx != nil && diagnosis != nil && identifier == nil && meter != nil && meterCurrent != nil && meterEnergy == nil && phaseSwitcher != nil:
+		return &struct {
+			api.Charger
+			api.ChargerEx
+			api.Diagnosis
+			api.Meter
+			api.MeterCurrent
+			api.PhaseSwitcher
+		}{
+			Charger: base,
+			ChargerEx: &decorateChargerChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Diagnosis: &decorateChargerDiagnosisImpl{
+				diagnosis: diagnosis,
+			},
+			Meter: &decorateChargerMeterImpl{
+				meter: meter,
+			},
+			MeterCurrent: &decorateChargerMeterCurrentImpl{
+				meterCurrent: meterCurrent,
+			},
+			PhaseSwitcher: &decorateChargerPhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+		}
+
+	case chargeRater == nil && chargerEx != nil && diagnosis != nil && identifier == nil && meter == nil && meterCurrent != nil && meterEnergy != nil && phaseSwitcher != nil:
+		return &struct {
+			api.Charger
+			api.ChargerEx
+			api.Diagnosis
+			api.MeterCurrent
+			api.MeterEnergy
+			api.PhaseSwitcher
+		}{
+			Charger: base,
+			ChargerEx: &decorateChargerChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Diagnosis: &decorateChargerDiagnosisImpl{
+				diagnosis: diagnosis,
+			},
+			MeterCurrent: &decorateChargerMeterCurrentImpl{
+				meterCurrent: meterCurrent,
+			},
+			MeterEnergy: &decorateChargerMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseSwitcher: &decorateChargerPhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+		}
+
+	case chargeRater == nil && chargerEx != nil && diagnosis != nil && identifier == nil && meter != nil && meterCurrent != nil && meterEnergy != nil && phaseSwitcher != nil:
+		return &struct {
+			api.Charger
+			api.ChargerEx
+			api.Diagnosis
+			api.Meter
+			api.MeterCurrent
+			api.MeterEnergy
+			api.PhaseSwitcher
+		}{
+			Charger: base,
+			ChargerEx: &decorateChargerChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Diagnosis: &decorateChargerDiagnosisImpl{
+				diagnosis: diagnosis,
+			},
+			Meter: &decorateChargerMeterImpl{
+				meter: meter,
+			},
+			MeterCurrent: &decorateChargerMeterCurrentImpl{
+				meterCurrent: meterCurrent,
+			},
+			MeterEnergy: &decorateChargerMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseSwitcher: &decorateChargerPhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+		}
+
+	case chargeRater != nil && chargerEx == nil && diagnosis != nil && identifier == nil && meter == nil && meterCurrent == nil && meterEnergy == nil && phaseSwitcher == nil:
+		return &struct {
+			api.Charger
+			api.ChargeRater
+			api.Diagnosis
+		}{
+			Charger: base,
+			ChargeRater: &decorateChargerChargeRaterImpl{
+				chargeRater: chargeRater,
+			},
+			Diagnosis: &decorateChargerDiagnosisImpl{
+				diagnosis: diagnosis,
+			},
+		}
+
+	case chargeRater != nil && chargerEx == nil && diagnosis != nil && identifier == nil && meter != nil && meterCurrent == nil && meterEnergy == nil && phaseSwitcher == nil:
+		return &struct {
+			api.Charger
+			api.ChargeRater
+			api.Diagnosis
+			api.Meter
+		}{
+			Charger: base,
+			ChargeRater: &decorateChargerChargeRaterImpl{
+				chargeRater: chargeRater,
+			},
+			Diagnosis: &decorateChargerDiagnosisImpl{
+				diagnosis: diagnosis,
+			},
+			Meter: &decorateChargerMeterImpl{
+				meter: meter,
+			},
+		}
+
+	case chargeRater != nil && chargerEx == nil && diagnosis != nil && identifier == nil && meter == nil && meterCurrent == nil && meterEnergy != nil && phaseSwitcher == nil:
+		return &struct {
+			api.Charger
+			api.ChargeRater
+			api.Diagnosis
+			api.MeterEnergy
+		}{
+			Charger: base,
+			ChargeRater: &decorateChargerChargeRaterImpl{
+				chargeRater: chargeRater,
+			},
+			Diagnosis: &decorateChargerDiagnosisImpl{
+				diagnosis: diagnosis,
+			},
+			MeterEnergy: &decorateChargerMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+		}
+
+	case chargeRater != nil && chargerEx == nil && diagnosis != nil && identifier == nil && meter != nil && meterCurrent == nil && meterEnergy != nil && phaseSwitcher == nil:
+		return &struct {
+			api.Charger
+			api.ChargeRater
+			api.Diagnosis
+			api.Meter
+			api.MeterEnergy
+		}{
+			Charger: base,
+			ChargeRater: &decorateChargerChargeRaterImpl{
+				chargeRater: chargeRater,
+			},
+			Diagnosis: &decorateChargerDiagnosisImpl{
+				diagnosis: diagnosis,
+			},
+			Meter: &decorateChargerMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decorateChargerMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+		}
+
+	case chargeRater != nil && chargerEx == nil && diagnosis != nil && identifier == nil && meter == nil && meterCurrent != nil && meterEnergy == nil && phaseSwitcher == nil:
+		return &struct {
+			api.Charger
+			api.ChargeRater
+			api.Diagnosis
+			api.MeterCurrent
+		}{
+			Charger: base,
+			ChargeRater: &decorateChargerChargeRaterImpl{
+				chargeRater: chargeRater,
+			},
+			Diagnosis: &decorateChargerDiagnosisImpl{
+				diagnosis: diagnosis,
+			},
+			MeterCurrent: &decorateChargerMeterCurrentImpl{
+				meterCurrent: meterCurrent,
+			},
+		}
+
+	case chargeRater != nil && chargerEx == nil && diagnosis != nil && identifier == nil && meter != nil && meterCurrent != nil && meterEnergy == nil && phaseSwitcher == nil:
+		return &struct {
+			api.Charger
+			api.ChargeRater
+			api.Diagnosis
+			api.Meter
+			api.MeterCurrent
+		}{
+			Charger: base,
+			ChargeRater: &decorateChargerChargeRaterImpl{
+				chargeRater: chargeRater,
+			},
+			Diagnosis: &decorateChargerDiagnosisImpl{
+				diagnosis: diagnosis,
+			},
+			Meter: &decorateChargerMeterImpl{
+				meter: meter,
+			},
+			MeterCurrent: &decorateChargerMeterCurrentImpl{
+				meterCurrent: meterCurrent,
+			},
+		}
+
+	case chargeRater != nil && chargerEx == nil && diagnosis != nil && identifier == nil && meter == nil && meterCurrent != nil && meterEnergy != nil && phaseSwitcher == nil:
+		return &struct {
+			api.Charger
+			api.ChargeRater
+			api.Diagnosis
+			api.MeterCurrent
+			api.MeterEnergy
+		}{
+			Charger: base,
+			ChargeRater: &decorateChargerChargeRaterImpl{
+				chargeRater: chargeRater,
+			},
+			Diagnosis: &decorateChargerDiagnosisImpl{
+				diagnosis: diagnosis,
+			},
+			MeterCurrent: &decorateChargerMeterCurrentImpl{
+				meterCurrent: meterCurrent,
+			},
+			MeterEnergy: &decorateChargerMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+		}
+
+	case chargeRater != nil && chargerEx == nil && diagnosis != nil && identifier == nil && meter != nil && meterCurrent != nil && meterEnergy != nil && phaseSwitcher == nil:
+		return &struct {
+			api.Charger
+			api.ChargeRater
+			api.Diagnosis
+			api.Meter
+			api.MeterCurrent
+			api.MeterEnergy
+		}{
+			Charger: base,
+			ChargeRater: &decorateChargerChargeRaterImpl{
+				chargeRater: chargeRater,
+			},
+			Diagnosis: &decorateChargerDiagnosisImpl{
+				diagnosis: diagnosis,
+			},
+			Meter: &decorateChargerMeterImpl{
+				meter: meter,
+			},
+			MeterCurrent: &decorateChargerMeterCurrentImpl{
+				meterCurrent: meterCurrent,
+			},
+			MeterEnergy: &decorateChargerMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+		}
+
+	case chargeRater != nil && chargerEx != nil && diagnosis != nil && identifier == nil && meter == nil && meterCurrent == nil && meterEnergy == nil && phaseSwitcher == nil:
+		return &struct {
+			api.Charger
+			api.ChargeRater
+			api.ChargerEx
+			api.Diagnosis
+		}{
+			Charger: base,
+			ChargeRater: &decorateChargerChargeRaterImpl{
+				chargeRater: chargeRater,
+			},
+			ChargerEx: &decorateChargerChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Diagnosis: &decorateChargerDiagnosisImpl{
+				diagnosis: diagnosis,
+			},
+		}
+
+	case chargeRater != nil && chargerEx != nil && diagnosis != nil && identifier == nil && meter != nil && meterCurrent == nil && meterEnergy == nil && phaseSwitcher == nil:
+		return &struct {
+			api.Charger
+			api.ChargeRater
+			api.ChargerEx
+			api.Diagnosis
+			api.Meter
+		}{
+			Charger: base,
+			ChargeRater: &decorateChargerChargeRaterImpl{
+				chargeRater: chargeRater,
+			},
+			ChargerEx: &decorateChargerChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Diagnosis: &decorateChargerDiagnosisImpl{
+				diagnosis: diagnosis,
+			},
+			Meter: &decorateChargerMeterImpl{
+				meter: meter,
+			},
+		}
+
+	case chargeRater != nil && chargerEx != nil && diagnosis != nil && identifier == nil && meter == nil && meterCurrent == nil && meterEnergy != nil && phaseSwitcher == nil:
+		return &struct {
+			api.Charger
+			api.ChargeRater
+			api.ChargerEx
+			api.Diagnosis
+			api.MeterEnergy
+		}{
+			Charger: base,
+			ChargeRater: &decorateChargerChargeRaterImpl{
+				chargeRater: chargeRater,
+			},
+			ChargerEx: &decorateChargerChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Diagnosis: &decorateChargerDiagnosisImpl{
+				diagnosis: diagnosis,
+			},
+			MeterEnergy: &decorateChargerMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+		}
+
+	case chargeRater != nil && chargerEx != nil && diagnosis != nil && identifier == nil && meter != nil && meterCurrent == nil && meterEnergy != nil && phaseSwitcher == nil:
+		return &struct {
+			api.Charger
+			api.ChargeRater
+			api.ChargerEx
+			api.Diagnosis
+			api.Meter
+			api.MeterEnergy
+		}{
+			Charger: base,
+			ChargeRater: &decorateChargerChargeRaterImpl{
+				chargeRater: chargeRater,
+			},
+			ChargerEx: &decorateChargerChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Diagnosis: &decorateChargerDiagnosisImpl{
+				diagnosis: diagnosis,
+			},
+			Meter: &decorateChargerMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decorateChargerMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+		}
+
+	case chargeRater != nil && chargerEx != nil && diagnosis != nil && identifier == nil && meter == nil && meterCurrent != nil && meterEnergy == nil && phaseSwitcher == nil:
+		return &struct {
+			api.Charger
+			api.ChargeRater
+			api.ChargerEx
+			api.Diagnosis
+			api.MeterCurrent
+		}{
+			Charger: base,
+			ChargeRater: &decorateChargerChargeRaterImpl{
+				chargeRater: chargeRater,
+			},
+			ChargerEx: &decorateChargerChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Diagnosis: &decorateChargerDiagnosisImpl{
+				diagnosis: diagnosis,
+			},
+			MeterCurrent: &decorateChargerMeterCurrentImpl{
+				meterCurrent: meterCurrent,
+			},
+		}
+
+	case chargeRater != nil && chargerEx != nil && diagnosis != nil && identifier == nil && meter != nil && meterCurrent != nil && meterEnergy == nil && phaseSwitcher == nil:
+		return &struct {
+			api.Charger
+			api.ChargeRater
+			api.ChargerEx
+			api.Diagnosis
+			api.Meter
+			api.MeterCurrent
+		}{
+			Charger: base,
+			ChargeRater: &decorateChargerChargeRaterImpl{
+				chargeRater: chargeRater,
+			},
+			ChargerEx: &decorateChargerChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Diagnosis: &decorateChargerDiagnosisImpl{
+				diagnosis: diagnosis,
+			},
+			Meter: &decorateChargerMeterImpl{
+				meter: meter,
+			},
+			MeterCurrent: &decorateChargerMeterCurrentImpl{
+				meterCurrent: meterCurrent,
+			},
+		}
+
+	case chargeRater != nil && chargerEx != nil && diagnosis != nil && identifier == nil && meter == nil && meterCurrent != nil && meterEnergy != nil && phaseSwitcher == nil:
+		return &struct {
+			api.Charger
+			api.ChargeRater
+			api.ChargerEx
+			api.Diagnosis
+			api.MeterCurrent
+			api.MeterEnergy
+		}{
+			Charger: base,
+			ChargeRater: &decorateChargerChargeRaterImpl{
+				chargeRater: chargeRater,
+			},
+			ChargerEx: &decorateChargerChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Diagnosis: &decorateChargerDiagnosisImpl{
+				diagnosis: diagnosis,
+			},
+			MeterCurrent: &decorateChargerMeterCurrentImpl{
+				meterCurrent: meterCurrent,
+			},
+			MeterEnergy: &decorateChargerMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+		}
+
+	case chargeRater != nil && chargerEx != nil && diagnosis != nil && identifier == nil && meter != nil && meterCurrent != nil && meterEnergy != nil && phaseSwitcher == nil:
+		return &struct {
+			api.Charger
+			api.ChargeRater
+			api.ChargerEx
+			api.Diagnosis
+			api.Meter
+			api.MeterCurrent
+			api.MeterEnergy
+		}{
+			Charger: base,
+			ChargeRater: &decorateChargerChargeRaterImpl{
+				chargeRater: chargeRater,
+			},
+			ChargerEx: &decorateChargerChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Diagnosis: &decorateChargerDiagnosisImpl{
+				diagnosis: diagnosis,
+			},
+			Meter: &decorateChargerMeterImpl{
+				meter: meter,
+			},
+			MeterCurrent: &decorateChargerMeterCurrentImpl{
+				meterCurrent: meterCurrent,
+			},
+			MeterEnergy: &decorateChargerMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+		}
+
+	case chargeRater != nil && chargerEx == nil && diagnosis != nil && identifier == nil && meter == nil && meterCurrent == nil && meterEnergy == nil && phaseSwitcher != nil:
+		return &struct {
+			api.Charger
+			api.ChargeRater
+			api.Diagnosis
+			api.PhaseSwitcher
+		}{
+			Charger: base,
+			ChargeRater: &decorateChargerChargeRaterImpl{
+				chargeRater: chargeRater,
+			},
+			Diagnosis: &decorateChargerDiagnosisImpl{
+				diagnosis: diagnosis,
+			},
+			PhaseSwitcher: &decorateChargerPhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+		}
+
+	case chargeRater != nil && chargerEx == nil && diagnosis != nil && identifier == nil && meter != nil && meterCurrent == nil && meterEnergy == nil && phaseSwitcher != nil:
+		return &struct {
+			api.Charger
+			api.ChargeRater
+			api.Diagnosis
+			api.Meter
+			api.PhaseSwitcher
+		}{
+			Charger: base,
+			ChargeRater: &decorateChargerChargeRaterImpl{
+				chargeRater: chargeRater,
+			},
+			Diagnosis: &decorateChargerDiagnosisImpl{
+				diagnosis: diagnosis,
+			},
+			Meter: &decorateChargerMeterImpl{
+				meter: meter,
+			},
+			PhaseSwitcher: &decorateChargerPhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+		}
+
+	case chargeRater != nil && chargerEx == nil && diagnosis != nil && identifier == nil && meter == nil && meterCurrent == nil && meterEnergy != nil && phaseSwitcher != nil:
+		return &struct {
+			api.Charger
+			api.ChargeRater
+			api.Diagnosis
+			api.MeterEnergy
+			api.PhaseSwitcher
+		}{
+			Charger: base,
+			ChargeRater: &decorateChargerChargeRaterImpl{
+				chargeRater: chargeRater,
+			},
+			Diagnosis: &decorateChargerDiagnosisImpl{
+				diagnosis: diagnosis,
+			},
+			MeterEnergy: &decorateChargerMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseSwitcher: &decorateChargerPhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+		}
+
+	case chargeRater != nil && chargerEx == nil && diagnosis != nil && identifier == nil && meter != nil && meterCurrent == nil && meterEnergy != nil && phaseSwitcher != nil:
+		return &struct {
+			api.Charger
+			api.ChargeRater
+			api.Diagnosis
+			api.Meter
+			api.MeterEnergy
+			api.PhaseSwitcher
+		}{
+			Charger: base,
+			ChargeRater: &decorateChargerChargeRaterImpl{
+				chargeRater: chargeRater,
+			},
+			Diagnosis: &decorateChargerDiagnosisImpl{
+				diagnosis: diagnosis,
+			},
+			Meter: &decorateChargerMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decorateChargerMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseSwitcher: &decorateChargerPhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+		}
+
+	case chargeRater != nil && chargerEx == nil && diagnosis != nil && identifier == nil && meter == nil && meterCurrent != nil && meterEnergy == nil && phaseSwitcher != nil:
+		return &struct {
+			api.Charger
+			api.ChargeRater
+			api.Diagnosis
+			api.MeterCurrent
+			api.PhaseSwitcher
+		}{
+			Charger: base,
+			ChargeRater: &decorateChargerChargeRaterImpl{
+				chargeRater: chargeRater,
+			},
+			Diagnosis: &decorateChargerDiagnosisImpl{
+				diagnosis: diagnosis,
+			},
+			MeterCurrent: &decorateChargerMeterCurrentImpl{
+				meterCurrent: meterCurrent,
+			},
+			PhaseSwitcher: &decorateChargerPhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+		}
+
+	case chargeRater != nil && chargerEx == nil && diagnosis != nil && identifier == nil && meter != nil && meterCurrent != nil && meterEnergy == nil && phaseSwitcher != nil:
+		return &struct {
+			api.Charger
+			api.ChargeRater
+			api.Diagnosis
+			api.Meter
+			api.MeterCurrent
+			api.PhaseSwitcher
+		}{
+			Charger: base,
+			ChargeRater: &decorateChargerChargeRaterImpl{
+				chargeRater: chargeRater,
+			},
+			Diagnosis: &decorateChargerDiagnosisImpl{
+				diagnosis: diagnosis,
+			},
+			Meter: &decorateChargerMeterImpl{
+				meter: meter,
+			},
+			MeterCurrent: &decorateChargerMeterCurrentImpl{
+				meterCurrent: meterCurrent,
+			},
+			PhaseSwitcher: &decorateChargerPhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+		}
+
+	case chargeRater != nil && chargerEx == nil && diagnosis != nil && identifier == nil && meter == nil && meterCurrent != nil && meterEnergy != nil && phaseSwitcher != nil:
+		return &struct {
+			api.Charger
+			api.ChargeRater
+			api.Diagnosis
+			api.MeterCurrent
+			api.MeterEnergy
+			api.PhaseSwitcher
+		}{
+			Charger: base,
+			ChargeRater: &decorateChargerChargeRaterImpl{
+				chargeRater: chargeRater,
+			},
+			Diagnosis: &decorateChargerDiagnosisImpl{
+				diagnosis: diagnosis,
+			},
+			MeterCurrent: &decorateChargerMeterCurrentImpl{
+				meterCurrent: meterCurrent,
+			},
+			MeterEnergy: &decorateChargerMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseSwitcher: &decorateChargerPhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+		}
+
+	case chargeRater != nil && chargerEx == nil && diagnosis != nil && identifier == nil && meter != nil && meterCurrent != nil && meterEnergy != nil && phaseSwitcher != nil:
+		return &struct {
+			api.Charger
+			api.ChargeRater
+			api.Diagnosis
+			api.Meter
+			api.MeterCurrent
+			api.MeterEnergy
+			api.PhaseSwitcher
+		}{
+			Charger: base,
+			ChargeRater: &decorateChargerChargeRaterImpl{
+				chargeRater: chargeRater,
+			},
+			Diagnosis: &decorateChargerDiagnosisImpl{
+				diagnosis: diagnosis,
+			},
+			Meter: &decorateChargerMeterImpl{
+				meter: meter,
+			},
+			MeterCurrent: &decorateChargerMeterCurrentImpl{
+				meterCurrent: meterCurrent,
+			},
+			MeterEnergy: &decorateChargerMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseSwitcher: &decorateChargerPhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+		}
+
+	case chargeRater != nil && chargerEx != nil && diagnosis != nil && identifier == nil && meter == nil && meterCurrent == nil && meterEnergy == nil && phaseSwitcher != nil:
+		return &struct {
+			api.Charger
+			api.ChargeRater
+			api.ChargerEx
+			api.Diagnosis
+			api.PhaseSwitcher
+		}{
+			Charger: base,
+			ChargeRater: &decorateChargerChargeRaterImpl{
+				chargeRater: chargeRater,
+			},
+			ChargerEx: &decorateChargerChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Diagnosis: &decorateChargerDiagnosisImpl{
+				diagnosis: diagnosis,
+			},
+			PhaseSwitcher: &decorateChargerPhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+		}
+
+	case chargeRater != nil && chargerEx != nil && diagnosis != nil && identifier == nil && meter != nil && meterCurrent == nil && meterEnergy == nil && phaseSwitcher != nil:
+		return &struct {
+			api.Charger
+			api.ChargeRater
+			api.ChargerEx
+			api.Diagnosis
+			api.Meter
+			api.PhaseSwitcher
+		}{
+			Charger: base,
+			ChargeRater: &decorateChargerChargeRaterImpl{
+				chargeRater: chargeRater,
+			},
+			ChargerEx: &decorateChargerChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Diagnosis: &decorateChargerDiagnosisImpl{
+				diagnosis: diagnosis,
+			},
+			Meter: &decorateChargerMeterImpl{
+				meter: meter,
+			},
+			PhaseSwitcher: &decorateChargerPhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+		}
+
+	case chargeRater != nil && chargerEx != nil && diagnosis != nil && identifier == nil && meter == nil && meterCurrent == nil && meterEnergy != nil && phaseSwitcher != nil:
+		return &struct {
+			api.Charger
+			api.ChargeRater
+			api.ChargerEx
+			api.Diagnosis
+			api.MeterEnergy
+			api.PhaseSwitcher
+		}{
+			Charger: base,
+			ChargeRater: &decorateChargerChargeRaterImpl{
+				chargeRater: chargeRater,
+			},
+			ChargerEx: &decorateChargerChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Diagnosis: &decorateChargerDiagnosisImpl{
+				diagnosis: diagnosis,
+			},
+			MeterEnergy: &decorateChargerMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseSwitcher: &decorateChargerPhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+		}
+
+	case chargeRater != nil && chargerEx != nil && diagnosis != nil && identifier == nil && meter != nil && meterCurrent == nil && meterEnergy != nil && phaseSwitcher != nil:
+		return &struct {
+			api.Charger
+			api.ChargeRater
+			api.ChargerEx
+			api.Diagnosis
+			api.Meter
+			api.MeterEnergy
+			api.PhaseSwitcher
+		}{
+			Charger: base,
+			ChargeRater: &decorateChargerChargeRaterImpl{
+				chargeRater: chargeRater,
+			},
+			ChargerEx: &decorateChargerChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Diagnosis: &decorateChargerDiagnosisImpl{
+				diagnosis: diagnosis,
+			},
+			Meter: &decorateChargerMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decorateChargerMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseSwitcher: &decorateChargerPhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+		}
+
+	case chargeRater != nil && chargerEx != nil && diagnosis != nil && identifier == nil && meter == nil && meterCurrent != nil && meterEnergy == nil && phaseSwitcher != nil:
+		return &struct {
+			api.Charger
+			api.ChargeRater
+			api.ChargerEx
+			api.Diagnosis
+			api.MeterCurrent
+			api.PhaseSwitcher
+		}{
+			Charger: base,
+			ChargeRater: &decorateChargerChargeRaterImpl{
+				chargeRater: chargeRater,
+			},
+			ChargerEx: &decorateChargerChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Diagnosis: &decorateChargerDiagnosisImpl{
+				diagnosis: diagnosis,
+			},
+			MeterCurrent: &decorateChargerMeterCurrentImpl{
+				meterCurrent: meterCurrent,
+			},
+			PhaseSwitcher: &decorateChargerPhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+		}
+
+	case chargeRater != nil && chargerEx != nil && diagnosis != nil && identifier == nil && meter != nil && meterCurrent != nil && meterEnergy == nil && phaseSwitcher != nil:
+		return &struct {
+			api.Charger
+			api.ChargeRater
+			api.ChargerEx
+			api.Diagnosis
+			api.Meter
+			api.MeterCurrent
+			api.PhaseSwitcher
+		}{
+			Charger: base,
+			ChargeRater: &decorateChargerChargeRaterImpl{
+				chargeRater: chargeRater,
+			},
+			ChargerEx: &decorateChargerChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Diagnosis: &decorateChargerDiagnosisImpl{
+				diagnosis: diagnosis,
+			},
+			Meter: &decorateChargerMeterImpl{
+				meter: meter,
+			},
+			MeterCurrent: &decorateChargerMeterCurrentImpl{
+				meterCurrent: meterCurrent,
+			},
+			PhaseSwitcher: &decorateChargerPhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+		}
+
+	case chargeRater != nil && chargerEx != nil && diagnosis != nil && identifier == nil && meter == nil && meterCurrent != nil && meterEnergy != nil && phaseSwitcher != nil:
+		return &struct {
+			api.Charger
+			api.ChargeRater
+			api.ChargerEx
+			api.Diagnosis
+			api.MeterCurrent
+			api.MeterEnergy
+			api.PhaseSwitcher
+		}{
+			Charger: base,
+			ChargeRater: &decorateChargerChargeRaterImpl{
+				chargeRater: chargeRater,
+			},
+			ChargerEx: &decorateChargerChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Diagnosis: &decorateChargerDiagnosisImpl{
+				diagnosis: diagnosis,
+			},
+			MeterCurrent: &decorateChargerMeterCurrentImpl{
+				meterCurrent: meterCurrent,
+			},
+			MeterEnergy: &decorateChargerMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseSwitcher: &decorateChargerPhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+		}
+
+	case chargeRater != nil && chargerEx != nil && diagnosis != nil && identifier == nil && meter != nil && meterCurrent != nil && meterEnergy != nil && phaseSwitcher != nil:
+		return &struct {
+			api.Charger
+			api.ChargeRater
+			api.ChargerEx
+			api.Diagnosis
+			api.Meter
+			api.MeterCurrent
+			api.MeterEnergy
+			api.PhaseSwitcher
+		}{
+			Charger: base,
+			ChargeRater: &decorateChargerChargeRaterImpl{
+				chargeRater: chargeRater,
+			},
+			ChargerEx: &decorateChargerChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Diagnosis: &decorateChargerDiagnosisImpl{
+				diagnosis: diagnosis,
+			},
+			Meter: &decorateChargerMeterImpl{
+				meter: meter,
+			},
+			MeterCurrent: &decorateChargerMeterCurrentImpl{
+				meterCurrent: meterCurrent,
+			},
+			MeterEnergy: &decorateChargerMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseSwitcher: &decorateChargerPhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+		}
+
+	case chargeRater == nil && chargerEx == nil && diagnosis != nil && identifier != nil && meter == nil && meterCurrent == nil && meterEnergy == nil && phaseSwitcher == nil:
+		return &struct {
+			api.Charger
+			api.Diagnosis
+			api.Identifier
+		}{
+			Charger: base,
+			Diagnosis: &decorateChargerDiagnosisImpl{
+				diagnosis: diagnosis,
+			},
+			Identifier: &decorateChargerIdentifierImpl{
+				identifier: identifier,
+			},
+		}
+
+	case chargeRater == nil && chargerEx == nil && diagnosis != nil && identifier != nil && meter != nil && meterCurrent == nil && meterEnergy == nil && phaseSwitcher == nil:
+		return &struct {
+			api.Charger
+			api.Diagnosis
+			api.Identifier
+			api.Meter
+		}{
+			Charger: base,
+			Diagnosis: &decorateChargerDiagnosisImpl{
+				diagnosis: diagnosis,
+			},
+			Identifier: &decorateChargerIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decorateChargerMeterImpl{
+				meter: meter,
+			},
+		}
+
+	case chargeRater == nil && chargerEx == nil && diagnosis != nil && identifier != nil && meter == nil && meterCurrent == nil && meterEnergy != nil && phaseSwitcher == nil:
+		return &struct {
+			api.Charger
+			api.Diagnosis
+			api.Identifier
+			api.MeterEnergy
+		}{
+			Charger: base,
+			Diagnosis: &decorateChargerDiagnosisImpl{
+				diagnosis: diagnosis,
+			},
+			Identifier: &decorateChargerIdentifierImpl{
+				identifier: identifier,
+			},
+			MeterEnergy: &decorateChargerMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+		}
+
+	case chargeRater == nil && chargerEx == nil && diagnosis != nil && identifier != nil && meter != nil && meterCurrent == nil && meterEnergy != nil && phaseSwitcher == nil:
+		return &struct {
+			api.Charger
+			api.Diagnosis
+			api.Identifier
+			api.Meter
+			api.MeterEnergy
+		}{
+			Charger: base,
+			Diagnosis: &decorateChargerDiagnosisImpl{
+				diagnosis: diagnosis,
+			},
+			Identifier: &decorateChargerIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decorateChargerMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decorateChargerMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+		}
+
+	case chargeRater == nil && chargerEx == nil && diagnosis != nil && identifier != nil && meter == nil && meterCurrent != nil && meterEnergy == nil && phaseSwitcher == nil:
+		return &struct {
+			api.Charger
+			api.Diagnosis
+			api.Identifier
+			api.MeterCurrent
+		}{
+			Charger: base,
+			Diagnosis: &decorateChargerDiagnosisImpl{
+				diagnosis: diagnosis,
+			},
+			Identifier: &decorateChargerIdentifierImpl{
+				identifier: identifier,
+			},
+			MeterCurrent: &decorateChargerMeterCurrentImpl{
+				meterCurrent: meterCurrent,
+			},
+		}
+
+	case chargeRater == nil && chargerEx == nil && diagnosis != nil && identifier != nil && meter != nil && meterCurrent != nil && meterEnergy == nil && phaseSwitcher == nil:
+		return &struct {
+			api.Charger
+			api.Diagnosis
+			api.Identifier
+			api.Meter
+			api.MeterCurrent
+		}{
+			Charger: base,
+			Diagnosis: &decorateChargerDiagnosisImpl{
+				diagnosis: diagnosis,
+			},
+			Identifier: &decorateChargerIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decorateChargerMeterImpl{
+				meter: meter,
+			},
+			MeterCurrent: &decorateChargerMeterCurrentImpl{
+				meterCurrent: meterCurrent,
+			},
+		}
+
+	case chargeRater == nil && chargerEx == nil && diagnosis != nil && identifier != nil && meter == nil && meterCurrent != nil && meterEnergy != nil && phaseSwitcher == nil:
+		return &struct {
+			api.Charger
+			api.Diagnosis
+			api.Identifier
+			api.MeterCurrent
+			api.MeterEnergy
+		}{
+			Charger: base,
+			Diagnosis: &decorateChargerDiagnosisImpl{
+				diagnosis: diagnosis,
+			},
+			Identifier: &decorateChargerIdentifierImpl{
+				identifier: identifier,
+			},
+			MeterCurrent: &decorateChargerMeterCurrentImpl{
+				meterCurrent: meterCurrent,
+			},
+			MeterEnergy: &decorateChargerMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+		}
+
+	case chargeRater == nil && chargerEx == nil && diagnosis != nil && identifier != nil && meter != nil && meterCurrent != nil && meterEnergy != nil && phaseSwitcher == nil:
+		return &struct {
+			api.Charger
+			api.Diagnosis
+			api.Identifier
+			api.Meter
+			api.MeterCurrent
+			api.MeterEnergy
+		}{
+			Charger: base,
+			Diagnosis: &decorateChargerDiagnosisImpl{
+				diagnosis: diagnosis,
+			},
+			Identifier: &decorateChargerIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decorateChargerMeterImpl{
+				meter: meter,
+			},
+			MeterCurrent: &decorateChargerMeterCurrentImpl{
+				meterCurrent: meterCurrent,
+			},
+			MeterEnergy: &decorateChargerMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+		}
+
+	case chargeRater == nil && chargerEx != nil && diagnosis != nil && identifier != nil && meter == nil && meterCurrent == nil && meterEnergy == nil && phaseSwitcher == nil:
+		return &struct {
+			api.Charger
+			api.ChargerEx
+			api.Diagnosis
+			api.Identifier
+		}{
+			Charger: base,
+			ChargerEx: &decorateChargerChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Diagnosis: &decorateChargerDiagnosisImpl{
+				diagnosis: diagnosis,
+			},
+			Identifier: &decorateChargerIdentifierImpl{
+				identifier: identifier,
+			},
+		}
+
+	case chargeRater == nil && chargerEx != nil && diagnosis != nil && identifier != nil && meter != nil && meterCurrent == nil && meterEnergy == nil && phaseSwitcher == nil:
+		return &struct {
+			api.Charger
+			api.ChargerEx
+			api.Diagnosis
+			api.Identifier
+			api.Meter
+		}{
+			Charger: base,
+			ChargerEx: &decorateChargerChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Diagnosis: &decorateChargerDiagnosisImpl{
+				diagnosis: diagnosis,
+			},
+			Identifier: &decorateChargerIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decorateChargerMeterImpl{
+				meter: meter,
+			},
+		}
+
+	case chargeRater == nil && chargerEx != nil && diagnosis != nil && identifier != nil && meter == nil && meterCurrent == nil && meterEnergy != nil && phaseSwitcher == nil:
+		return &struct {
+			api.Charger
+			api.ChargerEx
+			api.Diagnosis
+			api.Identifier
+			api.MeterEnergy
+		}{
+			Charger: base,
+			ChargerEx: &decorateChargerChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Diagnosis: &decorateChargerDiagnosisImpl{
+				diagnosis: diagnosis,
+			},
+			Identifier: &decorateChargerIdentifierImpl{
+				identifier: identifier,
+			},
+			MeterEnergy: &decorateChargerMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+		}
+
+	case chargeRater == nil && chargerEx != nil && diagnosis != nil && identifier != nil && meter != nil && meterCurrent == nil && meterEnergy != nil && phaseSwitcher == nil:
+		return &struct {
+			api.Charger
+			api.ChargerEx
+			api.Diagnosis
+			api.Identifier
+			api.Meter
+			api.MeterEnergy
+		}{
+			Charger: base,
+			ChargerEx: &decorateChargerChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Diagnosis: &decorateChargerDiagnosisImpl{
+				diagnosis: diagnosis,
+			},
+			Identifier: &decorateChargerIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decorateChargerMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decorateChargerMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+		}
+
+	case chargeRater == nil && chargerEx != nil && diagnosis != nil && identifier != nil && meter == nil && meterCurrent != nil && meterEnergy == nil && phaseSwitcher == nil:
+		return &struct {
+			api.Charger
+			api.ChargerEx
+			api.Diagnosis
+			api.Identifier
+			api.MeterCurrent
+		}{
+			Charger: base,
+			ChargerEx: &decorateChargerChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Diagnosis: &decorateChargerDiagnosisImpl{
+				diagnosis: diagnosis,
+			},
+			Identifier: &decorateChargerIdentifierImpl{
+				identifier: identifier,
+			},
+			MeterCurrent: &decorateChargerMeterCurrentImpl{
+				meterCurrent: meterCurrent,
+			},
+		}
+
+	case chargeRater == nil && chargerEx != nil && diagnosis != nil && identifier != nil && meter != nil && meterCurrent != nil && meterEnergy == nil && phaseSwitcher == nil:
+		return &struct {
+			api.Charger
+			api.ChargerEx
+			api.Diagnosis
+			api.Identifier
+			api.Meter
+			api.MeterCurrent
+		}{
+			Charger: base,
+			ChargerEx: &decorateChargerChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Diagnosis: &decorateChargerDiagnosisImpl{
+				diagnosis: diagnosis,
+			},
+			Identifier: &decorateChargerIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decorateChargerMeterImpl{
+				meter: meter,
+			},
+			MeterCurrent: &decorateChargerMeterCurrentImpl{
+				meterCurrent: meterCurrent,
+			},
+		}
+
+	case chargeRater == nil && chargerEx != nil && diagnosis != nil && identifier != nil && meter == nil && meterCurrent != nil && meterEnergy != nil && phaseSwitcher == nil:
+		return &struct {
+			api.Charger
+			api.ChargerEx
+			api.Diagnosis
+			api.Identifier
+			api.MeterCurrent
+			api.MeterEnergy
+		}{
+			Charger: base,
+			ChargerEx: &decorateChargerChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Diagnosis: &decorateChargerDiagnosisImpl{
+				diagnosis: diagnosis,
+			},
+			Identifier: &decorateChargerIdentifierImpl{
+				identifier: identifier,
+			},
+			MeterCurrent: &decorateChargerMeterCurrentImpl{
+				meterCurrent: meterCurrent,
+			},
+			MeterEnergy: &decorateChargerMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+		}
+
+	case chargeRater == nil && chargerEx != nil && diagnosis != nil && identifier != nil && meter != nil && meterCurrent != nil && meterEnergy != nil && phaseSwitcher == nil:
+		return &struct {
+			api.Charger
+			api.ChargerEx
+			api.Diagnosis
+			api.Identifier
+			api.Meter
+			api.MeterCurrent
+			api.MeterEnergy
+		}{
+			Charger: base,
+			ChargerEx: &decorateChargerChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Diagnosis: &decorateChargerDiagnosisImpl{
+				diagnosis: diagnosis,
+			},
+			Identifier: &decorateChargerIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decorateChargerMeterImpl{
+				meter: meter,
+			},
+			MeterCurrent: &decorateChargerMeterCurrentImpl{
+				meterCurrent: meterCurrent,
+			},
+			MeterEnergy: &decorateChargerMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+		}
+
+	case chargeRater == nil && chargerEx == nil && diagnosis != nil && identifier != nil && meter == nil && meterCurrent == nil && meterEnergy == nil && phaseSwitcher != nil:
+		return &struct {
+			api.Charger
+			api.Diagnosis
+			api.Identifier
+			api.PhaseSwitcher
+		}{
+			Charger: base,
+			Diagnosis: &decorateChargerDiagnosisImpl{
+				diagnosis: diagnosis,
+			},
+			Identifier: &decorateChargerIdentifierImpl{
+				identifier: identifier,
+			},
+			PhaseSwitcher: &decorateChargerPhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+		}
+
+	case chargeRater == nil && chargerEx == nil && diagnosis != nil && identifier != nil && meter != nil && meterCurrent == nil && meterEnergy == nil && phaseSwitcher != nil:
+		return &struct {
+			api.Charger
+			api.Diagnosis
+			api.Identifier
+			api.Meter
+			api.PhaseSwitcher
+		}{
+			Charger: base,
+			Diagnosis: &decorateChargerDiagnosisImpl{
+				diagnosis: diagnosis,
+			},
+			Identifier: &decorateChargerIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decorateChargerMeterImpl{
+				meter: meter,
+			},
+			PhaseSwitcher: &decorateChargerPhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+		}
+
+	case chargeRater == nil && chargerEx == nil && diagnosis != nil && identifier != nil && meter == nil && meterCurrent == nil && meterEnergy != nil && phaseSwitcher != nil:
+		return &struct {
+			api.Charger
+			api.Diagnosis
+			api.Identifier
+			api.MeterEnergy
+			api.PhaseSwitcher
+		}{
+			Charger: base,
+			Diagnosis: &decorateChargerDiagnosisImpl{
+				diagnosis: diagnosis,
+			},
+			Identifier: &decorateChargerIdentifierImpl{
+				identifier: identifier,
+			},
+			MeterEnergy: &decorateChargerMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseSwitcher: &decorateChargerPhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+		}
+
+	case chargeRater == nil && chargerEx == nil && diagnosis != nil && identifier != nil && meter != nil && meterCurrent == nil && meterEnergy != nil && phaseSwitcher != nil:
+		return &struct {
+			api.Charger
+			api.Diagnosis
+			api.Identifier
+			api.Meter
+			api.MeterEnergy
+			api.PhaseSwitcher
+		}{
+			Charger: base,
+			Diagnosis: &decorateChargerDiagnosisImpl{
+				diagnosis: diagnosis,
+			},
+			Identifier: &decorateChargerIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decorateChargerMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decorateChargerMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseSwitcher: &decorateChargerPhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+		}
+
+	case chargeRater == nil && chargerEx == nil && diagnosis != nil && identifier != nil && meter == nil && meterCurrent != nil && meterEnergy == nil && phaseSwitcher != nil:
+		return &struct {
+			api.Charger
+			api.Diagnosis
+			api.Identifier
+			api.MeterCurrent
+			api.PhaseSwitcher
+		}{
+			Charger: base,
+			Diagnosis: &decorateChargerDiagnosisImpl{
+				diagnosis: diagnosis,
+			},
+			Identifier: &decorateChargerIdentifierImpl{
+				identifier: identifier,
+			},
+			MeterCurrent: &decorateChargerMeterCurrentImpl{
+				meterCurrent: meterCurrent,
+			},
+			PhaseSwitcher: &decorateChargerPhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+		}
+
+	case chargeRater == nil && chargerEx == nil && diagnosis != nil && identifier != nil && meter != nil && meterCurrent != nil && meterEnergy == nil && phaseSwitcher != nil:
+		return &struct {
+			api.Charger
+			api.Diagnosis
+			api.Identifier
+			api.Meter
+			api.MeterCurrent
+			api.PhaseSwitcher
+		}{
+			Charger: base,
+			Diagnosis: &decorateChargerDiagnosisImpl{
+				diagnosis: diagnosis,
+			},
+			Identifier: &decorateChargerIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decorateChargerMeterImpl{
+				meter: meter,
+			},
+			MeterCurrent: &decorateChargerMeterCurrentImpl{
+				meterCurrent: meterCurrent,
+			},
+			PhaseSwitcher: &decorateChargerPhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+		}
+
+	case chargeRater == nil && chargerEx == nil && diagnosis != nil && identifier != nil && meter == nil && meterCurrent != nil && meterEnergy != nil && phaseSwitcher != nil:
+		return &struct {
+			api.Charger
+			api.Diagnosis
+			api.Identifier
+			api.MeterCurrent
+			api.MeterEnergy
+			api.PhaseSwitcher
+		}{
+			Charger: base,
+			Diagnosis: &decorateChargerDiagnosisImpl{
+				diagnosis: diagnosis,
+			},
+			Identifier: &decorateChargerIdentifierImpl{
+				identifier: identifier,
+			},
+			MeterCurrent: &decorateChargerMeterCurrentImpl{
+				meterCurrent: meterCurrent,
+			},
+			MeterEnergy: &decorateChargerMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseSwitcher: &decorateChargerPhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+		}
+
+	case chargeRater == nil && chargerEx == nil && diagnosis != nil && identifier != nil && meter != nil && meterCurrent != nil && meterEnergy != nil && phaseSwitcher != nil:
+		return &struct {
+			api.Charger
+			api.Diagnosis
+			api.Identifier
+			api.Meter
+			api.MeterCurrent
+			api.MeterEnergy
+			api.PhaseSwitcher
+		}{
+			Charger: base,
+			Diagnosis: &decorateChargerDiagnosisImpl{
+				diagnosis: diagnosis,
+			},
+			Identifier: &decorateChargerIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decorateChargerMeterImpl{
+				meter: meter,
+			},
+			MeterCurrent: &decorateChargerMeterCurrentImpl{
+				meterCurrent: meterCurrent,
+			},
+			MeterEnergy: &decorateChargerMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseSwitcher: &decorateChargerPhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+		}
+
+	case chargeRater == nil && chargerEx != nil && diagnosis != nil && identifier != nil && meter == nil && meterCurrent == nil && meterEnergy == nil && phaseSwitcher != nil:
+		return &struct {
+			api.Charger
+			api.ChargerEx
+			api.Diagnosis
+			api.Identifier
+			api.PhaseSwitcher
+		}{
+			Charger: base,
+			ChargerEx: &decorateChargerChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Diagnosis: &decorateChargerDiagnosisImpl{
+				diagnosis: diagnosis,
+			},
+			Identifier: &decorateChargerIdentifierImpl{
+				identifier: identifier,
+			},
+			PhaseSwitcher: &decorateChargerPhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+		}
+
+	case chargeRater == nil && chargerEx != nil && diagnosis != nil && identifier != nil && meter != nil && meterCurrent == nil && meterEnergy == nil && phaseSwitcher != nil:
+		return &struct {
+			api.Charger
+			api.ChargerEx
+			api.Diagnosis
+			api.Identifier
+			api.Meter
+			api.PhaseSwitcher
+		}{
+			Charger: base,
+			ChargerEx: &decorateChargerChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Diagnosis: &decorateChargerDiagnosisImpl{
+				diagnosis: diagnosis,
+			},
+			Identifier: &decorateChargerIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decorateChargerMeterImpl{
+				meter: meter,
+			},
+			PhaseSwitcher: &decorateChargerPhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+		}
+
+	case chargeRater == nil && chargerEx != nil && diagnosis != nil && identifier != nil && meter == nil && meterCurrent == nil && meterEnergy != nil && phaseSwitcher != nil:
+		return &struct {
+			api.Charger
+			api.ChargerEx
+			api.Diagnosis
+			api.Identifier
+			api.MeterEnergy
+			api.PhaseSwitcher
+		}{
+			Charger: base,
+			ChargerEx: &decorateChargerChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Diagnosis: &decorateChargerDiagnosisImpl{
+				diagnosis: diagnosis,
+			},
+			Identifier: &decorateChargerIdentifierImpl{
+				identifier: identifier,
+			},
+			MeterEnergy: &decorateChargerMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseSwitcher: &decorateChargerPhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+		}
+
+	case chargeRater == nil && chargerEx != nil && diagnosis != nil && identifier != nil && meter != nil && meterCurrent == nil && meterEnergy != nil && phaseSwitcher != nil:
+		return &struct {
+			api.Charger
+			api.ChargerEx
+			api.Diagnosis
+			api.Identifier
+			api.Meter
+			api.MeterEnergy
+			api.PhaseSwitcher
+		}{
+			Charger: base,
+			ChargerEx: &decorateChargerChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Diagnosis: &decorateChargerDiagnosisImpl{
+				diagnosis: diagnosis,
+			},
+			Identifier: &decorateChargerIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decorateChargerMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decorateChargerMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseSwitcher: &decorateChargerPhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+		}
+
+	case chargeRater == nil && chargerEx != nil && diagnosis != nil && identifier != nil && meter == nil && meterCurrent != nil && meterEnergy == nil && phaseSwitcher != nil:
+		return &struct {
+			api.Charger
+			api.ChargerEx
+			api.Diagnosis
+			api.Identifier
+			api.MeterCurrent
+			api.PhaseSwitcher
+		}{
+			Charger: base,
+			ChargerEx: &decorateChargerChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Diagnosis: &decorateChargerDiagnosisImpl{
+				diagnosis: diagnosis,
+			},
+			Identifier: &decorateChargerIdentifierImpl{
+				identifier: identifier,
+			},
+			MeterCurrent: &decorateChargerMeterCurrentImpl{
+				meterCurrent: meterCurrent,
+			},
+			PhaseSwitcher: &decorateChargerPhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+		}
+
+	case chargeRater == nil && chargerEx != nil && diagnosis != nil && identifier != nil && meter != nil && meterCurrent != nil && meterEnergy == nil && phaseSwitcher != nil:
+		return &struct {
+			api.Charger
+			api.ChargerEx
+			api.Diagnosis
+			api.Identifier
+			api.Meter
+			api.MeterCurrent
+			api.PhaseSwitcher
+		}{
+			Charger: base,
+			ChargerEx: &decorateChargerChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Diagnosis: &decorateChargerDiagnosisImpl{
+				diagnosis: diagnosis,
+			},
+			Identifier: &decorateChargerIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decorateChargerMeterImpl{
+				meter: meter,
+			},
+			MeterCurrent: &decorateChargerMeterCurrentImpl{
+				meterCurrent: meterCurrent,
+			},
+			PhaseSwitcher: &decorateChargerPhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+		}
+
+	case chargeRater == nil && chargerEx != nil && diagnosis != nil && identifier != nil && meter == nil && meterCurrent != nil && meterEnergy != nil && phaseSwitcher != nil:
+		return &struct {
+			api.Charger
+			api.ChargerEx
+			api.Diagnosis
+			api.Identifier
+			api.MeterCurrent
+			api.MeterEnergy
+			api.PhaseSwitcher
+		}{
+			Charger: base,
+			ChargerEx: &decorateChargerChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Diagnosis: &decorateChargerDiagnosisImpl{
+				diagnosis: diagnosis,
+			},
+			Identifier: &decorateChargerIdentifierImpl{
+				identifier: identifier,
+			},
+			MeterCurrent: &decorateChargerMeterCurrentImpl{
+				meterCurrent: meterCurrent,
+			},
+			MeterEnergy: &decorateChargerMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseSwitcher: &decorateChargerPhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+		}
+
+	case chargeRater == nil && chargerEx != nil && diagnosis != nil && identifier != nil && meter != nil && meterCurrent != nil && meterEnergy != nil && phaseSwitcher != nil:
+		return &struct {
+			api.Charger
+			api.ChargerEx
+			api.Diagnosis
+			api.Identifier
+			api.Meter
+			api.MeterCurrent
+			api.MeterEnergy
+			api.PhaseSwitcher
+		}{
+			Charger: base,
+			ChargerEx: &decorateChargerChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Diagnosis: &decorateChargerDiagnosisImpl{
+				diagnosis: diagnosis,
+			},
+			Identifier: &decorateChargerIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decorateChargerMeterImpl{
+				meter: meter,
+			},
+			MeterCurrent: &decorateChargerMeterCurrentImpl{
+				meterCurrent: meterCurrent,
+			},
+			MeterEnergy: &decorateChargerMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseSwitcher: &decorateChargerPhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+		}
+
+	case chargeRater != nil && chargerEx == nil && diagnosis != nil && identifier != nil && meter == nil && meterCurrent == nil && meterEnergy == nil && phaseSwitcher == nil:
+		return &struct {
+			api.Charger
+			api.ChargeRater
+			api.Diagnosis
+			api.Identifier
+		}{
+			Charger: base,
+			ChargeRater: &decorateChargerChargeRaterImpl{
+				chargeRater: chargeRater,
+			},
+			Diagnosis: &decorateChargerDiagnosisImpl{
+				diagnosis: diagnosis,
+			},
+			Identifier: &decorateChargerIdentifierImpl{
+				identifier: identifier,
+			},
+		}
+
+	case chargeRater != nil && chargerEx == nil && diagnosis != nil && identifier != nil && meter != nil && meterCurrent == nil && meterEnergy == nil && phaseSwitcher == nil:
+		return &struct {
+			api.Charger
+			api.ChargeRater
+			api.Diagnosis
+			api.Identifier
+			api.Meter
+		}{
+			Charger: base,
+			ChargeRater: &decorateChargerChargeRaterImpl{
+				chargeRater: chargeRater,
+			},
+			Diagnosis: &decorateChargerDiagnosisImpl{
+				diagnosis: diagnosis,
+			},
+			Identifier: &decorateChargerIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decorateChargerMeterImpl{
+				meter: meter,
+			},
+		}
+
+	case chargeRater != nil && chargerEx == nil && diagnosis != nil && identifier != nil && meter == nil && meterCurrent == nil && meterEnergy != nil && phaseSwitcher == nil:
+		return &struct {
+			api.Charger
+			api.ChargeRater
+			api.Diagnosis
+			api.Identifier
+			api.MeterEnergy
+		}{
+			Charger: base,
+			ChargeRater: &decorateChargerChargeRaterImpl{
+				chargeRater: chargeRater,
+			},
+			Diagnosis: &decorateChargerDiagnosisImpl{
+				diagnosis: diagnosis,
+			},
+			Identifier: &decorateChargerIdentifierImpl{
+				identifier: identifier,
+			},
+			MeterEnergy: &decorateChargerMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+		}
+
+	case chargeRater != nil && chargerEx == nil && diagnosis != nil && identifier != nil && meter != nil && meterCurrent == nil && meterEnergy != nil && phaseSwitcher == nil:
+		return &struct {
+			api.Charger
+			api.ChargeRater
+			api.Diagnosis
+			api.Identifier
+			api.Meter
+			api.MeterEnergy
+		}{
+			Charger: base,
+			ChargeRater: &decorateChargerChargeRaterImpl{
+				chargeRater: chargeRater,
+			},
+			Diagnosis: &decorateChargerDiagnosisImpl{
+				diagnosis: diagnosis,
+			},
+			Identifier: &decorateChargerIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decorateChargerMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decorateChargerMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+		}
+
+	case chargeRater != nil && chargerEx == nil && diagnosis != nil && identifier != nil && meter == nil && meterCurrent != nil && meterEnergy == nil && phaseSwitcher == nil:
+		return &struct {
+			api.Charger
+			api.ChargeRater
+			api.Diagnosis
+			api.Identifier
+			api.MeterCurrent
+		}{
+			Charger: base,
+			ChargeRater: &decorateChargerChargeRaterImpl{
+				chargeRater: chargeRater,
+			},
+			Diagnosis: &decorateChargerDiagnosisImpl{
+				diagnosis: diagnosis,
+			},
+			Identifier: &decorateChargerIdentifierImpl{
+				identifier: identifier,
+			},
+			MeterCurrent: &decorateChargerMeterCurrentImpl{
+				meterCurrent: meterCurrent,
+			},
+		}
+
+	case chargeRater != nil && chargerEx == nil && diagnosis != nil && identifier != nil && meter != nil && meterCurrent != nil && meterEnergy == nil && phaseSwitcher == nil:
+		return &struct {
+			api.Charger
+			api.ChargeRater
+			api.Diagnosis
+			api.Identifier
+			api.Meter
+			api.MeterCurrent
+		}{
+			Charger: base,
+			ChargeRater: &decorateChargerChargeRaterImpl{
+				chargeRater: chargeRater,
+			},
+			Diagnosis: &decorateChargerDiagnosisImpl{
+				diagnosis: diagnosis,
+			},
+			Identifier: &decorateChargerIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decorateChargerMeterImpl{
+				meter: meter,
+			},
+			MeterCurrent: &decorateChargerMeterCurrentImpl{
+				meterCurrent: meterCurrent,
+			},
+		}
+
+	case chargeRater != nil && chargerEx == nil && diagnosis != nil && identifier != nil && meter == nil && meterCurrent != nil && meterEnergy != nil && phaseSwitcher == nil:
+		return &struct {
+			api.Charger
+			api.ChargeRater
+			api.Diagnosis
+			api.Identifier
+			api.MeterCurrent
+			api.MeterEnergy
+		}{
+			Charger: base,
+			ChargeRater: &decorateChargerChargeRaterImpl{
+				chargeRater: chargeRater,
+			},
+			Diagnosis: &decorateChargerDiagnosisImpl{
+				diagnosis: diagnosis,
+			},
+			Identifier: &decorateChargerIdentifierImpl{
+				identifier: identifier,
+			},
+			MeterCurrent: &decorateChargerMeterCurrentImpl{
+				meterCurrent: meterCurrent,
+			},
+			MeterEnergy: &decorateChargerMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+		}
+
+	case chargeRater != nil && chargerEx == nil && diagnosis != nil && identifier != nil && meter != nil && meterCurrent != nil && meterEnergy != nil && phaseSwitcher == nil:
+		return &struct {
+			api.Charger
+			api.ChargeRater
+			api.Diagnosis
+			api.Identifier
+			api.Meter
+			api.MeterCurrent
+			api.MeterEnergy
+		}{
+			Charger: base,
+			ChargeRater: &decorateChargerChargeRaterImpl{
+				chargeRater: chargeRater,
+			},
+			Diagnosis: &decorateChargerDiagnosisImpl{
+				diagnosis: diagnosis,
+			},
+			Identifier: &decorateChargerIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decorateChargerMeterImpl{
+				meter: meter,
+			},
+			MeterCurrent: &decorateChargerMeterCurrentImpl{
+				meterCurrent: meterCurrent,
+			},
+			MeterEnergy: &decorateChargerMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+		}
+
+	case chargeRater != nil && chargerEx != nil && diagnosis != nil && identifier != nil && meter == nil && meterCurrent == nil && meterEnergy == nil && phaseSwitcher == nil:
+		return &struct {
+			api.Charger
+			api.ChargeRater
+			api.ChargerEx
+			api.Diagnosis
+			api.Identifier
+		}{
+			Charger: base,
+			ChargeRater: &decorateChargerChargeRaterImpl{
+				chargeRater: chargeRater,
+			},
+			ChargerEx: &decorateChargerChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Diagnosis: &decorateChargerDiagnosisImpl{
+				diagnosis: diagnosis,
+			},
+			Identifier: &decorateChargerIdentifierImpl{
+				identifier: identifier,
+			},
+		}
+
+	case chargeRater != nil && chargerEx != nil && diagnosis != nil && identifier != nil && meter != nil && meterCurrent == nil && meterEnergy == nil && phaseSwitcher == nil:
+		return &struct {
+			api.Charger
+			api.ChargeRater
+			api.ChargerEx
+			api.Diagnosis
+			api.Identifier
+			api.Meter
+		}{
+			Charger: base,
+			ChargeRater: &decorateChargerChargeRaterImpl{
+				chargeRater: chargeRater,
+			},
+			ChargerEx: &decorateChargerChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Diagnosis: &decorateChargerDiagnosisImpl{
+				diagnosis: diagnosis,
+			},
+			Identifier: &decorateChargerIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decorateChargerMeterImpl{
+				meter: meter,
+			},
+		}
+
+	case chargeRater != nil && chargerEx != nil && diagnosis != nil && identifier != nil && meter == nil && meterCurrent == nil && meterEnergy != nil && phaseSwitcher == nil:
+		return &struct {
+			api.Charger
+			api.ChargeRater
+			api.ChargerEx
+			api.Diagnosis
+			api.Identifier
+			api.MeterEnergy
+		}{
+			Charger: base,
+			ChargeRater: &decorateChargerChargeRaterImpl{
+				chargeRater: chargeRater,
+			},
+			ChargerEx: &decorateChargerChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Diagnosis: &decorateChargerDiagnosisImpl{
+				diagnosis: diagnosis,
+			},
+			Identifier: &decorateChargerIdentifierImpl{
+				identifier: identifier,
+			},
+			MeterEnergy: &decorateChargerMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+		}
+
+	case chargeRater != nil && chargerEx != nil && diagnosis != nil && identifier != nil && meter != nil && meterCurrent == nil && meterEnergy != nil && phaseSwitcher == nil:
+		return &struct {
+			api.Charger
+			api.ChargeRater
+			api.ChargerEx
+			api.Diagnosis
+			api.Identifier
+			api.Meter
+			api.MeterEnergy
+		}{
+			Charger: base,
+			ChargeRater: &decorateChargerChargeRaterImpl{
+				chargeRater: chargeRater,
+			},
+			ChargerEx: &decorateChargerChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Diagnosis: &decorateChargerDiagnosisImpl{
+				diagnosis: diagnosis,
+			},
+			Identifier: &decorateChargerIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decorateChargerMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decorateChargerMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+		}
+
+	case chargeRater != nil && chargerEx != nil && diagnosis != nil && identifier != nil && meter == nil && meterCurrent != nil && meterEnergy == nil && phaseSwitcher == nil:
+		return &struct {
+			api.Charger
+			api.ChargeRater
+			api.ChargerEx
+			api.Diagnosis
+			api.Identifier
+			api.MeterCurrent
+		}{
+			Charger: base,
+			ChargeRater: &decorateChargerChargeRaterImpl{
+				chargeRater: chargeRater,
+			},
+			ChargerEx: &decorateChargerChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Diagnosis: &decorateChargerDiagnosisImpl{
+				diagnosis: diagnosis,
+			},
+			Identifier: &decorateChargerIdentifierImpl{
+				identifier: identifier,
+			},
+			MeterCurrent: &decorateChargerMeterCurrentImpl{
+				meterCurrent: meterCurrent,
+			},
+		}
+
+	case chargeRater != nil && chargerEx != nil && diagnosis != nil && identifier != nil && meter != nil && meterCurrent != nil && meterEnergy == nil && phaseSwitcher == nil:
+		return &struct {
+			api.Charger
+			api.ChargeRater
+			api.ChargerEx
+			api.Diagnosis
+			api.Identifier
+			api.Meter
+			api.MeterCurrent
+		}{
+			Charger: base,
+			ChargeRater: &decorateChargerChargeRaterImpl{
+				chargeRater: chargeRater,
+			},
+			ChargerEx: &decorateChargerChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Diagnosis: &decorateChargerDiagnosisImpl{
+				diagnosis: diagnosis,
+			},
+			Identifier: &decorateChargerIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decorateChargerMeterImpl{
+				meter: meter,
+			},
+			MeterCurrent: &decorateChargerMeterCurrentImpl{
+				meterCurrent: meterCurrent,
+			},
+		}
+
+	case chargeRater != nil && chargerEx != nil && diagnosis != nil && identifier != nil && meter == nil && meterCurrent != nil && meterEnergy != nil && phaseSwitcher == nil:
+		return &struct {
+			api.Charger
+			api.ChargeRater
+			api.ChargerEx
+			api.Diagnosis
+			api.Identifier
+			api.MeterCurrent
+			api.MeterEnergy
+		}{
+			Charger: base,
+			ChargeRater: &decorateChargerChargeRaterImpl{
+				chargeRater: chargeRater,
+			},
+			ChargerEx: &decorateChargerChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Diagnosis: &decorateChargerDiagnosisImpl{
+				diagnosis: diagnosis,
+			},
+			Identifier: &decorateChargerIdentifierImpl{
+				identifier: identifier,
+			},
+			MeterCurrent: &decorateChargerMeterCurrentImpl{
+				meterCurrent: meterCurrent,
+			},
+			MeterEnergy: &decorateChargerMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+		}
+
+	case chargeRater != nil && chargerEx != nil && diagnosis != nil && identifier != nil && meter != nil && meterCurrent != nil && meterEnergy != nil && phaseSwitcher == nil:
+		return &struct {
+			api.Charger
+			api.ChargeRater
+			api.ChargerEx
+			api.Diagnosis
+			api.Identifier
+			api.Meter
+			api.MeterCurrent
+			api.MeterEnergy
+		}{
+			Charger: base,
+			ChargeRater: &decorateChargerChargeRaterImpl{
+				chargeRater: chargeRater,
+			},
+			ChargerEx: &decorateChargerChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Diagnosis: &decorateChargerDiagnosisImpl{
+				diagnosis: diagnosis,
+			},
+			Identifier: &decorateChargerIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decorateChargerMeterImpl{
+				meter: meter,
+			},
+			MeterCurrent: &decorateChargerMeterCurrentImpl{
+				meterCurrent: meterCurrent,
+			},
+			MeterEnergy: &decorateChargerMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+		}
+
+	case chargeRater != nil && chargerEx == nil && diagnosis != nil && identifier != nil && meter == nil && meterCurrent == nil && meterEnergy == nil && phaseSwitcher != nil:
+		return &struct {
+			api.Charger
+			api.ChargeRater
+			api.Diagnosis
+			api.Identifier
+			api.PhaseSwitcher
+		}{
+			Charger: base,
+			ChargeRater: &decorateChargerChargeRaterImpl{
+				chargeRater: chargeRater,
+			},
+			Diagnosis: &decorateChargerDiagnosisImpl{
+				diagnosis: diagnosis,
+			},
+			Identifier: &decorateChargerIdentifierImpl{
+				identifier: identifier,
+			},
+			PhaseSwitcher: &decorateChargerPhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+		}
+
+	case chargeRater != nil && chargerEx == nil && diagnosis != nil && identifier != nil && meter != nil && meterCurrent == nil && meterEnergy == nil && phaseSwitcher != nil:
+		return &struct {
+			api.Charger
+			api.ChargeRater
+			api.Diagnosis
+			api.Identifier
+			api.Meter
+			api.PhaseSwitcher
+		}{
+			Charger: base,
+			ChargeRater: &decorateChargerChargeRaterImpl{
+				chargeRater: chargeRater,
+			},
+			Diagnosis: &decorateChargerDiagnosisImpl{
+				diagnosis: diagnosis,
+			},
+			Identifier: &decorateChargerIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decorateChargerMeterImpl{
+				meter: meter,
+			},
+			PhaseSwitcher: &decorateChargerPhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+		}
+
+	case chargeRater != nil && chargerEx == nil && diagnosis != nil && identifier != nil && meter == nil && meterCurrent == nil && meterEnergy != nil && phaseSwitcher != nil:
+		return &struct {
+			api.Charger
+			api.ChargeRater
+			api.Diagnosis
+			api.Identifier
+			api.MeterEnergy
+			api.PhaseSwitcher
+		}{
+			Charger: base,
+			ChargeRater: &decorateChargerChargeRaterImpl{
+				chargeRater: chargeRater,
+			},
+			Diagnosis: &decorateChargerDiagnosisImpl{
+				diagnosis: diagnosis,
+			},
+			Identifier: &decorateChargerIdentifierImpl{
+				identifier: identifier,
+			},
+			MeterEnergy: &decorateChargerMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseSwitcher: &decorateChargerPhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+		}
+
+	case chargeRater != nil && chargerEx == nil && diagnosis != nil && identifier != nil && meter != nil && meterCurrent == nil && meterEnergy != nil && phaseSwitcher != nil:
+		return &struct {
+			api.Charger
+			api.ChargeRater
+			api.Diagnosis
+			api.Identifier
+			api.Meter
+			api.MeterEnergy
+			api.PhaseSwitcher
+		}{
+			Charger: base,
+			ChargeRater: &decorateChargerChargeRaterImpl{
+				chargeRater: chargeRater,
+			},
+			Diagnosis: &decorateChargerDiagnosisImpl{
+				diagnosis: diagnosis,
+			},
+			Identifier: &decorateChargerIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decorateChargerMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decorateChargerMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseSwitcher: &decorateChargerPhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+		}
+
+	case chargeRater != nil && chargerEx == nil && diagnosis != nil && identifier != nil && meter == nil && meterCurrent != nil && meterEnergy == nil && phaseSwitcher != nil:
+		return &struct {
+			api.Charger
+			api.ChargeRater
+			api.Diagnosis
+			api.Identifier
+			api.MeterCurrent
+			api.PhaseSwitcher
+		}{
+			Charger: base,
+			ChargeRater: &decorateChargerChargeRaterImpl{
+				chargeRater: chargeRater,
+			},
+			Diagnosis: &decorateChargerDiagnosisImpl{
+				diagnosis: diagnosis,
+			},
+			Identifier: &decorateChargerIdentifierImpl{
+				identifier: identifier,
+			},
+			MeterCurrent: &decorateChargerMeterCurrentImpl{
+				meterCurrent: meterCurrent,
+			},
+			PhaseSwitcher: &decorateChargerPhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+		}
+
+	case chargeRater != nil && chargerEx == nil && diagnosis != nil && identifier != nil && meter != nil && meterCurrent != nil && meterEnergy == nil && phaseSwitcher != nil:
+		return &struct {
+			api.Charger
+			api.ChargeRater
+			api.Diagnosis
+			api.Identifier
+			api.Meter
+			api.MeterCurrent
+			api.PhaseSwitcher
+		}{
+			Charger: base,
+			ChargeRater: &decorateChargerChargeRaterImpl{
+				chargeRater: chargeRater,
+			},
+			Diagnosis: &decorateChargerDiagnosisImpl{
+				diagnosis: diagnosis,
+			},
+			Identifier: &decorateChargerIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decorateChargerMeterImpl{
+				meter: meter,
+			},
+			MeterCurrent: &decorateChargerMeterCurrentImpl{
+				meterCurrent: meterCurrent,
+			},
+			PhaseSwitcher: &decorateChargerPhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+		}
+
+	case chargeRater != nil && chargerEx == nil && diagnosis != nil && identifier != nil && meter == nil && meterCurrent != nil && meterEnergy != nil && phaseSwitcher != nil:
+		return &struct {
+			api.Charger
+			api.ChargeRater
+			api.Diagnosis
+			api.Identifier
+			api.MeterCurrent
+			api.MeterEnergy
+			api.PhaseSwitcher
+		}{
+			Charger: base,
+			ChargeRater: &decorateChargerChargeRaterImpl{
+				chargeRater: chargeRater,
+			},
+			Diagnosis: &decorateChargerDiagnosisImpl{
+				diagnosis: diagnosis,
+			},
+			Identifier: &decorateChargerIdentifierImpl{
+				identifier: identifier,
+			},
+			MeterCurrent: &decorateChargerMeterCurrentImpl{
+				meterCurrent: meterCurrent,
+			},
+			MeterEnergy: &decorateChargerMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseSwitcher: &decorateChargerPhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+		}
+
+	case chargeRater != nil && chargerEx == nil && diagnosis != nil && identifier != nil && meter != nil && meterCurrent != nil && meterEnergy != nil && phaseSwitcher != nil:
+		return &struct {
+			api.Charger
+			api.ChargeRater
+			api.Diagnosis
+			api.Identifier
+			api.Meter
+			api.MeterCurrent
+			api.MeterEnergy
+			api.PhaseSwitcher
+		}{
+			Charger: base,
+			ChargeRater: &decorateChargerChargeRaterImpl{
+				chargeRater: chargeRater,
+			},
+			Diagnosis: &decorateChargerDiagnosisImpl{
+				diagnosis: diagnosis,
+			},
+			Identifier: &decorateChargerIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decorateChargerMeterImpl{
+				meter: meter,
+			},
+			MeterCurrent: &decorateChargerMeterCurrentImpl{
+				meterCurrent: meterCurrent,
+			},
+			MeterEnergy: &decorateChargerMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseSwitcher: &decorateChargerPhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+		}
+
+	case chargeRater != nil && chargerEx != nil && diagnosis != nil && identifier != nil && meter == nil && meterCurrent == nil && meterEnergy == nil && phaseSwitcher != nil:
+		return &struct {
+			api.Charger
+			api.ChargeRater
+			api.ChargerEx
+			api.Diagnosis
+			api.Identifier
+			api.PhaseSwitcher
+		}{
+			Charger: base,
+			ChargeRater: &decorateChargerChargeRaterImpl{
+				chargeRater: chargeRater,
+			},
+			ChargerEx: &decorateChargerChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Diagnosis: &decorateChargerDiagnosisImpl{
+				diagnosis: diagnosis,
+			},
+			Identifier: &decorateChargerIdentifierImpl{
+				identifier: identifier,
+			},
+			PhaseSwitcher: &decorateChargerPhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+		}
+
+	case chargeRater != nil && chargerEx != nil && diagnosis != nil && identifier != nil && meter != nil && meterCurrent == nil && meterEnergy == nil && phaseSwitcher != nil:
+		return &struct {
+			api.Charger
+			api.ChargeRater
+			api.ChargerEx
+			api.Diagnosis
+			api.Identifier
+			api.Meter
+			api.PhaseSwitcher
+		}{
+			Charger: base,
+			ChargeRater: &decorateChargerChargeRaterImpl{
+				chargeRater: chargeRater,
+			},
+			ChargerEx: &decorateChargerChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Diagnosis: &decorateChargerDiagnosisImpl{
+				diagnosis: diagnosis,
+			},
+			Identifier: &decorateChargerIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decorateChargerMeterImpl{
+				meter: meter,
+			},
+			PhaseSwitcher: &decorateChargerPhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+		}
+
+	case chargeRater != nil && chargerEx != nil && diagnosis != nil && identifier != nil && meter == nil && meterCurrent == nil && meterEnergy != nil && phaseSwitcher != nil:
+		return &struct {
+			api.Charger
+			api.ChargeRater
+			api.ChargerEx
+			api.Diagnosis
+			api.Identifier
+			api.MeterEnergy
+			api.PhaseSwitcher
+		}{
+			Charger: base,
+			ChargeRater: &decorateChargerChargeRaterImpl{
+				chargeRater: chargeRater,
+			},
+			ChargerEx: &decorateChargerChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Diagnosis: &decorateChargerDiagnosisImpl{
+				diagnosis: diagnosis,
+			},
+			Identifier: &decorateChargerIdentifierImpl{
+				identifier: identifier,
+			},
+			MeterEnergy: &decorateChargerMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseSwitcher: &decorateChargerPhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+		}
+
+	case chargeRater != nil && chargerEx != nil && diagnosis != nil && identifier != nil && meter != nil && meterCurrent == nil && meterEnergy != nil && phaseSwitcher != nil:
+		return &struct {
+			api.Charger
+			api.ChargeRater
+			api.ChargerEx
+			api.Diagnosis
+			api.Identifier
+			api.Meter
+			api.MeterEnergy
+			api.PhaseSwitcher
+		}{
+			Charger: base,
+			ChargeRater: &decorateChargerChargeRaterImpl{
+				chargeRater: chargeRater,
+			},
+			ChargerEx: &decorateChargerChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Diagnosis: &decorateChargerDiagnosisImpl{
+				diagnosis: diagnosis,
+			},
+			Identifier: &decorateChargerIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decorateChargerMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decorateChargerMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseSwitcher: &decorateChargerPhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+		}
+
+	case chargeRater != nil && chargerEx != nil && diagnosis != nil && identifier != nil && meter == nil && meterCurrent != nil && meterEnergy == nil && phaseSwitcher != nil:
+		return &struct {
+			api.Charger
+			api.ChargeRater
+			api.ChargerEx
+			api.Diagnosis
+			api.Identifier
+			api.MeterCurrent
+			api.PhaseSwitcher
+		}{
+			Charger: base,
+			ChargeRater: &decorateChargerChargeRaterImpl{
+				chargeRater: chargeRater,
+			},
+			ChargerEx: &decorateChargerChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Diagnosis: &decorateChargerDiagnosisImpl{
+				diagnosis: diagnosis,
+			},
+			Identifier: &decorateChargerIdentifierImpl{
+				identifier: identifier,
+			},
+			MeterCurrent: &decorateChargerMeterCurrentImpl{
+				meterCurrent: meterCurrent,
+			},
+			PhaseSwitcher: &decorateChargerPhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+		}
+
+	case chargeRater != nil && chargerEx != nil && diagnosis != nil && identifier != nil && meter != nil && meterCurrent != nil && meterEnergy == nil && phaseSwitcher != nil:
+		return &struct {
+			api.Charger
+			api.ChargeRater
+			api.ChargerEx
+			api.Diagnosis
+			api.Identifier
+			api.Meter
+			api.MeterCurrent
+			api.PhaseSwitcher
+		}{
+			Charger: base,
+			ChargeRater: &decorateChargerChargeRaterImpl{
+				chargeRater: chargeRater,
+			},
+			ChargerEx: &decorateChargerChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Diagnosis: &decorateChargerDiagnosisImpl{
+				diagnosis: diagnosis,
+			},
+			Identifier: &decorateChargerIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decorateChargerMeterImpl{
+				meter: meter,
+			},
+			MeterCurrent: &decorateChargerMeterCurrentImpl{
+				meterCurrent: meterCurrent,
+			},
+			PhaseSwitcher: &decorateChargerPhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+		}
+
+	case chargeRater != nil && chargerEx != nil && diagnosis != nil && identifier != nil && meter == nil && meterCurrent != nil && meterEnergy != nil && phaseSwitcher != nil:
+		return &struct {
+			api.Charger
+			api.ChargeRater
+			api.ChargerEx
+			api.Diagnosis
+			api.Identifier
+			api.MeterCurrent
+			api.MeterEnergy
+			api.PhaseSwitcher
+		}{
+			Charger: base,
+			ChargeRater: &decorateChargerChargeRaterImpl{
+				chargeRater: chargeRater,
+			},
+			ChargerEx: &decorateChargerChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Diagnosis: &decorateChargerDiagnosisImpl{
+				diagnosis: diagnosis,
+			},
+			Identifier: &decorateChargerIdentifierImpl{
+				identifier: identifier,
+			},
+			MeterCurrent: &decorateChargerMeterCurrentImpl{
+				meterCurrent: meterCurrent,
+			},
+			MeterEnergy: &decorateChargerMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseSwitcher: &decorateChargerPhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+		}
+
+	case chargeRater != nil && chargerEx != nil && diagnosis != nil && identifier != nil && meter != nil && meterCurrent != nil && meterEnergy != nil && phaseSwitcher != nil:
+		return &struct {
+			api.Charger
+			api.ChargeRater
+			api.ChargerEx
+			api.Diagnosis
+			api.Identifier
+			api.Meter
+			api.MeterCurrent
+			api.MeterEnergy
+			api.PhaseSwitcher
+		}{
+			Charger: base,
+			ChargeRater: &decorateChargerChargeRaterImpl{
+				chargeRater: chargeRater,
+			},
+			ChargerEx: &decorateChargerChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Diagnosis: &decorateChargerDiagnosisImpl{
+				diagnosis: diagnosis,
+			},
+			Identifier: &decorateChargerIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decorateChargerMeterImpl{
+				meter: meter,
+			},
+			MeterCurrent: &decorateChargerMeterCurrentImpl{
+				meterCurrent: meterCurrent,
+			},
+			MeterEnergy: &decorateChargerMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseSwitcher: &decorateChargerPhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+		}
+	}
+
+	return nil
+}
+
+type decorateChargerChargeRaterImpl struct {
+	chargeRater func() (float64, error)
+}
+
+func (impl *decorateChargerChargeRaterImpl) ChargedEnergy() (float64, error) {
+	return impl.chargeRater()
+}
+
+type decorateChargerChargerExImpl struct {
+	chargerEx func(float64) error
+}
+
+func (impl *decorateChargerChargerExImpl) MaxCurrentMillis(p0 float64) error {
+	return impl.chargerEx(p0)
+}
+
+type decorateChargerDiagnosisImpl struct {
+	diagnosis func()
+}
+
+func (impl *decorateChargerDiagnosisImpl) Diagnose() {
+	impl.diagnosis()
+}
+
+type decorateChargerIdentifierImpl struct {
+	identifier func() (string, error)
+}
+
+func (impl *decorateChargerIdentifierImpl) Identify() (string, error) {
+	return impl.identifier()
+}
+
+type decorateChargerMeterImpl struct {
+	meter func() (float64, error)
+}
+
+func (impl *decorateChargerMeterImpl) CurrentPower() (float64, error) {
+	return impl.meter()
+}
+
+type decorateChargerMeterCurrentImpl struct {
+	meterCurrent func() (float64, float64, float64, error)
+}
+
+func (impl *decorateChargerMeterCurrentImpl) Currents() (float64, float64, float64, error) {
+	return impl.meterCurrent()
+}
+
+type decorateChargerMeterEnergyImpl struct {
+	meterEnergy func() (float64, error)
+}
+
+func (impl *decorateChargerMeterEnergyImpl) TotalEnergy() (float64, error) {
+	return impl.meterEnergy()
+}
+
+type decorateChargerPhaseSwitcherImpl struct {
+	phaseSwitcher func(int) error
+}
+
+func (impl *decorateChargerPhaseSwitcherImpl) Phases1p3p(p0 int) error {
+	return impl.phaseSwitcher(p0)
+}
@@ -0,0 +1,183 @@
+package metrics
+
+import (
+	"testing"
+
+	"github.com/evcc-io/evcc/api"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testMeter struct{}
+
+func (m *testMeter) CurrentPower() (float64, error) {
+	return 1e3, nil
+}
+
+type testEnergyMeter struct {
+	testMeter
+}
+
+func (m *testEnergyMeter) TotalEnergy() (float64, error) {
+	return 42, nil
+}
+
+type testCharger struct {
+	phases int
+}
+
+func (c *testCharger) Status() (api.ChargeStatus, error) {
+	return api.StatusC, nil
+}
+
+func (c *testCharger) Enabled() (bool, error) {
+	return true, nil
+}
+
+func (c *testCharger) Enable(enable bool) error {
+	return nil
+}
+
+func (c *testCharger) MaxCurrent(current int64) error {
+	return nil
+}
+
+type testSwitchingCharger struct {
+	testCharger
+}
+
+func (c *testSwitchingCharger) Phases1p3p(phases int) error {
+	c.phases = phases
+	return nil
+}
+
+type testMeterCharger struct {
+	testCharger
+	testEnergyMeter
+}
+
+func requests(name string) int64 {
+	for _, s := range Diagnostics() {
+		if s.Name == name {
+			return s.Requests
+		}
+	}
+	return 0
+}
+
+func TestInstrumentMeter(t *testing.T) {
+	m := InstrumentMeter("test-meter", &testMeter{})
+
+	_, ok := m.(api.MeterEnergy)
+	assert.False(t, ok)
+
+	_, err := m.CurrentPower()
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), requests("test-meter"))
+
+	m = InstrumentMeter("test-energy-meter", &testEnergyMeter{})
+
+	em, ok := m.(api.MeterEnergy)
+	require.True(t, ok)
+
+	_, ok = m.(api.MeterCurrent)
+	assert.False(t, ok)
+
+	energy, err := em.TotalEnergy()
+	require.NoError(t, err)
+	assert.Equal(t, 42.0, energy)
+	assert.Equal(t, int64(1), requests("test-energy-meter"))
+}
+
+func TestInstrumentCharger(t *testing.T) {
+	c := InstrumentCharger("test-charger", &testCharger{})
+
+	_, ok := c.(api.PhaseSwitcher)
+	assert.False(t, ok)
+
+	_, ok = c.(api.Meter)
+	assert.False(t, ok)
+
+	_, err := c.Status()
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), requests("test-charger"))
+
+	impl := &testSwitchingCharger{}
+	c = InstrumentCharger("test-switching-charger", impl)
+
+	ps, ok := c.(api.PhaseSwitcher)
+	require.True(t, ok)
+
+	require.NoError(t, ps.Phases1p3p(1))
+	assert.Equal(t, 1, impl.phases)
+	assert.Equal(t, int64(1), requests("test-switching-charger"))
+}
+
+func TestInstrumentChargerMeter(t *testing.T) {
+	c := InstrumentCharger("test-meter-charger", &testMeterCharger{})
+
+	m, ok := c.(api.Meter)
+	require.True(t, ok)
+
+	_, ok = m.(api.MeterEnergy)
+	assert.True(t, ok)
+
+	_, ok = m.(api.MeterCurrent)
+	assert.False(t, ok)
+
+	_, err := m.CurrentPower()
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), requests("test-meter-charger"))
+}
+
+type testControlCharger struct {
+	testCharger
+	controlled bool
+}
+
+func (c *testControlCharger) LoadpointControl() {
+	c.controlled = true
+}
+
+func TestInstrumentChargerUninstrumented(t *testing.T) {
+	impl := &testControlCharger{}
+	c := InstrumentCharger("test-control-charger", impl)
+
+	// optional interfaces that cannot be retained leave the charger uninstrumented
+	assert.Equal(t, api.Charger(impl), c)
+
+	ctrl, ok := c.(interface{ LoadpointControl() })
+	require.True(t, ok)
+
+	ctrl.LoadpointControl()
+	assert.True(t, impl.controlled)
+}
+
+type testVehicle struct {
+	api.Vehicle
+}
+
+func (v *testVehicle) SoC() (float64, error) {
+	return 50, nil
+}
+
+func (v *testVehicle) Range() (int64, error) {
+	return 200, nil
+}
+
+func TestInstrumentVehicle(t *testing.T) {
+	v := InstrumentVehicle("test-vehicle", &testVehicle{})
+
+	vr, ok := v.(api.VehicleRange)
+	require.True(t, ok)
+
+	_, ok = v.(api.ChargeState)
+	assert.False(t, ok)
+
+	_, err := v.SoC()
+	require.NoError(t, err)
+
+	_, err = vr.Range()
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), requests("test-vehicle"))
+}
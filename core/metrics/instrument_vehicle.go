@@ -0,0 +1,81 @@
+package metrics
+
+import (
+	"time"
+
+	"github.com/evcc-io/evcc/api"
+)
+
+//go:generate go run ../../cmd/tools/decorate.go -f decorateVehicle -b api.Vehicle -t "api.ChargeState,Status,func() (api.ChargeStatus, error)" -t "api.VehicleFinishTimer,FinishTime,func() (time.Time, error)" -t "api.VehicleRange,Range,func() (int64, error)" -t "api.VehicleClimater,Climater,func() (bool, float64, float64, error)" -t "api.VehicleOdometer,Odometer,func() (float64, error)" -t "api.VehiclePosition,Position,func() (float64, float64, error)" -t "api.Resurrector,WakeUp,func() error"
+
+// InstrumentVehicle returns the vehicle recording its requests. Vehicles with optional
+// interfaces that cannot be retained are returned uninstrumented.
+func InstrumentVehicle(name string, v api.Vehicle) api.Vehicle {
+	d := &device{typ: Vehicle, name: name}
+
+	var status func() (api.ChargeStatus, error)
+	if vv, ok := v.(api.ChargeState); ok {
+		status = func() (api.ChargeStatus, error) {
+			return observed(d, vv.Status)
+		}
+	}
+
+	var finishTime func() (time.Time, error)
+	if vv, ok := v.(api.VehicleFinishTimer); ok {
+		finishTime = func() (time.Time, error) {
+			return observed(d, vv.FinishTime)
+		}
+	}
+
+	var rng func() (int64, error)
+	if vv, ok := v.(api.VehicleRange); ok {
+		rng = func() (int64, error) {
+			return observed(d, vv.Range)
+		}
+	}
+
+	var climater func() (bool, float64, float64, error)
+	if vv, ok := v.(api.VehicleClimater); ok {
+		climater = func() (bool, float64, float64, error) {
+			start := time.Now()
+			active, outsideTemp, targetTemp, err := vv.Climater()
+			d.observe(start, err)
+			return active, outsideTemp, targetTemp, err
+		}
+	}
+
+	var odometer func() (float64, error)
+	if vv, ok := v.(api.VehicleOdometer); ok {
+		odometer = func() (float64, error) {
+			return observed(d, vv.Odometer)
+		}
+	}
+
+	var position func() (float64, float64, error)
+	if vv, ok := v.(api.VehiclePosition); ok {
+		position = func() (float64, float64, error) {
+			start := time.Now()
+			lat, lon, err := vv.Position()
+			d.observe(start, err)
+			return lat, lon, err
+		}
+	}
+
+	var wakeUp func() error
+	if vv, ok := v.(api.Resurrector); ok {
+		wakeUp = func() error {
+			return observedErr(d, vv.WakeUp)
+		}
+	}
+
+	return retains(v, decorateVehicle(&vehicle{d, v}, status, finishTime, rng, climater, odometer, position, wakeUp))
+}
+
+type vehicle struct {
+	*device
+	api.Vehicle
+}
+
+func (v *vehicle) SoC() (float64, error) {
+	return observed(v.device, v.Vehicle.SoC)
+}
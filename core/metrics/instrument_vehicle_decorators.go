@@ -0,0 +1,2755 @@
+package metrics
+
+// Code generated by github.com/evcc-io/evcc/cmd/tools/decorate.go. DO NOT EDIT.
+
+import (
+	"time"
+
+	"github.com/evcc-io/evcc/api"
+)
+
+func decorateVehicle(base api.Vehicle, chargeState func() (api.ChargeStatus, error), vehicleFinishTimer func() (time.Time, error), vehicleRange func() (int64, error), vehicleClimater func() (bool, float64, float64, error), vehicleOdometer func() (float64, error), vehiclePosition func() (float64, float64, error), resurrector func() error) api.Vehicle {
+	switch {
+	case chargeState == nil && resurrector == nil && vehicleClimater == nil && vehicleFinishTimer == nil && vehicleOdometer == nil && vehiclePosition == nil && vehicleRange == nil:
+		return base
+
+	case chargeState != nil && resurrector == nil && vehicleClimater == nil && vehicleFinishTimer == nil && vehicleOdometer == nil && vehiclePosition == nil && vehicleRange == nil:
+		return &struct {
+			api.Vehicle
+			api.ChargeState
+		}{
+			Vehicle: base,
+			ChargeState: &decorateVehicleChargeStateImpl{
+				chargeState: chargeState,
+			},
+		}
+
+	case chargeState == nil && resurrector == nil && vehicleClimater == nil && vehicleFinishTimer != nil && vehicleOdometer == nil && vehiclePosition == nil && vehicleRange == nil:
+		return &struct {
+			api.Vehicle
+			api.VehicleFinishTimer
+		}{
+			Vehicle: base,
+			VehicleFinishTimer: &decorateVehicleVehicleFinishTimerImpl{
+				vehicleFinishTimer: vehicleFinishTimer,
+			},
+		}
+
+	case chargeState != nil && resurrector == nil && vehicleClimater == nil && vehicleFinishTimer != nil && vehicleOdometer == nil && vehiclePosition == nil && vehicleRange == nil:
+		return &struct {
+			api.Vehicle
+			api.ChargeState
+			api.VehicleFinishTimer
+		}{
+			Vehicle: base,
+			ChargeState: &decorateVehicleChargeStateImpl{
+				chargeState: chargeState,
+			},
+			VehicleFinishTimer: &decorateVehicleVehicleFinishTimerImpl{
+				vehicleFinishTimer: vehicleFinishTimer,
+			},
+		}
+
+	case chargeState == nil && resurrector == nil && vehicleClimater == nil && vehicleFinishTimer == nil && vehicleOdometer == nil && vehiclePosition == nil && vehicleRange != nil:
+		return &struct {
+			api.Vehicle
+			api.VehicleRange
+		}{
+			Vehicle: base,
+			VehicleRange: &decorateVehicleVehicleRangeImpl{
+				vehicleRange: vehicleRange,
+			},
+		}
+
+	case chargeState != nil && resurrector == nil && vehicleClimater == nil && vehicleFinishTimer == nil && vehicleOdometer == nil && vehiclePosition == nil && vehicleRange != nil:
+		return &struct {
+			api.Vehicle
+			api.ChargeState
+			api.VehicleRange
+		}{
+			Vehicle: base,
+			ChargeState: &decorateVehicleChargeStateImpl{
+				chargeState: chargeState,
+			},
+			VehicleRange: &decorateVehicleVehicleRangeImpl{
+				vehicleRange: vehicleRange,
+			},
+		}
+
+	case chargeState == nil && resurrector == nil && vehicleClimater == nil && vehicleFinishTimer != nil && vehicleOdometer == nil && vehiclePosition == nil && vehicleRange != nil:
+		return &struct {
+			api.Vehicle
+			api.VehicleFinishTimer
+			api.VehicleRange
+		}{
+			Vehicle: base,
+			VehicleFinishTimer: &decorateVehicleVehicleFinishTimerImpl{
+				vehicleFinishTimer: vehicleFinishTimer,
+			},
+			VehicleRange: &decorateVehicleVehicleRangeImpl{
+				vehicleRange: vehicleRange,
+			},
+		}
+
+	case chargeState != nil && resurrector == nil && vehicleClimater == nil && vehicleFinishTimer != nil && vehicleOdometer == nil && vehiclePosition == nil && vehicleRange != nil:
+		return &struct {
+			api.Vehicle
+			api.ChargeState
+			api.VehicleFinishTimer
+			api.VehicleRange
+		}{
+			Vehicle: base,
+			ChargeState: &decorateVehicleChargeStateImpl{
+				chargeState: chargeState,
+			},
+			VehicleFinishTimer: &decorateVehicleVehicleFinishTimerImpl{
+				vehicleFinishTimer: vehicleFinishTimer,
+			},
+			VehicleRange: &decorateVehicleVehicleRangeImpl{
+				vehicleRange: vehicleRange,
+			},
+		}
+
+	case chargeState == nil && resurrector == nil && vehicleClimater != nil && vehicleFinishTimer == nil && vehicleOdometer == nil && vehiclePosition == nil && vehicleRange == nil:
+		return &struct {
+			api.Vehicle
+			api.VehicleClimater
+		}{
+			Vehicle: base,
+			VehicleClimater: &decorateVehicleVehicleClimaterImpl{
+				vehicleClimater: vehicleClimater,
+			},
+		}
+
+	case chargeState != nil && resurrector == nil && vehicleClimater != nil && vehicleFinishTimer == nil && vehicleOdometer == nil && vehiclePosition == nil && vehicleRange == nil:
+		return &struct {
+			api.Vehicle
+			api.ChargeState
+			api.VehicleClimater
+		}{
+			Vehicle: base,
+			ChargeState: &decorateVehicleChargeStateImpl{
+				chargeState: chargeState,
+			},
+			VehicleClimater: &decorateVehicleVehicleClimaterImpl{
+				vehicleClimater: vehicleClimater,
+			},
+		}
+
+	case chargeState == nil && resurrector == nil && vehicleClimater != nil && vehicleFinishTimer != nil && vehicleOdometer == nil && vehiclePosition == nil && vehicleRange == nil:
+		return &struct {
+			api.Vehicle
+			api.VehicleClimater
+			api.VehicleFinishTimer
+		}{
+			Vehicle: base,
+			VehicleClimater: &decorateVehicleVehicleClimaterImpl{
+				vehicleClimater: vehicleClimater,
+			},
+			VehicleFinishTimer: &decorateVehicleVehicleFinishTimerImpl{
+				vehicleFinishTimer: vehicleFinishTimer,
+			},
+		}
+
+	case chargeState != nil && resurrector == nil && vehicleClimater != nil && vehicleFinishTimer != nil && vehicleOdometer == nil && vehiclePosition == nil && vehicleRange == nil:
+		return &struct {
+			api.Vehicle
+			api.ChargeState
+			api.VehicleClimater
+			api.VehicleFinishTimer
+		}{
+			Vehicle: base,
+			ChargeState: &decorateVehicleChargeStateImpl{
+				chargeState: chargeState,
+			},
+			VehicleClimater: &decorateVehicleVehicleClimaterImpl{
+				vehicleClimater: vehicleClimater,
+			},
+			VehicleFinishTimer: &decorateVehicleVehicleFinishTimerImpl{
+				vehicleFinishTimer: vehicleFinishTimer,
+			},
+		}
+
+	case chargeState == nil && resurrector == nil && vehicleClimater != nil && vehicleFinishTimer == nil && vehicleOdometer == nil && vehiclePosition == nil && vehicleRange != nil:
+		return &struct {
+			api.Vehicle
+			api.VehicleClimater
+			api.VehicleRange
+		}{
+			Vehicle: base,
+			VehicleClimater: &decorateVehicleVehicleClimaterImpl{
+				vehicleClimater: vehicleClimater,
+			},
+			VehicleRange: &decorateVehicleVehicleRangeImpl{
+				vehicleRange: vehicleRange,
+			},
+		}
+
+	case chargeState != nil && resurrector == nil && vehicleClimater != nil && vehicleFinishTimer == nil && vehicleOdometer == nil && vehiclePosition == nil && vehicleRange != nil:
+		return &struct {
+			api.Vehicle
+			api.ChargeState
+			api.VehicleClimater
+			api.VehicleRange
+		}{
+			Vehicle: base,
+			ChargeState: &decorateVehicleChargeStateImpl{
+				chargeState: chargeState,
+			},
+			VehicleClimater: &decorateVehicleVehicleClimaterImpl{
+				vehicleClimater: vehicleClimater,
+			},
+			VehicleRange: &decorateVehicleVehicleRangeImpl{
+				vehicleRange: vehicleRange,
+			},
+		}
+
+	case chargeState == nil && resurrector == nil && vehicleClimater != nil && vehicleFinishTimer != nil && vehicleOdometer == nil && vehiclePosition == nil && vehicleRange != nil:
+		return &struct {
+			api.Vehicle
+			api.VehicleClimater
+			api.VehicleFinishTimer
+			api.VehicleRange
+		}{
+			Vehicle: base,
+			VehicleClimater: &decorateVehicleVehicleClimaterImpl{
+				vehicleClimater: vehicleClimater,
+			},
+			VehicleFinishTimer: &decorateVehicleVehicleFinishTimerImpl{
+				vehicleFinishTimer: vehicleFinishTimer,
+			},
+			VehicleRange: &decorateVehicleVehicleRangeImpl{
+				vehicleRange: vehicleRange,
+			},
+		}
+
+	case chargeState != nil && resurrector == nil && vehicleClimater != nil && vehicleFinishTimer != nil && vehicleOdometer == nil && vehiclePosition == nil && vehicleRange != nil:
+		return &struct {
+			api.Vehicle
+			api.ChargeState
+			api.VehicleClimater
+			api.VehicleFinishTimer
+			api.VehicleRange
+		}{
+			Vehicle: base,
+			ChargeState: &decorateVehicleChargeStateImpl{
+				chargeState: chargeState,
+			},
+			VehicleClimater: &decorateVehicleVehicleClimaterImpl{
+				vehicleClimater: vehicleClimater,
+			},
+			VehicleFinishTimer: &decorateVehicleVehicleFinishTimerImpl{
+				vehicleFinishTimer: vehicleFinishTimer,
+			},
+			VehicleRange: &decorateVehicleVehicleRangeImpl{
+				vehicleRange: vehicleRange,
+			},
+		}
+
+	case chargeState == nil && resurrector == nil && vehicleClimater == nil && vehicleFinishTimer == nil && vehicleOdometer != nil && vehiclePosition == nil && vehicleRange == nil:
+		return &struct {
+			api.Vehicle
+			api.VehicleOdometer
+		}{
+			Vehicle: base,
+			VehicleOdometer: &decorateVehicleVehicleOdometerImpl{
+				vehicleOdometer: vehicleOdometer,
+			},
+		}
+
+	case chargeState != nil && resurrector == nil && vehicleClimater == nil && vehicleFinishTimer == nil && vehicleOdometer != nil && vehiclePosition == nil && vehicleRange == nil:
+		return &struct {
+			api.Vehicle
+			api.ChargeState
+			api.VehicleOdometer
+		}{
+			Vehicle: base,
+			ChargeState: &decorateVehicleChargeStateImpl{
+				chargeState: chargeState,
+			},
+			VehicleOdometer: &decorateVehicleVehicleOdometerImpl{
+				vehicleOdometer: vehicleOdometer,
+			},
+		}
+
+	case chargeState == nil && resurrector == nil && vehicleClimater == nil && vehicleFinishTimer != nil && vehicleOdometer != nil && vehiclePosition == nil && vehicleRange == nil:
+		return &struct {
+			api.Vehicle
+			api.VehicleFinishTimer
+			api.VehicleOdometer
+		}{
+			Vehicle: base,
+			VehicleFinishTimer: &decorateVehicleVehicleFinishTimerImpl{
+				vehicleFinishTimer: vehicleFinishTimer,
+			},
+			VehicleOdometer: &decorateVehicleVehicleOdometerImpl{
+				vehicleOdometer: vehicleOdometer,
+			},
+		}
+
+	case chargeState != nil && resurrector == nil && vehicleClimater == nil && vehicleFinishTimer != nil && vehicleOdometer != nil && vehiclePosition == nil && vehicleRange == nil:
+		return &struct {
+			api.Vehicle
+			api.ChargeState
+			api.VehicleFinishTimer
+			api.VehicleOdometer
+		}{
+			Vehicle: base,
+			ChargeState: &decorateVehicleChargeStateImpl{
+				chargeState: chargeState,
+			},
+			VehicleFinishTimer: &decorateVehicleVehicleFinishTimerImpl{
+				vehicleFinishTimer: vehicleFinishTimer,
+			},
+			VehicleOdometer: &decorateVehicleVehicleOdometerImpl{
+				vehicleOdometer: vehicleOdometer,
+			},
+		}
+
+	case chargeState == nil && resurrector == nil && vehicleClimater == nil && vehicleFinishTimer == nil && vehicleOdometer != nil && vehiclePosition == nil && vehicleRange != nil:
+		return &struct {
+			api.Vehicle
+			api.VehicleOdometer
+			api.VehicleRange
+		}{
+			Vehicle: base,
+			VehicleOdometer: &decorateVehicleVehicleOdometerImpl{
+				vehicleOdometer: vehicleOdometer,
+			},
+			VehicleRange: &decorateVehicleVehicleRangeImpl{
+				vehicleRange: vehicleRange,
+			},
+		}
+
+	case chargeState != nil && resurrector == nil && vehicleClimater == nil && vehicleFinishTimer == nil && vehicleOdometer != nil && vehiclePosition == nil && vehicleRange != nil:
+		return &struct {
+			api.Vehicle
+			api.ChargeState
+			api.VehicleOdometer
+			api.VehicleRange
+		}{
+			Vehicle: base,
+			ChargeState: &decorateVehicleChargeStateImpl{
+				chargeState: chargeState,
+			},
+			VehicleOdometer: &decorateVehicleVehicleOdometerImpl{
+				vehicleOdometer: vehicleOdometer,
+			},
+			VehicleRange: &decorateVehicleVehicleRangeImpl{
+				vehicleRange: vehicleRange,
+			},
+		}
+
+	case chargeState == nil && resurrector == nil && vehicleClimater == nil && vehicleFinishTimer != nil && vehicleOdometer != nil && vehiclePosition == nil && vehicleRange != nil:
+		return &struct {
+			api.Vehicle
+			api.VehicleFinishTimer
+			api.VehicleOdometer
+			api.VehicleRange
+		}{
+			Vehicle: base,
+			VehicleFinishTimer: &decorateVehicleVehicleFinishTimerImpl{
+				vehicleFinishTimer: vehicleFinishTimer,
+			},
+			VehicleOdometer: &decorateVehicleVehicleOdometerImpl{
+				vehicleOdometer: vehicleOdometer,
+			},
+			VehicleRange: &decorateVehicleVehicleRangeImpl{
+				vehicleRange: vehicleRange,
+			},
+		}
+
+	case chargeState != nil && resurrector == nil && vehicleClimater == nil && vehicleFinishTimer != nil && vehicleOdometer != nil && vehiclePosition == nil && vehicleRange != nil:
+		return &struct {
+			api.Vehicle
+			api.ChargeState
+			api.VehicleFinishTimer
+			api.VehicleOdometer
+			api.VehicleRange
+		}{
+			Vehicle: base,
+			ChargeState: &decorateVehicleChargeStateImpl{
+				chargeState: chargeState,
+			},
+			VehicleFinishTimer: &decorateVehicleVehicleFinishTimerImpl{
+				vehicleFinishTimer: vehicleFinishTimer,
+			},
+			VehicleOdometer: &decorateVehicleVehicleOdometerImpl{
+				vehicleOdometer: vehicleOdometer,
+			},
+			VehicleRange: &decorateVehicleVehicleRangeImpl{
+				vehicleRange: vehicleRange,
+			},
+		}
+
+	case chargeState == nil && resurrector == nil && vehicleClimater != nil && vehicleFinishTimer == nil && vehicleOdometer != nil && vehiclePosition == nil && vehicleRange == nil:
+		return &struct {
+			api.Vehicle
+			api.VehicleClimater
+			api.VehicleOdometer
+		}{
+			Vehicle: base,
+			VehicleClimater: &decorateVehicleVehicleClimaterImpl{
+				vehicleClimater: vehicleClimater,
+			},
+			VehicleOdometer: &decorateVehicleVehicleOdometerImpl{
+				vehicleOdometer: vehicleOdometer,
+			},
+		}
+
+	case chargeState != nil && resurrector == nil && vehicleClimater != nil && vehicleFinishTimer == nil && vehicleOdometer != nil && vehiclePosition == nil && vehicleRange == nil:
+		return &struct {
+			api.Vehicle
+			api.ChargeState
+			api.VehicleClimater
+			api.VehicleOdometer
+		}{
+			Vehicle: base,
+			ChargeState: &decorateVehicleChargeStateImpl{
+				chargeState: chargeState,
+			},
+			VehicleClimater: &decorateVehicleVehicleClimaterImpl{
+				vehicleClimater: vehicleClimater,
+			},
+			VehicleOdometer: &decorateVehicleVehicleOdometerImpl{
+				vehicleOdometer: vehicleOdometer,
+			},
+		}
+
+	case chargeState == nil && resurrector == nil && vehicleClimater != nil && vehicleFinishTimer != nil && vehicleOdometer != nil && vehiclePosition == nil && vehicleRange == nil:
+		return &struct {
+			api.Vehicle
+			api.VehicleClimater
+			api.VehicleFinishTimer
+			api.VehicleOdometer
+		}{
+			Vehicle: base,
+			VehicleClimater: &decorateVehicleVehicleClimaterImpl{
+				vehicleClimater: vehicleClimater,
+			},
+			VehicleFinishTimer: &decorateVehicleVehicleFinishTimerImpl{
+				vehicleFinishTimer: vehicleFinishTimer,
+			},
+			VehicleOdometer: &decorateVehicleVehicleOdometerImpl{
+				vehicleOdometer: vehicleOdometer,
+			},
+		}
+
+	case chargeState != nil && resurrector == nil && vehicleClimater != nil && vehicleFinishTimer != nil && vehicleOdometer != nil && vehiclePosition == nil && vehicleRange == nil:
+		return &struct {
+			api.Vehicle
+			api.ChargeState
+			api.VehicleClimater
+			api.VehicleFinishTimer
+			api.VehicleOdometer
+		}{
+			Vehicle: base,
+			ChargeState: &decorateVehicleChargeStateImpl{
+				chargeState: chargeState,
+			},
+			VehicleClimater: &decorateVehicleVehicleClimaterImpl{
+				vehicleClimater: vehicleClimater,
+			},
+			VehicleFinishTimer: &decorateVehicleVehicleFinishTimerImpl{
+				vehicleFinishTimer: vehicleFinishTimer,
+			},
+			VehicleOdometer: &decorateVehicleVehicleOdometerImpl{
+				vehicleOdometer: vehicleOdometer,
+			},
+		}
+
+	case chargeState == nil && resurrector == nil && vehicleClimater != nil && vehicleFinishTimer == nil && vehicleOdometer != nil && vehiclePosition == nil && vehicleRange != nil:
+		return &struct {
+			api.Vehicle
+			api.VehicleClimater
+			api.VehicleOdometer
+			api.VehicleRange
+		}{
+			Vehicle: base,
+			VehicleClimater: &decorateVehicleVehicleClimaterImpl{
+				vehicleClimater: vehicleClimater,
+			},
+			VehicleOdometer: &decorateVehicleVehicleOdometerImpl{
+				vehicleOdometer: vehicleOdometer,
+			},
+			VehicleRange: &decorateVehicleVehicleRangeImpl{
+				vehicleRange: vehicleRange,
+			},
+		}
+
+	case chargeState != nil && resurrector == nil && vehicleClimater != nil && vehicleFinishTimer == nil && vehicleOdometer != nil && vehiclePosition == nil && vehicleRange != nil:
+		return &struct {
+			api.Vehicle
+			api.ChargeState
+			api.VehicleClimater
+			api.VehicleOdometer
+			api.VehicleRange
+		}{
+			Vehicle: base,
+			ChargeState: &decorateVehicleChargeStateImpl{
+				chargeState: chargeState,
+			},
+			VehicleClimater: &decorateVehicleVehicleClimaterImpl{
+				vehicleClimater: vehicleClimater,
+			},
+			VehicleOdometer: &decorateVehicleVehicleOdometerImpl{
+				vehicleOdometer: vehicleOdometer,
+			},
+			VehicleRange: &decorateVehicleVehicleRangeImpl{
+				vehicleRange: vehicleRange,
+			},
+		}
+
+	case chargeState == nil && resurrector == nil && vehicleClimater != nil && vehicleFinishTimer != nil && vehicleOdometer != nil && vehiclePosition == nil && vehicleRange != nil:
+		return &struct {
+			api.Vehicle
+			api.VehicleClimater
+			api.VehicleFinishTimer
+			api.VehicleOdometer
+			api.VehicleRange
+		}{
+			Vehicle: base,
+			VehicleClimater: &decorateVehicleVehicleClimaterImpl{
+				vehicleClimater: vehicleClimater,
+			},
+			VehicleFinishTimer: &decorateVehicleVehicleFinishTimerImpl{
+				vehicleFinishTimer: vehicleFinishTimer,
+			},
+			VehicleOdometer: &decorateVehicleVehicleOdometerImpl{
+				vehicleOdometer: vehicleOdometer,
+			},
+			VehicleRange: &decorateVehicleVehicleRangeImpl{
+				vehicleRange: vehicleRange,
+			},
+		}
+
+	case chargeState != nil && resurrector == nil && vehicleClimater != nil && vehicleFinishTimer != nil && vehicleOdometer != nil && vehiclePosition == nil && vehicleRange != nil:
+		return &struct {
+			api.Vehicle
+			api.ChargeState
+			api.VehicleClimater
+			api.VehicleFinishTimer
+			api.VehicleOdometer
+			api.VehicleRange
+		}{
+			Vehicle: base,
+			ChargeState: &decorateVehicleChargeStateImpl{
+				chargeState: chargeState,
+			},
+			VehicleClimater: &decorateVehicleVehicleClimaterImpl{
+				vehicleClimater: vehicleClimater,
+			},
+			VehicleFinishTimer: &decorateVehicleVehicleFinishTimerImpl{
+				vehicleFinishTimer: vehicleFinishTimer,
+			},
+			VehicleOdometer: &decorateVehicleVehicleOdometerImpl{
+				vehicleOdometer: vehicleOdometer,
+			},
+			VehicleRange: &decorateVehicleVehicleRangeImpl{
+				vehicleRange: vehicleRange,
+			},
+		}
+
+	case chargeState == nil && resurrector == nil && vehicleClimater == nil && vehicleFinishTimer == nil && vehicleOdometer == nil && vehiclePosition != nil && vehicleRange == nil:
+		return &struct {
+			api.Vehicle
+			api.VehiclePosition
+		}{
+			Vehicle: base,
+			VehiclePosition: &decorateVehicleVehiclePositionImpl{
+				vehiclePosition: vehiclePosition,
+			},
+		}
+
+	case chargeState != nil && resurrector == nil && vehicleClimater == nil && vehicleFinishTimer == nil && vehicleOdometer == nil && vehiclePosition != nil && vehicleRange == nil:
+		return &struct {
+			api.Vehicle
+			api.ChargeState
+			api.VehiclePosition
+		}{
+			Vehicle: base,
+			ChargeState: &decorateVehicleChargeStateImpl{
+				chargeState: chargeState,
+			},
+			VehiclePosition: &decorateVehicleVehiclePositionImpl{
+				vehiclePosition: vehiclePosition,
+			},
+		}
+
+	case chargeState == nil && resurrector == nil && vehicleClimater == nil && vehicleFinishTimer != nil && vehicleOdometer == nil && vehiclePosition != nil && vehicleRange == nil:
+		return &struct {
+			api.Vehicle
+			api.VehicleFinishTimer
+			api.VehiclePosition
+		}{
+			Vehicle: base,
+			VehicleFinishTimer: &decorateVehicleVehicleFinishTimerImpl{
+				vehicleFinishTimer: vehicleFinishTimer,
+			},
+			VehiclePosition: &decorateVehicleVehiclePositionImpl{
+				vehiclePosition: vehiclePosition,
+			},
+		}
+
+	case chargeState != nil && resurrector == nil && vehicleClimater == nil && vehicleFinishTimer != nil && vehicleOdometer == nil && vehiclePosition != nil && vehicleRange == nil:
+		return &struct {
+			api.Vehicle
+			api.ChargeState
+			api.VehicleFinishTimer
+			api.VehiclePosition
+		}{
+			Vehicle: base,
+			ChargeState: &decorateVehicleChargeStateImpl{
+				chargeState: chargeState,
+			},
+			VehicleFinishTimer: &decorateVehicleVehicleFinishTimerImpl{
+				vehicleFinishTimer: vehicleFinishTimer,
+			},
+			VehiclePosition: &decorateVehicleVehiclePositionImpl{
+				vehiclePosition: vehiclePosition,
+			},
+		}
+
+	case chargeState == nil && resurrector == nil && vehicleClimater == nil && vehicleFinishTimer == nil && vehicleOdometer == nil && vehiclePosition != nil && vehicleRange != nil:
+		return &struct {
+			api.Vehicle
+			api.VehiclePosition
+			api.VehicleRange
+		}{
+			Vehicle: base,
+			VehiclePosition: &decorateVehicleVehiclePositionImpl{
+				vehiclePosition: vehiclePosition,
+			},
+			VehicleRange: &decorateVehicleVehicleRangeImpl{
+				vehicleRange: vehicleRange,
+			},
+		}
+
+	case chargeState != nil && resurrector == nil && vehicleClimater == nil && vehicleFinishTimer == nil && vehicleOdometer == nil && vehiclePosition != nil && vehicleRange != nil:
+		return &struct {
+			api.Vehicle
+			api.ChargeState
+			api.VehiclePosition
+			api.VehicleRange
+		}{
+			Vehicle: base,
+			ChargeState: &decorateVehicleChargeStateImpl{
+				chargeState: chargeState,
+			},
+			VehiclePosition: &decorateVehicleVehiclePositionImpl{
+				vehiclePosition: vehiclePosition,
+			},
+			VehicleRange: &decorateVehicleVehicleRangeImpl{
+				vehicleRange: vehicleRange,
+			},
+		}
+
+	case chargeState == nil && resurrector == nil && vehicleClimater == nil && vehicleFinishTimer != nil && vehicleOdometer == nil && vehiclePosition != nil && vehicleRange != nil:
+		return &struct {
+			api.Vehicle
+			api.VehicleFinishTimer
+			api.VehiclePosition
+			api.VehicleRange
+		}{
+			Vehicle: base,
+			VehicleFinishTimer: &decorateVehicleVehicleFinishTimerImpl{
+				vehicleFinishTimer: vehicleFinishTimer,
+			},
+			VehiclePosition: &decorateVehicleVehiclePositionImpl{
+				vehiclePosition: vehiclePosition,
+			},
+			VehicleRange: &decorateVehicleVehicleRangeImpl{
+				vehicleRange: vehicleRange,
+			},
+		}
+
+	case chargeState != nil && resurrector == nil && vehicleClimater == nil && vehicleFinishTimer != nil && vehicleOdometer == nil && vehiclePosition != nil && vehicleRange != nil:
+		return &struct {
+			api.Vehicle
+			api.ChargeState
+			api.VehicleFinishTimer
+			api.VehiclePosition
+			api.VehicleRange
+		}{
+			Vehicle: base,
+			ChargeState: &decorateVehicleChargeStateImpl{
+				chargeState: chargeState,
+			},
+			VehicleFinishTimer: &decorateVehicleVehicleFinishTimerImpl{
+				vehicleFinishTimer: vehicleFinishTimer,
+			},
+			VehiclePosition: &decorateVehicleVehiclePositionImpl{
+				vehiclePosition: vehiclePosition,
+			},
+			VehicleRange: &decorateVehicleVehicleRangeImpl{
+				vehicleRange: vehicleRange,
+			},
+		}
+
+	case chargeState == nil && resurrector == nil && vehicleClimater != nil && vehicleFinishTimer == nil && vehicleOdometer == nil && vehiclePosition != nil && vehicleRange == nil:
+		return &struct {
+			api.Vehicle
+			api.VehicleClimater
+			api.VehiclePosition
+		}{
+			Vehicle: base,
+			VehicleClimater: &decorateVehicleVehicleClimaterImpl{
+				vehicleClimater: vehicleClimater,
+			},
+			VehiclePosition: &decorateVehicleVehiclePositionImpl{
+				vehiclePosition: vehiclePosition,
+			},
+		}
+
+	case chargeState != nil && resurrector == nil && vehicleClimater != nil && vehicleFinishTimer == nil && vehicleOdometer == nil && vehiclePosition != nil && vehicleRange == nil:
+		return &struct {
+			api.Vehicle
+			api.ChargeState
+			api.VehicleClimater
+			api.VehiclePosition
+		}{
+			Vehicle: base,
+			ChargeState: &decorateVehicleChargeStateImpl{
+				chargeState: chargeState,
+			},
+			VehicleClimater: &decorateVehicleVehicleClimaterImpl{
+				vehicleClimater: vehicleClimater,
+			},
+			VehiclePosition: &decorateVehicleVehiclePositionImpl{
+				vehiclePosition: vehiclePosition,
+			},
+		}
+
+	case chargeState == nil && resurrector == nil && vehicleClimater != nil && vehicleFinishTimer != nil && vehicleOdometer == nil && vehiclePosition != nil && vehicleRange == nil:
+		return &struct {
+			api.Vehicle
+			api.VehicleClimater
+			api.VehicleFinishTimer
+			api.VehiclePosition
+		}{
+			Vehicle: base,
+			VehicleClimater: &decorateVehicleVehicleClimaterImpl{
+				vehicleClimater: vehicleClimater,
+			},
+			VehicleFinishTimer: &decorateVehicleVehicleFinishTimerImpl{
+				vehicleFinishTimer: vehicleFinishTimer,
+			},
+			VehiclePosition: &decorateVehicleVehiclePositionImpl{
+				vehiclePosition: vehiclePosition,
+			},
+		}
+
+	case chargeState != nil && resurrector == nil && vehicleClimater != nil && vehicleFinishTimer != nil && vehicleOdometer == nil && vehiclePosition != nil && vehicleRange == nil:
+		return &struct {
+			api.Vehicle
+			api.ChargeState
+			api.VehicleClimater
+			api.VehicleFinishTimer
+			api.VehiclePosition
+		}{
+			Vehicle: base,
+			ChargeState: &decorateVehicleChargeStateImpl{
+				chargeState: chargeState,
+			},
+			VehicleClimater: &decorateVehicleVehicleClimaterImpl{
+				vehicleClimater: vehicleClimater,
+			},
+			VehicleFinishTimer: &decorateVehicleVehicleFinishTimerImpl{
+				vehicleFinishTimer: vehicleFinishTimer,
+			},
+			VehiclePosition: &decorateVehicleVehiclePositionImpl{
+				vehiclePosition: vehiclePosition,
+			},
+		}
+
+	case chargeState == nil && resurrector == nil && vehicleClimater != nil && vehicleFinishTimer == nil && vehicleOdometer == nil && vehiclePosition != nil && vehicleRange != nil:
+		return &struct {
+			api.Vehicle
+			api.VehicleClimater
+			api.VehiclePosition
+			api.VehicleRange
+		}{
+			Vehicle: base,
+			VehicleClimater: &decorateVehicleVehicleClimaterImpl{
+				vehicleClimater: vehicleClimater,
+			},
+			VehiclePosition: &decorateVehicleVehiclePositionImpl{
+				vehiclePosition: vehiclePosition,
+			},
+			VehicleRange: &decorateVehicleVehicleRangeImpl{
+				vehicleRange: vehicleRange,
+			},
+		}
+
+	case chargeState != nil && resurrector == nil && vehicleClimater != nil && vehicleFinishTimer == nil && vehicleOdometer == nil && vehiclePosition != nil && vehicleRange != nil:
+		return &struct {
+			api.Vehicle
+			api.ChargeState
+			api.VehicleClimater
+			api.VehiclePosition
+			api.VehicleRange
+		}{
+			Vehicle: base,
+			ChargeState: &decorateVehicleChargeStateImpl{
+				chargeState: chargeState,
+			},
+			VehicleClimater: &decorateVehicleVehicleClimaterImpl{
+				vehicleClimater: vehicleClimater,
+			},
+			VehiclePosition: &decorateVehicleVehiclePositionImpl{
+				vehiclePosition: vehiclePosition,
+			},
+			VehicleRange: &decorateVehicleVehicleRangeImpl{
+				vehicleRange: vehicleRange,
+			},
+		}
+
+	case chargeState == nil && resurrector == nil && vehicleClimater != nil && vehicleFinishTimer != nil && vehicleOdometer == nil && vehiclePosition != nil && vehicleRange != nil:
+		return &struct {
+			api.Vehicle
+			api.VehicleClimater
+			api.VehicleFinishTimer
+			api.VehiclePosition
+			api.VehicleRange
+		}{
+			Vehicle: base,
+			VehicleClimater: &decorateVehicleVehicleClimaterImpl{
+				vehicleClimater: vehicleClimater,
+			},
+			VehicleFinishTimer: &decorateVehicleVehicleFinishTimerImpl{
+				vehicleFinishTimer: vehicleFinishTimer,
+			},
+			VehiclePosition: &decorateVehicleVehiclePositionImpl{
+				vehiclePosition: vehiclePosition,
+			},
+			VehicleRange: &decorateVehicleVehicleRangeImpl{
+				vehicleRange: vehicleRange,
+			},
+		}
+
+	case chargeState != nil && resurrector == nil && vehicleClimater != nil && vehicleFinishTimer != nil && vehicleOdometer == nil && vehiclePosition != nil && vehicleRange != nil:
+		return &struct {
+			api.Vehicle
+			api.ChargeState
+			api.VehicleClimater
+			api.VehicleFinishTimer
+			api.VehiclePosition
+			api.VehicleRange
+		}{
+			Vehicle: base,
+			ChargeState: &decorateVehicleChargeStateImpl{
+				chargeState: chargeState,
+			},
+			VehicleClimater: &decorateVehicleVehicleClimaterImpl{
+				vehicleClimater: vehicleClimater,
+			},
+			VehicleFinishTimer: &decorateVehicleVehicleFinishTimerImpl{
+				vehicleFinishTimer: vehicleFinishTimer,
+			},
+			VehiclePosition: &decorateVehicleVehiclePositionImpl{
+				vehiclePosition: vehiclePosition,
+			},
+			VehicleRange: &decorateVehicleVehicleRangeImpl{
+				vehicleRange: vehicleRange,
+			},
+		}
+
+	case chargeState == nil && resurrector == nil && vehicleClimater == nil && vehicleFinishTimer == nil && vehicleOdometer != nil && vehiclePosition != nil && vehicleRange == nil:
+		return &struct {
+			api.Vehicle
+			api.VehicleOdometer
+			api.VehiclePosition
+		}{
+			Vehicle: base,
+			VehicleOdometer: &decorateVehicleVehicleOdometerImpl{
+				vehicleOdometer: vehicleOdometer,
+			},
+			VehiclePosition: &decorateVehicleVehiclePositionImpl{
+				vehiclePosition: vehiclePosition,
+			},
+		}
+
+	case chargeState != nil && resurrector == nil && vehicleClimater == nil && vehicleFinishTimer == nil && vehicleOdometer != nil && vehiclePosition != nil && vehicleRange == nil:
+		return &struct {
+			api.Vehicle
+			api.ChargeState
+			api.VehicleOdometer
+			api.VehiclePosition
+		}{
+			Vehicle: base,
+			ChargeState: &decorateVehicleChargeStateImpl{
+				chargeState: chargeState,
+			},
+			VehicleOdometer: &decorateVehicleVehicleOdometerImpl{
+				vehicleOdometer: vehicleOdometer,
+			},
+			VehiclePosition: &decorateVehicleVehiclePositionImpl{
+				vehiclePosition: vehiclePosition,
+			},
+		}
+
+	case chargeState == nil && resurrector == nil && vehicleClimater == nil && vehicleFinishTimer != nil && vehicleOdometer != nil && vehiclePosition != nil && vehicleRange == nil:
+		return &struct {
+			api.Vehicle
+			api.VehicleFinishTimer
+			api.VehicleOdometer
+			api.VehiclePosition
+		}{
+			Vehicle: base,
+			VehicleFinishTimer: &decorateVehicleVehicleFinishTimerImpl{
+				vehicleFinishTimer: vehicleFinishTimer,
+			},
+			VehicleOdometer: &decorateVehicleVehicleOdometerImpl{
+				vehicleOdometer: vehicleOdometer,
+			},
+			VehiclePosition: &decorateVehicleVehiclePositionImpl{
+				vehiclePosition: vehiclePosition,
+			},
+		}
+
+	case chargeState != nil && resurrector == nil && vehicleClimater == nil && vehicleFinishTimer != nil && vehicleOdometer != nil && vehiclePosition != nil && vehicleRange == nil:
+		return &struct {
+			api.Vehicle
+			api.ChargeState
+			api.VehicleFinishTimer
+			api.VehicleOdometer
+			api.VehiclePosition
+		}{
+			Vehicle: base,
+			ChargeState: &decorateVehicleChargeStateImpl{
+				chargeState: chargeState,
+			},
+			VehicleFinishTimer: &decorateVehicleVehicleFinishTimerImpl{
+				vehicleFinishTimer: vehicleFinishTimer,
+			},
+			VehicleOdometer: &decorateVehicleVehicleOdometerImpl{
+				vehicleOdometer: vehicleOdometer,
+			},
+			VehiclePosition: &decorateVehicleVehiclePositionImpl{
+				vehiclePosition: vehiclePosition,
+			},
+		}
+
+	case chargeState == nil && resurrector == nil && vehicleClimater == nil && vehicleFinishTimer == nil && vehicleOdometer != nil && vehiclePosition != nil && vehicleRange != nil:
+		return &struct {
+			api.Vehicle
+			api.VehicleOdometer
+			api.VehiclePosition
+			api.VehicleRange
+		}{
+			Vehicle: base,
+			VehicleOdometer: &decorateVehicleVehicleOdometerImpl{
+				vehicleOdometer: vehicleOdometer,
+			},
+			VehiclePosition: &decorateVehicleVehiclePositionImpl{
+				vehiclePosition: vehiclePosition,
+			},
+			VehicleRange: &decorateVehicleVehicleRangeImpl{
+				vehicleRange: vehicleRange,
+			},
+		}
+
+	case chargeState != nil && resurrector == nil && vehicleClimater == nil && vehicleFinishTimer == nil && vehicleOdometer != nil && vehiclePosition != nil && vehicleRange != nil:
+		return &struct {
+			api.Vehicle
+			api.ChargeState
+			api.VehicleOdometer
+			api.VehiclePosition
+			api.VehicleRange
+		}{
+			Vehicle: base,
+			ChargeState: &decorateVehicleChargeStateImpl{
+				chargeState: chargeState,
+			},
+			VehicleOdometer: &decorateVehicleVehicleOdometerImpl{
+				vehicleOdometer: vehicleOdometer,
+			},
+			VehiclePosition: &decorateVehicleVehiclePositionImpl{
+				vehiclePosition: vehiclePosition,
+			},
+			VehicleRange: &decorateVehicleVehicleRangeImpl{
+				vehicleRange: vehicleRange,
+			},
+		}
+
+	case chargeState == nil && resurrector == nil && vehicleClimater == nil && vehicleFinishTimer != nil && vehicleOdometer != nil && vehiclePosition != nil && vehicleRange != nil:
+		return &struct {
+			api.Vehicle
+			api.VehicleFinishTimer
+			api.VehicleOdometer
+			api.VehiclePosition
+			api.VehicleRange
+		}{
+			Vehicle: base,
+			VehicleFinishTimer: &decorateVehicleVehicleFinishTimerImpl{
+				vehicleFinishTimer: vehicleFinishTimer,
+			},
+			VehicleOdometer: &decorateVehicleVehicleOdometerImpl{
+				vehicleOdometer: vehicleOdometer,
+			},
+			VehiclePosition: &decorateVehicleVehiclePositionImpl{
+				vehiclePosition: vehiclePosition,
+			},
+			VehicleRange: &decorateVehicleVehicleRangeImpl{
+				vehicleRange: vehicleRange,
+			},
+		}
+
+	case chargeState != nil && resurrector == nil && vehicleClimater == nil && vehicleFinishTimer != nil && vehicleOdometer != nil && vehiclePosition != nil && vehicleRange != nil:
+		return &struct {
+			api.Vehicle
+			api.ChargeState
+			api.VehicleFinishTimer
+			api.VehicleOdometer
+			api.VehiclePosition
+			api.VehicleRange
+		}{
+			Vehicle: base,
+			ChargeState: &decorateVehicleChargeStateImpl{
+				chargeState: chargeState,
+			},
+			VehicleFinishTimer: &decorateVehicleVehicleFinishTimerImpl{
+				vehicleFinishTimer: vehicleFinishTimer,
+			},
+			VehicleOdometer: &decorateVehicleVehicleOdometerImpl{
+				vehicleOdometer: vehicleOdometer,
+			},
+			VehiclePosition: &decorateVehicleVehiclePositionImpl{
+				vehiclePosition: vehiclePosition,
+			},
+			VehicleRange: &decorateVehicleVehicleRangeImpl{
+				vehicleRange: vehicleRange,
+			},
+		}
+
+	case chargeState == nil && resurrector == nil && vehicleClimater != nil && vehicleFinishTimer == nil && vehicleOdometer != nil && vehiclePosition != nil && vehicleRange == nil:
+		return &struct {
+			api.Vehicle
+			api.VehicleClimater
+			api.VehicleOdometer
+			api.VehiclePosition
+		}{
+			Vehicle: base,
+			VehicleClimater: &decorateVehicleVehicleClimaterImpl{
+				vehicleClimater: vehicleClimater,
+			},
+			VehicleOdometer: &decorateVehicleVehicleOdometerImpl{
+				vehicleOdometer: vehicleOdometer,
+			},
+			VehiclePosition: &decorateVehicleVehiclePositionImpl{
+				vehiclePosition: vehiclePosition,
+			},
+		}
+
+	case chargeState != nil && resurrector == nil && vehicleClimater != nil && vehicleFinishTimer == nil && vehicleOdometer != nil && vehiclePosition != nil && vehicleRange == nil:
+		return &struct {
+			api.Vehicle
+			api.ChargeState
+			api.VehicleClimater
+			api.VehicleOdometer
+			api.VehiclePosition
+		}{
+			Vehicle: base,
+			ChargeState: &decorateVehicleChargeStateImpl{
+				chargeState: chargeState,
+			},
+			VehicleClimater: &decorateVehicleVehicleClimaterImpl{
+				vehicleClimater: vehicleClimater,
+			},
+			VehicleOdometer: &decorateVehicleVehicleOdometerImpl{
+				vehicleOdometer: vehicleOdometer,
+			},
+			VehiclePosition: &decorateVehicleVehiclePositionImpl{
+				vehiclePosition: vehiclePosition,
+			},
+		}
+
+	case chargeState == nil && resurrector == nil && vehicleClimater != nil && vehicleFinishTimer != nil && vehicleOdometer != nil && vehiclePosition != nil && vehicleRange == nil:
+		return &struct {
+			api.Vehicle
+			api.VehicleClimater
+			api.VehicleFinishTimer
+			api.VehicleOdometer
+			api.VehiclePosition
+		}{
+			Vehicle: base,
+			VehicleClimater: &decorateVehicleVehicleClimaterImpl{
+				vehicleClimater: vehicleClimater,
+			},
+			VehicleFinishTimer: &decorateVehicleVehicleFinishTimerImpl{
+				vehicleFinishTimer: vehicleFinishTimer,
+			},
+			VehicleOdometer: &decorateVehicleVehicleOdometerImpl{
+				vehicleOdometer: vehicleOdometer,
+			},
+			VehiclePosition: &decorateVehicleVehiclePositionImpl{
+				vehiclePosition: vehiclePosition,
+			},
+		}
+
+	case chargeState != nil && resurrector == nil && vehicleClimater != nil && vehicleFinishTimer != nil && vehicleOdometer != nil && vehiclePosition != nil && vehicleRange == nil:
+		return &struct {
+			api.Vehicle
+			api.ChargeState
+			api.VehicleClimater
+			api.VehicleFinishTimer
+			api.VehicleOdometer
+			api.VehiclePosition
+		}{
+			Vehicle: base,
+			ChargeState: &decorateVehicleChargeStateImpl{
+				chargeState: chargeState,
+			},
+			VehicleClimater: &decorateVehicleVehicleClimaterImpl{
+				vehicleClimater: vehicleClimater,
+			},
+			VehicleFinishTimer: &decorateVehicleVehicleFinishTimerImpl{
+				vehicleFinishTimer: vehicleFinishTimer,
+			},
+			VehicleOdometer: &decorateVehicleVehicleOdometerImpl{
+				vehicleOdometer: vehicleOdometer,
+			},
+			VehiclePosition: &decorateVehicleVehiclePositionImpl{
+				vehiclePosition: vehiclePosition,
+			},
+		}
+
+	case chargeState == nil && resurrector == nil && vehicleClimater != nil && vehicleFinishTimer == nil && vehicleOdometer != nil && vehiclePosition != nil && vehicleRange != nil:
+		return &struct {
+			api.Vehicle
+			api.VehicleClimater
+			api.VehicleOdometer
+			api.VehiclePosition
+			api.VehicleRange
+		}{
+			Vehicle: base,
+			VehicleClimater: &decorateVehicleVehicleClimaterImpl{
+				vehicleClimater: vehicleClimater,
+			},
+			VehicleOdometer: &decorateVehicleVehicleOdometerImpl{
+				vehicleOdometer: vehicleOdometer,
+			},
+			VehiclePosition: &decorateVehicleVehiclePositionImpl{
+				vehiclePosition: vehiclePosition,
+			},
+			VehicleRange: &decorateVehicleVehicleRangeImpl{
+				vehicleRange: vehicleRange,
+			},
+		}
+
+	case chargeState != nil && resurrector == nil && vehicleClimater != nil && vehicleFinishTimer == nil && vehicleOdometer != nil && vehiclePosition != nil && vehicleRange != nil:
+		return &struct {
+			api.Vehicle
+			api.ChargeState
+			api.VehicleClimater
+			api.VehicleOdometer
+			api.VehiclePosition
+			api.VehicleRange
+		}{
+			Vehicle: base,
+			ChargeState: &decorateVehicleChargeStateImpl{
+				chargeState: chargeState,
+			},
+			VehicleClimater: &decorateVehicleVehicleClimaterImpl{
+				vehicleClimater: vehicleClimater,
+			},
+			VehicleOdometer: &decorateVehicleVehicleOdometerImpl{
+				vehicleOdometer: vehicleOdometer,
+			},
+			VehiclePosition: &decorateVehicleVehiclePositionImpl{
+				vehiclePosition: vehiclePosition,
+			},
+			VehicleRange: &decorateVehicleVehicleRangeImpl{
+				vehicleRange: vehicleRange,
+			},
+		}
+
+	case chargeState == nil && resurrector == nil && vehicleClimater != nil && vehicleFinishTimer != nil && vehicleOdometer != nil && vehiclePosition != nil && vehicleRange != nil:
+		return &struct {
+			api.Vehicle
+			api.VehicleClimater
+			api.VehicleFinishTimer
+			api.VehicleOdometer
+			api.VehiclePosition
+			api.VehicleRange
+		}{
+			Vehicle: base,
+			VehicleClimater: &decorateVehicleVehicleClimaterImpl{
+				vehicleClimater: vehicleClimater,
+			},
+			VehicleFinishTimer: &decorateVehicleVehicleFinishTimerImpl{
+				vehicleFinishTimer: vehicleFinishTimer,
+			},
+			VehicleOdometer: &decorateVehicleVehicleOdometerImpl{
+				vehicleOdometer: vehicleOdometer,
+			},
+			VehiclePosition: &decorateVehicleVehiclePositionImpl{
+				vehiclePosition: vehiclePosition,
+			},
+			VehicleRange: &decorateVehicleVehicleRangeImpl{
+				vehicleRange: vehicleRange,
+			},
+		}
+
+	case chargeState != nil && resurrector == nil && vehicleClimater != nil && vehicleFinishTimer != nil && vehicleOdometer != nil && vehiclePosition != nil && vehicleRange != nil:
+		return &struct {
+			api.Vehicle
+			api.ChargeState
+			api.VehicleClimater
+			api.VehicleFinishTimer
+			api.VehicleOdometer
+			api.VehiclePosition
+			api.VehicleRange
+		}{
+			Vehicle: base,
+			ChargeState: &decorateVehicleChargeStateImpl{
+				chargeState: chargeState,
+			},
+			VehicleClimater: &decorateVehicleVehicleClimaterImpl{
+				vehicleClimater: vehicleClimater,
+			},
+			VehicleFinishTimer: &decorateVehicleVehicleFinishTimerImpl{
+				vehicleFinishTimer: vehicleFinishTimer,
+			},
+			VehicleOdometer: &decorateVehicleVehicleOdometerImpl{
+				vehicleOdometer: vehicleOdometer,
+			},
+			VehiclePosition: &decorateVehicleVehiclePositionImpl{
+				vehiclePosition: vehiclePosition,
+			},
+			VehicleRange: &decorateVehicleVehicleRangeImpl{
+				vehicleRange: vehicleRange,
+			},
+		}
+
+	case chargeState == nil && resurrector != nil && vehicleClimater == nil && vehicleFinishTimer == nil && vehicleOdometer == nil && vehiclePosition == nil && vehicleRange == nil:
+		return &struct {
+			api.Vehicle
+			api.Resurrector
+		}{
+			Vehicle: base,
+			Resurrector: &decorateVehicleResurrectorImpl{
+				resurrector: resurrector,
+			},
+		}
+
+	case chargeState != nil && resurrector != nil && vehicleClimater == nil && vehicleFinishTimer == nil && vehicleOdometer == nil && vehiclePosition == nil && vehicleRange == nil:
+		return &struct {
+			api.Vehicle
+			api.ChargeState
+			api.Resurrector
+		}{
+			Vehicle: base,
+			ChargeState: &decorateVehicleChargeStateImpl{
+				chargeState: chargeState,
+			},
+			Resurrector: &decorateVehicleResurrectorImpl{
+				resurrector: resurrector,
+			},
+		}
+
+	case chargeState == nil && resurrector != nil && vehicleClimater == nil && vehicleFinishTimer != nil && vehicleOdometer == nil && vehiclePosition == nil && vehicleRange == nil:
+		return &struct {
+			api.Vehicle
+			api.Resurrector
+			api.VehicleFinishTimer
+		}{
+			Vehicle: base,
+			Resurrector: &decorateVehicleResurrectorImpl{
+				resurrector: resurrector,
+			},
+			VehicleFinishTimer: &decorateVehicleVehicleFinishTimerImpl{
+				vehicleFinishTimer: vehicleFinishTimer,
+			},
+		}
+
+	case chargeState != nil && resurrector != nil && vehicleClimater == nil && vehicleFinishTimer != nil && vehicleOdometer == nil && vehiclePosition == nil && vehicleRange == nil:
+		return &struct {
+			api.Vehicle
+			api.ChargeState
+			api.Resurrector
+			api.VehicleFinishTimer
+		}{
+			Vehicle: base,
+			ChargeState: &decorateVehicleChargeStateImpl{
+				chargeState: chargeState,
+			},
+			Resurrector: &decorateVehicleResurrectorImpl{
+				resurrector: resurrector,
+			},
+			VehicleFinishTimer: &decorateVehicleVehicleFinishTimerImpl{
+				vehicleFinishTimer: vehicleFinishTimer,
+			},
+		}
+
+	case chargeState == nil && resurrector != nil && vehicleClimater == nil && vehicleFinishTimer == nil && vehicleOdometer == nil && vehiclePosition == nil && vehicleRange != nil:
+		return &struct {
+			api.Vehicle
+			api.Resurrector
+			api.VehicleRange
+		}{
+			Vehicle: base,
+			Resurrector: &decorateVehicleResurrectorImpl{
+				resurrector: resurrector,
+			},
+			VehicleRange: &decorateVehicleVehicleRangeImpl{
+				vehicleRange: vehicleRange,
+			},
+		}
+
+	case chargeState != nil && resurrector != nil && vehicleClimater == nil && vehicleFinishTimer == nil && vehicleOdometer == nil && vehiclePosition == nil && vehicleRange != nil:
+		return &struct {
+			api.Vehicle
+			api.ChargeState
+			api.Resurrector
+			api.VehicleRange
+		}{
+			Vehicle: base,
+			ChargeState: &decorateVehicleChargeStateImpl{
+				chargeState: chargeState,
+			},
+			Resurrector: &decorateVehicleResurrectorImpl{
+				resurrector: resurrector,
+			},
+			VehicleRange: &decorateVehicleVehicleRangeImpl{
+				vehicleRange: vehicleRange,
+			},
+		}
+
+	case chargeState == nil && resurrector != nil && vehicleClimater == nil && vehicleFinishTimer != nil && vehicleOdometer == nil && vehiclePosition == nil && vehicleRange != nil:
+		return &struct {
+			api.Vehicle
+			api.Resurrector
+			api.VehicleFinishTimer
+			api.VehicleRange
+		}{
+			Vehicle: base,
+			Resurrector: &decorateVehicleResurrectorImpl{
+				resurrector: resurrector,
+			},
+			VehicleFinishTimer: &decorateVehicleVehicleFinishTimerImpl{
+				vehicleFinishTimer: vehicleFinishTimer,
+			},
+			VehicleRange: &decorateVehicleVehicleRangeImpl{
+				vehicleRange: vehicleRange,
+			},
+		}
+
+	case chargeState != nil && resurrector != nil && vehicleClimater == nil && vehicleFinishTimer != nil && vehicleOdometer == nil && vehiclePosition == nil && vehicleRange != nil:
+		return &struct {
+			api.Vehicle
+			api.ChargeState
+			api.Resurrector
+			api.VehicleFinishTimer
+			api.VehicleRange
+		}{
+			Vehicle: base,
+			ChargeState: &decorateVehicleChargeStateImpl{
+				chargeState: chargeState,
+			},
+			Resurrector: &decorateVehicleResurrectorImpl{
+				resurrector: resurrector,
+			},
+			VehicleFinishTimer: &decorateVehicleVehicleFinishTimerImpl{
+				vehicleFinishTimer: vehicleFinishTimer,
+			},
+			VehicleRange: &decorateVehicleVehicleRangeImpl{
+				vehicleRange: vehicleRange,
+			},
+		}
+
+	case chargeState == nil && resurrector != nil && vehicleClimater != nil && vehicleFinishTimer == nil && vehicleOdometer == nil && vehiclePosition == nil && vehicleRange == nil:
+		return &struct {
+			api.Vehicle
+			api.Resurrector
+			api.VehicleClimater
+		}{
+			Vehicle: base,
+			Resurrector: &decorateVehicleResurrectorImpl{
+				resurrector: resurrector,
+			},
+			VehicleClimater: &decorateVehicleVehicleClimaterImpl{
+				vehicleClimater: vehicleClimater,
+			},
+		}
+
+	case chargeState != nil && resurrector != nil && vehicleClimater != nil && vehicleFinishTimer == nil && vehicleOdometer == nil && vehiclePosition == nil && vehicleRange == nil:
+		return &struct {
+			api.Vehicle
+			api.ChargeState
+			api.Resurrector
+			api.VehicleClimater
+		}{
+			Vehicle: base,
+			ChargeState: &decorateVehicleChargeStateImpl{
+				chargeState: chargeState,
+			},
+			Resurrector: &decorateVehicleResurrectorImpl{
+				resurrector: resurrector,
+			},
+			VehicleClimater: &decorateVehicleVehicleClimaterImpl{
+				vehicleClimater: vehicleClimater,
+			},
+		}
+
+	case chargeState == nil && resurrector != nil && vehicleClimater != nil && vehicleFinishTimer != nil && vehicleOdometer == nil && vehiclePosition == nil && vehicleRange == nil:
+		return &struct {
+			api.Vehicle
+			api.Resurrector
+			api.VehicleClimater
+			api.VehicleFinishTimer
+		}{
+			Vehicle: base,
+			Resurrector: &decorateVehicleResurrectorImpl{
+				resurrector: resurrector,
+			},
+			VehicleClimater: &decorateVehicleVehicleClimaterImpl{
+				vehicleClimater: vehicleClimater,
+			},
+			VehicleFinishTimer: &decorateVehicleVehicleFinishTimerImpl{
+				vehicleFinishTimer: vehicleFinishTimer,
+			},
+		}
+
+	case chargeState != nil && resurrector != nil && vehicleClimater != nil && vehicleFinishTimer != nil && vehicleOdometer == nil && vehiclePosition == nil && vehicleRange == nil:
+		return &struct {
+			api.Vehicle
+			api.ChargeState
+			api.Resurrector
+			api.VehicleClimater
+			api.VehicleFinishTimer
+		}{
+			Vehicle: base,
+			ChargeState: &decorateVehicleChargeStateImpl{
+				chargeState: chargeState,
+			},
+			Resurrector: &decorateVehicleResurrectorImpl{
+				resurrector: resurrector,
+			},
+			VehicleClimater: &decorateVehicleVehicleClimaterImpl{
+				vehicleClimater: vehicleClimater,
+			},
+			VehicleFinishTimer: &decorateVehicleVehicleFinishTimerImpl{
+				vehicleFinishTimer: vehicleFinishTimer,
+			},
+		}
+
+	case chargeState == nil && resurrector != nil && vehicleClimater != nil && vehicleFinishTimer == nil && vehicleOdometer == nil && vehiclePosition == nil && vehicleRange != nil:
+		return &struct {
+			api.Vehicle
+			api.Resurrector
+			api.VehicleClimater
+			api.VehicleRange
+		}{
+			Vehicle: base,
+			Resurrector: &decorateVehicleResurrectorImpl{
+				resurrector: resurrector,
+			},
+			VehicleClimater: &decorateVehicleVehicleClimaterImpl{
+				vehicleClimater: vehicleClimater,
+			},
+			VehicleRange: &decorateVehicleVehicleRangeImpl{
+				vehicleRange: vehicleRange,
+			},
+		}
+
+	case chargeState != nil && resurrector != nil && vehicleClimater != nil && vehicleFinishTimer == nil && vehicleOdometer == nil && vehiclePosition == nil && vehicleRange != nil:
+		return &struct {
+			api.Vehicle
+			api.ChargeState
+			api.Resurrector
+			api.VehicleClimater
+			api.VehicleRange
+		}{
+			Vehicle: base,
+			ChargeState: &decorateVehicleChargeStateImpl{
+				chargeState: chargeState,
+			},
+			Resurrector: &decorateVehicleResurrectorImpl{
+				resurrector: resurrector,
+			},
+			VehicleClimater: &decorateVehicleVehicleClimaterImpl{
+				vehicleClimater: vehicleClimater,
+			},
+			VehicleRange: &decorateVehicleVehicleRangeImpl{
+				vehicleRange: vehicleRange,
+			},
+		}
+
+	case chargeState == nil && resurrector != nil && vehicleClimater != nil && vehicleFinishTimer != nil && vehicleOdometer == nil && vehiclePosition == nil && vehicleRange != nil:
+		return &struct {
+			api.Vehicle
+			api.Resurrector
+			api.VehicleClimater
+			api.VehicleFinishTimer
+			api.VehicleRange
+		}{
+			Vehicle: base,
+			Resurrector: &decorateVehicleResurrectorImpl{
+				resurrector: resurrector,
+			},
+			VehicleClimater: &decorateVehicleVehicleClimaterImpl{
+				vehicleClimater: vehicleClimater,
+			},
+			VehicleFinishTimer: &decorateVehicleVehicleFinishTimerImpl{
+				vehicleFinishTimer: vehicleFinishTimer,
+			},
+			VehicleRange: &decorateVehicleVehicleRangeImpl{
+				vehicleRange: vehicleRange,
+			},
+		}
+
+	case chargeState != nil && resurrector != nil && vehicleClimater != nil && vehicleFinishTimer != nil && vehicleOdometer == nil && vehiclePosition == nil && vehicleRange != nil:
+		return &struct {
+			api.Vehicle
+			api.ChargeState
+			api.Resurrector
+			api.VehicleClimater
+			api.VehicleFinishTimer
+			api.VehicleRange
+		}{
+			Vehicle: base,
+			ChargeState: &decorateVehicleChargeStateImpl{
+				chargeState: chargeState,
+			},
+			Resurrector: &decorateVehicleResurrectorImpl{
+				resurrector: resurrector,
+			},
+			VehicleClimater: &decorateVehicleVehicleClimaterImpl{
+				vehicleClimater: vehicleClimater,
+			},
+			VehicleFinishTimer: &decorateVehicleVehicleFinishTimerImpl{
+				vehicleFinishTimer: vehicleFinishTimer,
+			},
+			VehicleRange: &decorateVehicleVehicleRangeImpl{
+				vehicleRange: vehicleRange,
+			},
+		}
+
+	case chargeState == nil && resurrector != nil && vehicleClimater == nil && vehicleFinishTimer == nil && vehicleOdometer != nil && vehiclePosition == nil && vehicleRange == nil:
+		return &struct {
+			api.Vehicle
+			api.Resurrector
+			api.VehicleOdometer
+		}{
+			Vehicle: base,
+			Resurrector: &decorateVehicleResurrectorImpl{
+				resurrector: resurrector,
+			},
+			VehicleOdometer: &decorateVehicleVehicleOdometerImpl{
+				vehicleOdometer: vehicleOdometer,
+			},
+		}
+
+	case chargeState != nil && resurrector != nil && vehicleClimater == nil && vehicleFinishTimer == nil && vehicleOdometer != nil && vehiclePosition == nil && vehicleRange == nil:
+		return &struct {
+			api.Vehicle
+			api.ChargeState
+			api.Resurrector
+			api.VehicleOdometer
+		}{
+			Vehicle: base,
+			ChargeState: &decorateVehicleChargeStateImpl{
+				chargeState: chargeState,
+			},
+			Resurrector: &decorateVehicleResurrectorImpl{
+				resurrector: resurrector,
+			},
+			VehicleOdometer: &decorateVehicleVehicleOdometerImpl{
+				vehicleOdometer: vehicleOdometer,
+			},
+		}
+
+	case chargeState == nil && resurrector != nil && vehicleClimater == nil && vehicleFinishTimer != nil && vehicleOdometer != nil && vehiclePosition == nil && vehicleRange == nil:
+		return &struct {
+			api.Vehicle
+			api.Resurrector
+			api.VehicleFinishTimer
+			api.VehicleOdometer
+		}{
+			Vehicle: base,
+			Resurrector: &decorateVehicleResurrectorImpl{
+				resurrector: resurrector,
+			},
+			VehicleFinishTimer: &decorateVehicleVehicleFinishTimerImpl{
+				vehicleFinishTimer: vehicleFinishTimer,
+			},
+			VehicleOdometer: &decorateVehicleVehicleOdometerImpl{
+				vehicleOdometer: vehicleOdometer,
+			},
+		}
+
+	case chargeState != nil && resurrector != nil && vehicleClimater == nil && vehicleFinishTimer != nil && vehicleOdometer != nil && vehiclePosition == nil && vehicleRange == nil:
+		return &struct {
+			api.Vehicle
+			api.ChargeState
+			api.Resurrector
+			api.VehicleFinishTimer
+			api.VehicleOdometer
+		}{
+			Vehicle: base,
+			ChargeState: &decorateVehicleChargeStateImpl{
+				chargeState: chargeState,
+			},
+			Resurrector: &decorateVehicleResurrectorImpl{
+				resurrector: resurrector,
+			},
+			VehicleFinishTimer: &decorateVehicleVehicleFinishTimerImpl{
+				vehicleFinishTimer: vehicleFinishTimer,
+			},
+			VehicleOdometer: &decorateVehicleVehicleOdometerImpl{
+				vehicleOdometer: vehicleOdometer,
+			},
+		}
+
+	case chargeState == nil && resurrector != nil && vehicleClimater == nil && vehicleFinishTimer == nil && vehicleOdometer != nil && vehiclePosition == nil && vehicleRange != nil:
+		return &struct {
+			api.Vehicle
+			api.Resurrector
+			api.VehicleOdometer
+			api.VehicleRange
+		}{
+			Vehicle: base,
+			Resurrector: &decorateVehicleResurrectorImpl{
+				resurrector: resurrector,
+			},
+			VehicleOdometer: &decorateVehicleVehicleOdometerImpl{
+				vehicleOdometer: vehicleOdometer,
+			},
+			VehicleRange: &decorateVehicleVehicleRangeImpl{
+				vehicleRange: vehicleRange,
+			},
+		}
+
+	case chargeState != nil && resurrector != nil && vehicleClimater == nil && vehicleFinishTimer == nil && vehicleOdometer != nil && vehiclePosition == nil && vehicleRange != nil:
+		return &struct {
+			api.Vehicle
+			api.ChargeState
+			api.Resurrector
+			api.VehicleOdometer
+			api.VehicleRange
+		}{
+			Vehicle: base,
+			ChargeState: &decorateVehicleChargeStateImpl{
+				chargeState: chargeState,
+			},
+			Resurrector: &decorateVehicleResurrectorImpl{
+				resurrector: resurrector,
+			},
+			VehicleOdometer: &decorateVehicleVehicleOdometerImpl{
+				vehicleOdometer: vehicleOdometer,
+			},
+			VehicleRange: &decorateVehicleVehicleRangeImpl{
+				vehicleRange: vehicleRange,
+			},
+		}
+
+	case chargeState == nil && resurrector != nil && vehicleClimater == nil && vehicleFinishTimer != nil && vehicleOdometer != nil && vehiclePosition == nil && vehicleRange != nil:
+		return &struct {
+			api.Vehicle
+			api.Resurrector
+			api.VehicleFinishTimer
+			api.VehicleOdometer
+			api.VehicleRange
+		}{
+			Vehicle: base,
+			Resurrector: &decorateVehicleResurrectorImpl{
+				resurrector: resurrector,
+			},
+			VehicleFinishTimer: &decorateVehicleVehicleFinishTimerImpl{
+				vehicleFinishTimer: vehicleFinishTimer,
+			},
+			VehicleOdometer: &decorateVehicleVehicleOdometerImpl{
+				vehicleOdometer: vehicleOdometer,
+			},
+			VehicleRange: &decorateVehicleVehicleRangeImpl{
+				vehicleRange: vehicleRange,
+			},
+		}
+
+	case chargeState != nil && resurrector != nil && vehicleClimater == nil && vehicleFinishTimer != nil && vehicleOdometer != nil && vehiclePosition == nil && vehicleRange != nil:
+		return &struct {
+			api.Vehicle
+			api.ChargeState
+			api.Resurrector
+			api.VehicleFinishTimer
+			api.VehicleOdometer
+			api.VehicleRange
+		}{
+			Vehicle: base,
+			ChargeState: &decorateVehicleChargeStateImpl{
+				chargeState: chargeState,
+			},
+			Resurrector: &decorateVehicleResurrectorImpl{
+				resurrector: resurrector,
+			},
+			VehicleFinishTimer: &decorateVehicleVehicleFinishTimerImpl{
+				vehicleFinishTimer: vehicleFinishTimer,
+			},
+			VehicleOdometer: &decorateVehicleVehicleOdometerImpl{
+				vehicleOdometer: vehicleOdometer,
+			},
+			VehicleRange: &decorateVehicleVehicleRangeImpl{
+				vehicleRange: vehicleRange,
+			},
+		}
+
+	case chargeState == nil && resurrector != nil && vehicleClimater != nil && vehicleFinishTimer == nil && vehicleOdometer != nil && vehiclePosition == nil && vehicleRange == nil:
+		return &struct {
+			api.Vehicle
+			api.Resurrector
+			api.VehicleClimater
+			api.VehicleOdometer
+		}{
+			Vehicle: base,
+			Resurrector: &decorateVehicleResurrectorImpl{
+				resurrector: resurrector,
+			},
+			VehicleClimater: &decorateVehicleVehicleClimaterImpl{
+				vehicleClimater: vehicleClimater,
+			},
+			VehicleOdometer: &decorateVehicleVehicleOdometerImpl{
+				vehicleOdometer: vehicleOdometer,
+			},
+		}
+
+	case chargeState != nil && resurrector != nil && vehicleClimater != nil && vehicleFinishTimer == nil && vehicleOdometer != nil && vehiclePosition == nil && vehicleRange == nil:
+		return &struct {
+			api.Vehicle
+			api.ChargeState
+			api.Resurrector
+			api.VehicleClimater
+			api.VehicleOdometer
+		}{
+			Vehicle: base,
+			ChargeState: &decorateVehicleChargeStateImpl{
+				chargeState: chargeState,
+			},
+			Resurrector: &decorateVehicleResurrectorImpl{
+				resurrector: resurrector,
+			},
+			VehicleClimater: &decorateVehicleVehicleClimaterImpl{
+				vehicleClimater: vehicleClimater,
+			},
+			VehicleOdometer: &decorateVehicleVehicleOdometerImpl{
+				vehicleOdometer: vehicleOdometer,
+			},
+		}
+
+	case chargeState == nil && resurrector != nil && vehicleClimater != nil && vehicleFinishTimer != nil && vehicleOdometer != nil && vehiclePosition == nil && vehicleRange == nil:
+		return &struct {
+			api.Vehicle
+			api.Resurrector
+			api.VehicleClimater
+			api.VehicleFinishTimer
+			api.VehicleOdometer
+		}{
+			Vehicle: base,
+			Resurrector: &decorateVehicleResurrectorImpl{
+				resurrector: resurrector,
+			},
+			VehicleClimater: &decorateVehicleVehicleClimaterImpl{
+				vehicleClimater: vehicleClimater,
+			},
+			VehicleFinishTimer: &decorateVehicleVehicleFinishTimerImpl{
+				vehicleFinishTimer: vehicleFinishTimer,
+			},
+			VehicleOdometer: &decorateVehicleVehicleOdometerImpl{
+				vehicleOdometer: vehicleOdometer,
+			},
+		}
+
+	case chargeState != nil && resurrector != nil && vehicleClimater != nil && vehicleFinishTimer != nil && vehicleOdometer != nil && vehiclePosition == nil && vehicleRange == nil:
+		return &struct {
+			api.Vehicle
+			api.ChargeState
+			api.Resurrector
+			api.VehicleClimater
+			api.VehicleFinishTimer
+			api.VehicleOdometer
+		}{
+			Vehicle: base,
+			ChargeState: &decorateVehicleChargeStateImpl{
+				chargeState: chargeState,
+			},
+			Resurrector: &decorateVehicleResurrectorImpl{
+				resurrector: resurrector,
+			},
+			VehicleClimater: &decorateVehicleVehicleClimaterImpl{
+				vehicleClimater: vehicleClimater,
+			},
+			VehicleFinishTimer: &decorateVehicleVehicleFinishTimerImpl{
+				vehicleFinishTimer: vehicleFinishTimer,
+			},
+			VehicleOdometer: &decorateVehicleVehicleOdometerImpl{
+				vehicleOdometer: vehicleOdometer,
+			},
+		}
+
+	case chargeState == nil && resurrector != nil && vehicleClimater != nil && vehicleFinishTimer == nil && vehicleOdometer != nil && vehiclePosition == nil && vehicleRange != nil:
+		return &struct {
+			api.Vehicle
+			api.Resurrector
+			api.VehicleClimater
+			api.VehicleOdometer
+			api.VehicleRange
+		}{
+			Vehicle: base,
+			Resurrector: &decorateVehicleResurrectorImpl{
+				resurrector: resurrector,
+			},
+			VehicleClimater: &decorateVehicleVehicleClimaterImpl{
+				vehicleClimater: vehicleClimater,
+			},
+			VehicleOdometer: &decorateVehicleVehicleOdometerImpl{
+				vehicleOdometer: vehicleOdometer,
+			},
+			VehicleRange: &decorateVehicleVehicleRangeImpl{
+				vehicleRange: vehicleRange,
+			},
+		}
+
+	case chargeState != nil && resurrector != nil && vehicleClimater != nil && vehicleFinishTimer == nil && vehicleOdometer != nil && vehiclePosition == nil && vehicleRange != nil:
+		return &struct {
+			api.Vehicle
+			api.ChargeState
+			api.Resurrector
+			api.VehicleClimater
+			api.VehicleOdometer
+			api.VehicleRange
+		}{
+			Vehicle: base,
+			ChargeState: &decorateVehicleChargeStateImpl{
+				chargeState: chargeState,
+			},
+			Resurrector: &decorateVehicleResurrectorImpl{
+				resurrector: resurrector,
+			},
+			VehicleClimater: &decorateVehicleVehicleClimaterImpl{
+				vehicleClimater: vehicleClimater,
+			},
+			VehicleOdometer: &decorateVehicleVehicleOdometerImpl{
+				vehicleOdometer: vehicleOdometer,
+			},
+			VehicleRange: &decorateVehicleVehicleRangeImpl{
+				vehicleRange: vehicleRange,
+			},
+		}
+
+	case chargeState == nil && resurrector != nil && vehicleClimater != nil && vehicleFinishTimer != nil && vehicleOdometer != nil && vehiclePosition == nil && vehicleRange != nil:
+		return &struct {
+			api.Vehicle
+			api.Resurrector
+			api.VehicleClimater
+			api.VehicleFinishTimer
+			api.VehicleOdometer
+			api.VehicleRange
+		}{
+			Vehicle: base,
+			Resurrector: &decorateVehicleResurrectorImpl{
+				resurrector: resurrector,
+			},
+			VehicleClimater: &decorateVehicleVehicleClimaterImpl{
+				vehicleClimater: vehicleClimater,
+			},
+			VehicleFinishTimer: &decorateVehicleVehicleFinishTimerImpl{
+				vehicleFinishTimer: vehicleFinishTimer,
+			},
+			VehicleOdometer: &decorateVehicleVehicleOdometerImpl{
+				vehicleOdometer: vehicleOdometer,
+			},
+			VehicleRange: &decorateVehicleVehicleRangeImpl{
+				vehicleRange: vehicleRange,
+			},
+		}
+
+	case chargeState != nil && resurrector != nil && vehicleClimater != nil && vehicleFinishTimer != nil && vehicleOdometer != nil && vehiclePosition == nil && vehicleRange != nil:
+		return &struct {
+			api.Vehicle
+			api.ChargeState
+			api.Resurrector
+			api.VehicleClimater
+			api.VehicleFinishTimer
+			api.VehicleOdometer
+			api.VehicleRange
+		}{
+			Vehicle: base,
+			ChargeState: &decorateVehicleChargeStateImpl{
+				chargeState: chargeState,
+			},
+			Resurrector: &decorateVehicleResurrectorImpl{
+				resurrector: resurrector,
+			},
+			VehicleClimater: &decorateVehicleVehicleClimaterImpl{
+				vehicleClimater: vehicleClimater,
+			},
+			VehicleFinishTimer: &decorateVehicleVehicleFinishTimerImpl{
+				vehicleFinishTimer: vehicleFinishTimer,
+			},
+			VehicleOdometer: &decorateVehicleVehicleOdometerImpl{
+				vehicleOdometer: vehicleOdometer,
+			},
+			VehicleRange: &decorateVehicleVehicleRangeImpl{
+				vehicleRange: vehicleRange,
+			},
+		}
+
+	case chargeState == nil && resurrector != nil && vehicleClimater == nil && vehicleFinishTimer == nil && vehicleOdometer == nil && vehiclePosition != nil && vehicleRange == nil:
+		return &struct {
+			api.Vehicle
+			api.Resurrector
+			api.VehiclePosition
+		}{
+			Vehicle: base,
+			Resurrector: &decorateVehicleResurrectorImpl{
+				resurrector: resurrector,
+			},
+			VehiclePosition: &decorateVehicleVehiclePositionImpl{
+				vehiclePosition: vehiclePosition,
+			},
+		}
+
+	case chargeState != nil && resurrector != nil && vehicleClimater == nil && vehicleFinishTimer == nil && vehicleOdometer == nil && vehiclePosition != nil && vehicleRange == nil:
+		return &struct {
+			api.Vehicle
+			api.ChargeState
+			api.Resurrector
+			api.VehiclePosition
+		}{
+			Vehicle: base,
+			ChargeState: &decorateVehicleChargeStateImpl{
+				chargeState: chargeState,
+			},
+			Resurrector: &decorateVehicleResurrectorImpl{
+				resurrector: resurrector,
+			},
+			VehiclePosition: &decorateVehicleVehiclePositionImpl{
+				vehiclePosition: vehiclePosition,
+			},
+		}
+
+	case chargeState == nil && resurrector != nil && vehicleClimater == nil && vehicleFinishTimer != nil && vehicleOdometer == nil && vehiclePosition != nil && vehicleRange == nil:
+		return &struct {
+			api.Vehicle
+			api.Resurrector
+			api.VehicleFinishTimer
+			api.VehiclePosition
+		}{
+			Vehicle: base,
+			Resurrector: &decorateVehicleResurrectorImpl{
+				resurrector: resurrector,
+			},
+			VehicleFinishTimer: &decorateVehicleVehicleFinishTimerImpl{
+				vehicleFinishTimer: vehicleFinishTimer,
+			},
+			VehiclePosition: &decorateVehicleVehiclePositionImpl{
+				vehiclePosition: vehiclePosition,
+			},
+		}
+
+	case chargeState != nil && resurrector != nil && vehicleClimater == nil && vehicleFinishTimer != nil && vehicleOdometer == nil && vehiclePosition != nil && vehicleRange == nil:
+		return &struct {
+			api.Vehicle
+			api.ChargeState
+			api.Resurrector
+			api.VehicleFinishTimer
+			api.VehiclePosition
+		}{
+			Vehicle: base,
+			ChargeState: &decorateVehicleChargeStateImpl{
+				chargeState: chargeState,
+			},
+			Resurrector: &decorateVehicleResurrectorImpl{
+				resurrector: resurrector,
+			},
+			VehicleFinishTimer: &decorateVehicleVehicleFinishTimerImpl{
+				vehicleFinishTimer: vehicleFinishTimer,
+			},
+			VehiclePosition: &decorateVehicleVehiclePositionImpl{
+				vehiclePosition: vehiclePosition,
+			},
+		}
+
+	case chargeState == nil && resurrector != nil && vehicleClimater == nil && vehicleFinishTimer == nil && vehicleOdometer == nil && vehiclePosition != nil && vehicleRange != nil:
+		return &struct {
+			api.Vehicle
+			api.Resurrector
+			api.VehiclePosition
+			api.VehicleRange
+		}{
+			Vehicle: base,
+			Resurrector: &decorateVehicleResurrectorImpl{
+				resurrector: resurrector,
+			},
+			VehiclePosition: &decorateVehicleVehiclePositionImpl{
+				vehiclePosition: vehiclePosition,
+			},
+			VehicleRange: &decorateVehicleVehicleRangeImpl{
+				vehicleRange: vehicleRange,
+			},
+		}
+
+	case chargeState != nil && resurrector != nil && vehicleClimater == nil && vehicleFinishTimer == nil && vehicleOdometer == nil && vehiclePosition != nil && vehicleRange != nil:
+		return &struct {
+			api.Vehicle
+			api.ChargeState
+			api.Resurrector
+			api.VehiclePosition
+			api.VehicleRange
+		}{
+			Vehicle: base,
+			ChargeState: &decorateVehicleChargeStateImpl{
+				chargeState: chargeState,
+			},
+			Resurrector: &decorateVehicleResurrectorImpl{
+				resurrector: resurrector,
+			},
+			VehiclePosition: &decorateVehicleVehiclePositionImpl{
+				vehiclePosition: vehiclePosition,
+			},
+			VehicleRange: &decorateVehicleVehicleRangeImpl{
+				vehicleRange: vehicleRange,
+			},
+		}
+
+	case chargeState == nil && resurrector != nil && vehicleClimater == nil && vehicleFinishTimer != nil && vehicleOdometer == nil && vehiclePosition != nil && vehicleRange != nil:
+		return &struct {
+			api.Vehicle
+			api.Resurrector
+			api.VehicleFinishTimer
+			api.VehiclePosition
+			api.VehicleRange
+		}{
+			Vehicle: base,
+			Resurrector: &decorateVehicleResurrectorImpl{
+				resurrector: resurrector,
+			},
+			VehicleFinishTimer: &decorateVehicleVehicleFinishTimerImpl{
+				vehicleFinishTimer: vehicleFinishTimer,
+			},
+			VehiclePosition: &decorateVehicleVehiclePositionImpl{
+				vehiclePosition: vehiclePosition,
+			},
+			VehicleRange: &decorateVehicleVehicleRangeImpl{
+				vehicleRange: vehicleRange,
+			},
+		}
+
+	case chargeState != nil && resurrector != nil && vehicleClimater == nil && vehicleFinishTimer != nil && vehicleOdometer == nil && vehiclePosition != nil && vehicleRange != nil:
+		return &struct {
+			api.Vehicle
+			api.ChargeState
+			api.Resurrector
+			api.VehicleFinishTimer
+			api.VehiclePosition
+			api.VehicleRange
+		}{
+			Vehicle: base,
+			ChargeState: &decorateVehicleChargeStateImpl{
+				chargeState: chargeState,
+			},
+			Resurrector: &decorateVehicleResurrectorImpl{
+				resurrector: resurrector,
+			},
+			VehicleFinishTimer: &decorateVehicleVehicleFinishTimerImpl{
+				vehicleFinishTimer: vehicleFinishTimer,
+			},
+			VehiclePosition: &decorateVehicleVehiclePositionImpl{
+				vehiclePosition: vehiclePosition,
+			},
+			VehicleRange: &decorateVehicleVehicleRangeImpl{
+				vehicleRange: vehicleRange,
+			},
+		}
+
+	case chargeState == nil && resurrector != nil && vehicleClimater != nil && vehicleFinishTimer == nil && vehicleOdometer == nil && vehiclePosition != nil && vehicleRange == nil:
+		return &struct {
+			api.Vehicle
+			api.Resurrector
+			api.VehicleClimater
+			api.VehiclePosition
+		}{
+			Vehicle: base,
+			Resurrector: &decorateVehicleResurrectorImpl{
+				resurrector: resurrector,
+			},
+			VehicleClimater: &decorateVehicleVehicleClimaterImpl{
+				vehicleClimater: vehicleClimater,
+			},
+			VehiclePosition: &decorateVehicleVehiclePositionImpl{
+				vehiclePosition: vehiclePosition,
+			},
+		}
+
+	case chargeState != nil && resurrector != nil && vehicleClimater != nil && vehicleFinishTimer == nil && vehicleOdometer == nil && vehiclePosition != nil && vehicleRange == nil:
+		return &struct {
+			api.Vehicle
+			api.ChargeState
+			api.Resurrector
+			api.VehicleClimater
+			api.VehiclePosition
+		}{
+			Vehicle: base,
+			ChargeState: &decorateVehicleChargeStateImpl{
+				chargeState: chargeState,
+			},
+			Resurrector: &decorateVehicleResurrectorImpl{
+				resurrector: resurrector,
+			},
+			VehicleClimater: &decorateVehicleVehicleClimaterImpl{
+				vehicleClimater: vehicleClimater,
+			},
+			VehiclePosition: &decorateVehicleVehiclePositionImpl{
+				vehiclePosition: vehiclePosition,
+			},
+		}
+
+	case chargeState == nil && resurrector != nil && vehicleClimater != nil && vehicleFinishTimer != nil && vehicleOdometer == nil && vehiclePosition != nil && vehicleRange == nil:
+		return &struct {
+			api.Vehicle
+			api.Resurrector
+			api.VehicleClimater
+			api.VehicleFinishTimer
+			api.VehiclePosition
+		}{
+			Vehicle: base,
+			Resurrector: &decorateVehicleResurrectorImpl{
+				resurrector: resurrector,
+			},
+			VehicleClimater: &decorateVehicleVehicleClimaterImpl{
+				vehicleClimater: vehicleClimater,
+			},
+			VehicleFinishTimer: &decorateVehicleVehicleFinishTimerImpl{
+				vehicleFinishTimer: vehicleFinishTimer,
+			},
+			VehiclePosition: &decorateVehicleVehiclePositionImpl{
+				vehiclePosition: vehiclePosition,
+			},
+		}
+
+	case chargeState != nil && resurrector != nil && vehicleClimater != nil && vehicleFinishTimer != nil && vehicleOdometer == nil && vehiclePosition != nil && vehicleRange == nil:
+		return &struct {
+			api.Vehicle
+			api.ChargeState
+			api.Resurrector
+			api.VehicleClimater
+			api.VehicleFinishTimer
+			api.VehiclePosition
+		}{
+			Vehicle: base,
+			ChargeState: &decorateVehicleChargeStateImpl{
+				chargeState: chargeState,
+			},
+			Resurrector: &decorateVehicleResurrectorImpl{
+				resurrector: resurrector,
+			},
+			VehicleClimater: &decorateVehicleVehicleClimaterImpl{
+				vehicleClimater: vehicleClimater,
+			},
+			VehicleFinishTimer: &decorateVehicleVehicleFinishTimerImpl{
+				vehicleFinishTimer: vehicleFinishTimer,
+			},
+			VehiclePosition: &decorateVehicleVehiclePositionImpl{
+				vehiclePosition: vehiclePosition,
+			},
+		}
+
+	case chargeState == nil && resurrector != nil && vehicleClimater != nil && vehicleFinishTimer == nil && vehicleOdometer == nil && vehiclePosition != nil && vehicleRange != nil:
+		return &struct {
+			api.Vehicle
+			api.Resurrector
+			api.VehicleClimater
+			api.VehiclePosition
+			api.VehicleRange
+		}{
+			Vehicle: base,
+			Resurrector: &decorateVehicleResurrectorImpl{
+				resurrector: resurrector,
+			},
+			VehicleClimater: &decorateVehicleVehicleClimaterImpl{
+				vehicleClimater: vehicleClimater,
+			},
+			VehiclePosition: &decorateVehicleVehiclePositionImpl{
+				vehiclePosition: vehiclePosition,
+			},
+			VehicleRange: &decorateVehicleVehicleRangeImpl{
+				vehicleRange: vehicleRange,
+			},
+		}
+
+	case chargeState != nil && resurrector != nil && vehicleClimater != nil && vehicleFinishTimer == nil && vehicleOdometer == nil && vehiclePosition != nil && vehicleRange != nil:
+		return &struct {
+			api.Vehicle
+			api.ChargeState
+			api.Resurrector
+			api.VehicleClimater
+			api.VehiclePosition
+			api.VehicleRange
+		}{
+			Vehicle: base,
+			ChargeState: &decorateVehicleChargeStateImpl{
+				chargeState: chargeState,
+			},
+			Resurrector: &decorateVehicleResurrectorImpl{
+				resurrector: resurrector,
+			},
+			VehicleClimater: &decorateVehicleVehicleClimaterImpl{
+				vehicleClimater: vehicleClimater,
+			},
+			VehiclePosition: &decorateVehicleVehiclePositionImpl{
+				vehiclePosition: vehiclePosition,
+			},
+			VehicleRange: &decorateVehicleVehicleRangeImpl{
+				vehicleRange: vehicleRange,
+			},
+		}
+
+	case chargeState == nil && resurrector != nil && vehicleClimater != nil && vehicleFinishTimer != nil && vehicleOdometer == nil && vehiclePosition != nil && vehicleRange != nil:
+		return &struct {
+			api.Vehicle
+			api.Resurrector
+			api.VehicleClimater
+			api.VehicleFinishTimer
+			api.VehiclePosition
+			api.VehicleRange
+		}{
+			Vehicle: base,
+			Resurrector: &decorateVehicleResurrectorImpl{
+				resurrector: resurrector,
+			},
+			VehicleClimater: &decorateVehicleVehicleClimaterImpl{
+				vehicleClimater: vehicleClimater,
+			},
+			VehicleFinishTimer: &decorateVehicleVehicleFinishTimerImpl{
+				vehicleFinishTimer: vehicleFinishTimer,
+			},
+			VehiclePosition: &decorateVehicleVehiclePositionImpl{
+				vehiclePosition: vehiclePosition,
+			},
+			VehicleRange: &decorateVehicleVehicleRangeImpl{
+				vehicleRange: vehicleRange,
+			},
+		}
+
+	case chargeState != nil && resurrector != nil && vehicleClimater != nil && vehicleFinishTimer != nil && vehicleOdometer == nil && vehiclePosition != nil && vehicleRange != nil:
+		return &struct {
+			api.Vehicle
+			api.ChargeState
+			api.Resurrector
+			api.VehicleClimater
+			api.VehicleFinishTimer
+			api.VehiclePosition
+			api.VehicleRange
+		}{
+			Vehicle: base,
+			ChargeState: &decorateVehicleChargeStateImpl{
+				chargeState: chargeState,
+			},
+			Resurrector: &decorateVehicleResurrectorImpl{
+				resurrector: resurrector,
+			},
+			VehicleClimater: &decorateVehicleVehicleClimaterImpl{
+				vehicleClimater: vehicleClimater,
+			},
+			VehicleFinishTimer: &decorateVehicleVehicleFinishTimerImpl{
+				vehicleFinishTimer: vehicleFinishTimer,
+			},
+			VehiclePosition: &decorateVehicleVehiclePositionImpl{
+				vehiclePosition: vehiclePosition,
+			},
+			VehicleRange: &decorateVehicleVehicleRangeImpl{
+				vehicleRange: vehicleRange,
+			},
+		}
+
+	case chargeState == nil && resurrector != nil && vehicleClimater == nil && vehicleFinishTimer == nil && vehicleOdometer != nil && vehiclePosition != nil && vehicleRange == nil:
+		return &struct {
+			api.Vehicle
+			api.Resurrector
+			api.VehicleOdometer
+			api.VehiclePosition
+		}{
+			Vehicle: base,
+			Resurrector: &decorateVehicleResurrectorImpl{
+				resurrector: resurrector,
+			},
+			VehicleOdometer: &decorateVehicleVehicleOdometerImpl{
+				vehicleOdometer: vehicleOdometer,
+			},
+			VehiclePosition: &decorateVehicleVehiclePositionImpl{
+				vehiclePosition: vehiclePosition,
+			},
+		}
+
+	case chargeState != nil && resurrector != nil && vehicleClimater == nil && vehicleFinishTimer == nil && vehicleOdometer != nil && vehiclePosition != nil && vehicleRange == nil:
+		return &struct {
+			api.Vehicle
+			api.ChargeState
+			api.Resurrector
+			api.VehicleOdometer
+			api.VehiclePosition
+		}{
+			Vehicle: base,
+			ChargeState: &decorateVehicleChargeStateImpl{
+				chargeState: chargeState,
+			},
+			Resurrector: &decorateVehicleResurrectorImpl{
+				resurrector: resurrector,
+			},
+			VehicleOdometer: &decorateVehicleVehicleOdometerImpl{
+				vehicleOdometer: vehicleOdometer,
+			},
+			VehiclePosition: &decorateVehicleVehiclePositionImpl{
+				vehiclePosition: vehiclePosition,
+			},
+		}
+
+	case chargeState == nil && resurrector != nil && vehicleClimater == nil && vehicleFinishTimer != nil && vehicleOdometer != nil && vehiclePosition != nil && vehicleRange == nil:
+		return &struct {
+			api.Vehicle
+			api.Resurrector
+			api.VehicleFinishTimer
+			api.VehicleOdometer
+			api.VehiclePosition
+		}{
+			Vehicle: base,
+			Resurrector: &decorateVehicleResurrectorImpl{
+				resurrector: resurrector,
+			},
+			VehicleFinishTimer: &decorateVehicleVehicleFinishTimerImpl{
+				vehicleFinishTimer: vehicleFinishTimer,
+			},
+			VehicleOdometer: &decorateVehicleVehicleOdometerImpl{
+				vehicleOdometer: vehicleOdometer,
+			},
+			VehiclePosition: &decorateVehicleVehiclePositionImpl{
+				vehiclePosition: vehiclePosition,
+			},
+		}
+
+	case chargeState != nil && resurrector != nil && vehicleClimater == nil && vehicleFinishTimer != nil && vehicleOdometer != nil && vehiclePosition != nil && vehicleRange == nil:
+		return &struct {
+			api.Vehicle
+			api.ChargeState
+			api.Resurrector
+			api.VehicleFinishTimer
+			api.VehicleOdometer
+			api.VehiclePosition
+		}{
+			Vehicle: base,
+			ChargeState: &decorateVehicleChargeStateImpl{
+				chargeState: chargeState,
+			},
+			Resurrector: &decorateVehicleResurrectorImpl{
+				resurrector: resurrector,
+			},
+			VehicleFinishTimer: &decorateVehicleVehicleFinishTimerImpl{
+				vehicleFinishTimer: vehicleFinishTimer,
+			},
+			VehicleOdometer: &decorateVehicleVehicleOdometerImpl{
+				vehicleOdometer: vehicleOdometer,
+			},
+			VehiclePosition: &decorateVehicleVehiclePositionImpl{
+				vehiclePosition: vehiclePosition,
+			},
+		}
+
+	case chargeState == nil && resurrector != nil && vehicleClimater == nil && vehicleFinishTimer == nil && vehicleOdometer != nil && vehiclePosition != nil && vehicleRange != nil:
+		return &struct {
+			api.Vehicle
+			api.Resurrector
+			api.VehicleOdometer
+			api.VehiclePosition
+			api.VehicleRange
+		}{
+			Vehicle: base,
+			Resurrector: &decorateVehicleResurrectorImpl{
+				resurrector: resurrector,
+			},
+			VehicleOdometer: &decorateVehicleVehicleOdometerImpl{
+				vehicleOdometer: vehicleOdometer,
+			},
+			VehiclePosition: &decorateVehicleVehiclePositionImpl{
+				vehiclePosition: vehiclePosition,
+			},
+			VehicleRange: &decorateVehicleVehicleRangeImpl{
+				vehicleRange: vehicleRange,
+			},
+		}
+
+	case chargeState != nil && resurrector != nil && vehicleClimater == nil && vehicleFinishTimer == nil && vehicleOdometer != nil && vehiclePosition != nil && vehicleRange != nil:
+		return &struct {
+			api.Vehicle
+			api.ChargeState
+			api.Resurrector
+			api.VehicleOdometer
+			api.VehiclePosition
+			api.VehicleRange
+		}{
+			Vehicle: base,
+			ChargeState: &decorateVehicleChargeStateImpl{
+				chargeState: chargeState,
+			},
+			Resurrector: &decorateVehicleResurrectorImpl{
+				resurrector: resurrector,
+			},
+			VehicleOdometer: &decorateVehicleVehicleOdometerImpl{
+				vehicleOdometer: vehicleOdometer,
+			},
+			VehiclePosition: &decorateVehicleVehiclePositionImpl{
+				vehiclePosition: vehiclePosition,
+			},
+			VehicleRange: &decorateVehicleVehicleRangeImpl{
+				vehicleRange: vehicleRange,
+			},
+		}
+
+	case chargeState == nil && resurrector != nil && vehicleClimater == nil && vehicleFinishTimer != nil && vehicleOdometer != nil && vehiclePosition != nil && vehicleRange != nil:
+		return &struct {
+			api.Vehicle
+			api.Resurrector
+			api.VehicleFinishTimer
+			api.VehicleOdometer
+			api.VehiclePosition
+			api.VehicleRange
+		}{
+			Vehicle: base,
+			Resurrector: &decorateVehicleResurrectorImpl{
+				resurrector: resurrector,
+			},
+			VehicleFinishTimer: &decorateVehicleVehicleFinishTimerImpl{
+				vehicleFinishTimer: vehicleFinishTimer,
+			},
+			VehicleOdometer: &decorateVehicleVehicleOdometerImpl{
+				vehicleOdometer: vehicleOdometer,
+			},
+			VehiclePosition: &decorateVehicleVehiclePositionImpl{
+				vehiclePosition: vehiclePosition,
+			},
+			VehicleRange: &decorateVehicleVehicleRangeImpl{
+				vehicleRange: vehicleRange,
+			},
+		}
+
+	case chargeState != nil && resurrector != nil && vehicleClimater == nil && vehicleFinishTimer != nil && vehicleOdometer != nil && vehiclePosition != nil && vehicleRange != nil:
+		return &struct {
+			api.Vehicle
+			api.ChargeState
+			api.Resurrector
+			api.VehicleFinishTimer
+			api.VehicleOdometer
+			api.VehiclePosition
+			api.VehicleRange
+		}{
+			Vehicle: base,
+			ChargeState: &decorateVehicleChargeStateImpl{
+				chargeState: chargeState,
+			},
+			Resurrector: &decorateVehicleResurrectorImpl{
+				resurrector: resurrector,
+			},
+			VehicleFinishTimer: &decorateVehicleVehicleFinishTimerImpl{
+				vehicleFinishTimer: vehicleFinishTimer,
+			},
+			VehicleOdometer: &decorateVehicleVehicleOdometerImpl{
+				vehicleOdometer: vehicleOdometer,
+			},
+			VehiclePosition: &decorateVehicleVehiclePositionImpl{
+				vehiclePosition: vehiclePosition,
+			},
+			VehicleRange: &decorateVehicleVehicleRangeImpl{
+				vehicleRange: vehicleRange,
+			},
+		}
+
+	case chargeState == nil && resurrector != nil && vehicleClimater != nil && vehicleFinishTimer == nil && vehicleOdometer != nil && vehiclePosition != nil && vehicleRange == nil:
+		return &struct {
+			api.Vehicle
+			api.Resurrector
+			api.VehicleClimater
+			api.VehicleOdometer
+			api.VehiclePosition
+		}{
+			Vehicle: base,
+			Resurrector: &decorateVehicleResurrectorImpl{
+				resurrector: resurrector,
+			},
+			VehicleClimater: &decorateVehicleVehicleClimaterImpl{
+				vehicleClimater: vehicleClimater,
+			},
+			VehicleOdometer: &decorateVehicleVehicleOdometerImpl{
+				vehicleOdometer: vehicleOdometer,
+			},
+			VehiclePosition: &decorateVehicleVehiclePositionImpl{
+				vehiclePosition: vehiclePosition,
+			},
+		}
+
+	case chargeState != nil && resurrector != nil && vehicleClimater != nil && vehicleFinishTimer == nil && vehicleOdometer != nil && vehiclePosition != nil && vehicleRange == nil:
+		return &struct {
+			api.Vehicle
+			api.ChargeState
+			api.Resurrector
+			api.VehicleClimater
+			api.VehicleOdometer
+			api.VehiclePosition
+		}{
+			Vehicle: base,
+			ChargeState: &decorateVehicleChargeStateImpl{
+				chargeState: chargeState,
+			},
+			Resurrector: &decorateVehicleResurrectorImpl{
+				resurrector: resurrector,
+			},
+			VehicleClimater: &decorateVehicleVehicleClimaterImpl{
+				vehicleClimater: vehicleClimater,
+			},
+			VehicleOdometer: &decorateVehicleVehicleOdometerImpl{
+				vehicleOdometer: vehicleOdometer,
+			},
+			VehiclePosition: &decorateVehicleVehiclePositionImpl{
+				vehiclePosition: vehiclePosition,
+			},
+		}
+
+	case chargeState == nil && resurrector != nil && vehicleClimater != nil && vehicleFinishTimer != nil && vehicleOdometer != nil && vehiclePosition != nil && vehicleRange == nil:
+		return &struct {
+			api.Vehicle
+			api.Resurrector
+			api.VehicleClimater
+			api.VehicleFinishTimer
+			api.VehicleOdometer
+			api.VehiclePosition
+		}{
+			Vehicle: base,
+			Resurrector: &decorateVehicleResurrectorImpl{
+				resurrector: resurrector,
+			},
+			VehicleClimater: &decorateVehicleVehicleClimaterImpl{
+				vehicleClimater: vehicleClimater,
+			},
+			VehicleFinishTimer: &decorateVehicleVehicleFinishTimerImpl{
+				vehicleFinishTimer: vehicleFinishTimer,
+			},
+			VehicleOdometer: &decorateVehicleVehicleOdometerImpl{
+				vehicleOdometer: vehicleOdometer,
+			},
+			VehiclePosition: &decorateVehicleVehiclePositionImpl{
+				vehiclePosition: vehiclePosition,
+			},
+		}
+
+	case chargeState != nil && resurrector != nil && vehicleClimater != nil && vehicleFinishTimer != nil && vehicleOdometer != nil && vehiclePosition != nil && vehicleRange == nil:
+		return &struct {
+			api.Vehicle
+			api.ChargeState
+			api.Resurrector
+			api.VehicleClimater
+			api.VehicleFinishTimer
+			api.VehicleOdometer
+			api.VehiclePosition
+		}{
+			Vehicle: base,
+			ChargeState: &decorateVehicleChargeStateImpl{
+				chargeState: chargeState,
+			},
+			Resurrector: &decorateVehicleResurrectorImpl{
+				resurrector: resurrector,
+			},
+			VehicleClimater: &decorateVehicleVehicleClimaterImpl{
+				vehicleClimater: vehicleClimater,
+			},
+			VehicleFinishTimer: &decorateVehicleVehicleFinishTimerImpl{
+				vehicleFinishTimer: vehicleFinishTimer,
+			},
+			VehicleOdometer: &decorateVehicleVehicleOdometerImpl{
+				vehicleOdometer: vehicleOdometer,
+			},
+			VehiclePosition: &decorateVehicleVehiclePositionImpl{
+				vehiclePosition: vehiclePosition,
+			},
+		}
+
+	case chargeState == nil && resurrector != nil && vehicleClimater != nil && vehicleFinishTimer == nil && vehicleOdometer != nil && vehiclePosition != nil && vehicleRange != nil:
+		return &struct {
+			api.Vehicle
+			api.Resurrector
+			api.VehicleClimater
+			api.VehicleOdometer
+			api.VehiclePosition
+			api.VehicleRange
+		}{
+			Vehicle: base,
+			Resurrector: &decorateVehicleResurrectorImpl{
+				resurrector: resurrector,
+			},
+			VehicleClimater: &decorateVehicleVehicleClimaterImpl{
+				vehicleClimater: vehicleClimater,
+			},
+			VehicleOdometer: &decorateVehicleVehicleOdometerImpl{
+				vehicleOdometer: vehicleOdometer,
+			},
+			VehiclePosition: &decorateVehicleVehiclePositionImpl{
+				vehiclePosition: vehiclePosition,
+			},
+			VehicleRange: &decorateVehicleVehicleRangeImpl{
+				vehicleRange: vehicleRange,
+			},
+		}
+
+	case chargeState != nil && resurrector != nil && vehicleClimater != nil && vehicleFinishTimer == nil && vehicleOdometer != nil && vehiclePosition != nil && vehicleRange != nil:
+		return &struct {
+			api.Vehicle
+			api.ChargeState
+			api.Resurrector
+			api.VehicleClimater
+			api.VehicleOdometer
+			api.VehiclePosition
+			api.VehicleRange
+		}{
+			Vehicle: base,
+			ChargeState: &decorateVehicleChargeStateImpl{
+				chargeState: chargeState,
+			},
+			Resurrector: &decorateVehicleResurrectorImpl{
+				resurrector: resurrector,
+			},
+			VehicleClimater: &decorateVehicleVehicleClimaterImpl{
+				vehicleClimater: vehicleClimater,
+			},
+			VehicleOdometer: &decorateVehicleVehicleOdometerImpl{
+				vehicleOdometer: vehicleOdometer,
+			},
+			VehiclePosition: &decorateVehicleVehiclePositionImpl{
+				vehiclePosition: vehiclePosition,
+			},
+			VehicleRange: &decorateVehicleVehicleRangeImpl{
+				vehicleRange: vehicleRange,
+			},
+		}
+
+	case chargeState == nil && resurrector != nil && vehicleClimater != nil && vehicleFinishTimer != nil && vehicleOdometer != nil && vehiclePosition != nil && vehicleRange != nil:
+		return &struct {
+			api.Vehicle
+			api.Resurrector
+			api.VehicleClimater
+			api.VehicleFinishTimer
+			api.VehicleOdometer
+			api.VehiclePosition
+			api.VehicleRange
+		}{
+			Vehicle: base,
+			Resurrector: &decorateVehicleResurrectorImpl{
+				resurrector: resurrector,
+			},
+			VehicleClimater: &decorateVehicleVehicleClimaterImpl{
+				vehicleClimater: vehicleClimater,
+			},
+			VehicleFinishTimer: &decorateVehicleVehicleFinishTimerImpl{
+				vehicleFinishTimer: vehicleFinishTimer,
+			},
+			VehicleOdometer: &decorateVehicleVehicleOdometerImpl{
+				vehicleOdometer: vehicleOdometer,
+			},
+			VehiclePosition: &decorateVehicleVehiclePositionImpl{
+				vehiclePosition: vehiclePosition,
+			},
+			VehicleRange: &decorateVehicleVehicleRangeImpl{
+				vehicleRange: vehicleRange,
+			},
+		}
+
+	case chargeState != nil && resurrector != nil && vehicleClimater != nil && vehicleFinishTimer != nil && vehicleOdometer != nil && vehiclePosition != nil && vehicleRange != nil:
+		return &struct {
+			api.Vehicle
+			api.ChargeState
+			api.Resurrector
+			api.VehicleClimater
+			api.VehicleFinishTimer
+			api.VehicleOdometer
+			api.VehiclePosition
+			api.VehicleRange
+		}{
+			Vehicle: base,
+			ChargeState: &decorateVehicleChargeStateImpl{
+				chargeState: chargeState,
+			},
+			Resurrector: &decorateVehicleResurrectorImpl{
+				resurrector: resurrector,
+			},
+			VehicleClimater: &decorateVehicleVehicleClimaterImpl{
+				vehicleClimater: vehicleClimater,
+			},
+			VehicleFinishTimer: &decorateVehicleVehicleFinishTimerImpl{
+				vehicleFinishTimer: vehicleFinishTimer,
+			},
+			VehicleOdometer: &decorateVehicleVehicleOdometerImpl{
+				vehicleOdometer: vehicleOdometer,
+			},
+			VehiclePosition: &decorateVehicleVehiclePositionImpl{
+				vehiclePosition: vehiclePosition,
+			},
+			VehicleRange: &decorateVehicleVehicleRangeImpl{
+				vehicleRange: vehicleRange,
+			},
+		}
+	}
+
+	return nil
+}
+
+type decorateVehicleChargeStateImpl struct {
+	chargeState func() (api.ChargeStatus, error)
+}
+
+func (impl *decorateVehicleChargeStateImpl) Status() (api.ChargeStatus, error) {
+	return impl.chargeState()
+}
+
+type decorateVehicleResurrectorImpl struct {
+	resurrector func() error
+}
+
+func (impl *decorateVehicleResurrectorImpl) WakeUp() error {
+	return impl.resurrector()
+}
+
+type decorateVehicleVehicleClimaterImpl struct {
+	vehicleClimater func() (bool, float64, float64, error)
+}
+
+func (impl *decorateVehicleVehicleClimaterImpl) Climater() (bool, float64, float64, error) {
+	return impl.vehicleClimater()
+}
+
+type decorateVehicleVehicleFinishTimerImpl struct {
+	vehicleFinishTimer func() (time.Time, error)
+}
+
+func (impl *decorateVehicleVehicleFinishTimerImpl) FinishTime() (time.Time, error) {
+	return impl.vehicleFinishTimer()
+}
+
+type decorateVehicleVehicleOdometerImpl struct {
+	vehicleOdometer func() (float64, error)
+}
+
+func (impl *decorateVehicleVehicleOdometerImpl) Odometer() (float64, error) {
+	return impl.vehicleOdometer()
+}
+
+type decorateVehicleVehiclePositionImpl struct {
+	vehiclePosition func() (float64, float64, error)
+}
+
+func (impl *decorateVehicleVehiclePositionImpl) Position() (float64, float64, error) {
+	return impl.vehiclePosition()
+}
+
+type decorateVehicleVehicleRangeImpl struct {
+	vehicleRange func() (int64, error)
+}
+
+func (impl *decorateVehicleVehicleRangeImpl) Range() (int64, error) {
+	return impl.vehicleRange()
+}
@@ -0,0 +1,69 @@
+package metrics
+
+import (
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// Device types
+const (
+	Meter   = "meter"
+	Charger = "charger"
+	Vehicle = "vehicle"
+)
+
+var (
+	durationMetric *prometheus.SummaryVec
+	requestMetric  *prometheus.CounterVec
+	errorMetric    *prometheus.CounterVec
+)
+
+func init() {
+	labels := []string{"type", "name"}
+
+	durationMetric = prometheus.NewSummaryVec(prometheus.SummaryOpts{
+		Namespace: "evcc",
+		Subsystem: "device",
+		Name:      "request_duration_seconds",
+		Help:      "A summary of device request durations",
+		Objectives: map[float64]float64{
+			0.5:  0.05,  // 50th percentile with a max. absolute error of 0.05
+			0.9:  0.01,  // 90th percentile with a max. absolute error of 0.01
+			0.99: 0.001, // 99th percentile with a max. absolute error of 0.001
+		},
+	}, labels)
+
+	requestMetric = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "evcc",
+		Subsystem: "device",
+		Name:      "request_total",
+		Help:      "Total count of device requests",
+	}, labels)
+
+	errorMetric = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "evcc",
+		Subsystem: "device",
+		Name:      "error_total",
+		Help:      "Total count of failed device requests",
+	}, labels)
+
+	prometheus.MustRegister(durationMetric, requestMetric, errorMetric)
+}
+
+// Observe records duration and result of a device request started at start
+func Observe(typ, name string, start time.Time, err error) {
+	duration := time.Since(start)
+
+	durationMetric.WithLabelValues(typ, name).Observe(duration.Seconds())
+	requestMetric.WithLabelValues(typ, name).Inc()
+
+	if err != nil {
+		errorMetric.WithLabelValues(typ, name).Inc()
+	} else {
+		// make sure the error series exists for rate() queries
+		errorMetric.WithLabelValues(typ, name).Add(0)
+	}
+
+	record(typ, name, time.Now(), duration, err)
+}
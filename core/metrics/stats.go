@@ -0,0 +1,115 @@
+package metrics
+
+import (
+	"math"
+	"sort"
+	"sync"
+	"time"
+)
+
+// samples is the number of recent requests used for latency percentiles
+const samples = 100
+
+// Latency contains request latency percentiles in seconds
+type Latency struct {
+	P50 float64 `json:"p50"`
+	P90 float64 `json:"p90"`
+	P99 float64 `json:"p99"`
+	Max float64 `json:"max"`
+}
+
+// Stats contains the request statistics of a device
+type Stats struct {
+	Type          string    `json:"type"`
+	Name          string    `json:"name"`
+	Requests      int64     `json:"requests"`
+	Errors        int64     `json:"errors"`
+	LastError     string    `json:"lastError,omitempty"`
+	LastErrorTime time.Time `json:"lastErrorTime"`
+	LastSuccess   time.Time `json:"lastSuccess"`
+	Latency       Latency   `json:"latency"`
+}
+
+type stats struct {
+	Stats
+	durations []time.Duration // ring buffer of recent durations
+	next      int
+}
+
+var (
+	mu      sync.Mutex
+	devices = make(map[[2]string]*stats)
+)
+
+func record(typ, name string, now time.Time, duration time.Duration, err error) {
+	mu.Lock()
+	defer mu.Unlock()
+
+	key := [2]string{typ, name}
+	s, ok := devices[key]
+	if !ok {
+		s = &stats{Stats: Stats{Type: typ, Name: name}}
+		devices[key] = s
+	}
+
+	s.Requests++
+	if err != nil {
+		s.Errors++
+		s.LastError = err.Error()
+		s.LastErrorTime = now
+	} else {
+		s.LastSuccess = now
+	}
+
+	if len(s.durations) < samples {
+		s.durations = append(s.durations, duration)
+	} else {
+		s.durations[s.next] = duration
+		s.next = (s.next + 1) % samples
+	}
+}
+
+// percentile returns the p-th percentile of the sorted durations using nearest rank
+func percentile(sorted []time.Duration, p float64) float64 {
+	if len(sorted) == 0 {
+		return 0
+	}
+
+	i := int(math.Ceil(p*float64(len(sorted)))) - 1
+	if i < 0 {
+		i = 0
+	}
+
+	return sorted[i].Seconds()
+}
+
+// Diagnostics returns the request statistics of all devices sorted by type and name
+func Diagnostics() []Stats {
+	mu.Lock()
+	defer mu.Unlock()
+
+	res := make([]Stats, 0, len(devices))
+	for _, s := range devices {
+		sorted := append([]time.Duration{}, s.durations...)
+		sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+
+		st := s.Stats
+		st.Latency = Latency{
+			P50: percentile(sorted, 0.5),
+			P90: percentile(sorted, 0.9),
+			P99: percentile(sorted, 0.99),
+			Max: percentile(sorted, 1),
+		}
+
+		res = append(res, st)
+	}
+
+	sort.Slice(res, func(i, j int) bool {
+		if res[i].Type != res[j].Type {
+			return res[i].Type < res[j].Type
+		}
+		return res[i].Name < res[j].Name
+	})
+
+	return res
+}
@@ -0,0 +1,46 @@
+package metrics
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDiagnostics(t *testing.T) {
+	now := time.Now()
+
+	for i := 1; i <= 200; i++ {
+		record(Meter, "test-grid", now, time.Duration(i)*time.Millisecond, nil)
+	}
+	record(Meter, "test-grid", now, time.Second, errors.New("timeout"))
+	record(Charger, "test-wallbox", now, time.Millisecond, nil)
+
+	var grid, wallbox *Stats
+	res := Diagnostics()
+	for i, s := range res {
+		switch s.Name {
+		case "test-grid":
+			grid = &res[i]
+		case "test-wallbox":
+			wallbox = &res[i]
+		}
+	}
+
+	require.NotNil(t, grid)
+	require.NotNil(t, wallbox)
+
+	assert.Equal(t, int64(201), grid.Requests)
+	assert.Equal(t, int64(1), grid.Errors)
+	assert.Equal(t, "timeout", grid.LastError)
+	assert.Equal(t, now, grid.LastSuccess)
+
+	// only the most recent samples are used: 102ms .. 200ms and 1s
+	assert.Equal(t, 0.151, grid.Latency.P50)
+	assert.Equal(t, 1.0, grid.Latency.Max)
+
+	assert.Equal(t, int64(1), wallbox.Requests)
+	assert.Equal(t, 0.001, wallbox.Latency.P99)
+}
@@ -1,10 +1,10 @@
 package core
 
 import (
-	"math"
 	"time"
 
 	"github.com/benbjohnson/clock"
+	"github.com/evcc-io/evcc/core/energy"
 	"github.com/evcc-io/evcc/tariff"
 )
 
@@ -75,20 +75,7 @@ func (s *Savings) SavingsAmount() float64 {
 }
 
 func (s *Savings) shareOfSelfProducedEnergy(gridPower, pvPower, batteryPower float64) float64 {
-	batteryDischarge := math.Max(0, batteryPower)
-	batteryCharge := math.Min(0, batteryPower) * -1
-	pvConsumption := math.Min(pvPower, pvPower+gridPower-batteryCharge)
-
-	gridImport := math.Max(0, gridPower)
-	selfConsumption := math.Max(0, batteryDischarge+pvConsumption+batteryCharge)
-
-	share := selfConsumption / (gridImport + selfConsumption)
-
-	if math.IsNaN(share) {
-		return 0
-	}
-
-	return share
+	return energy.SelfShare(gridPower, pvPower, batteryPower)
 }
 
 func (s *Savings) currentGridPrice() float64 {
@@ -8,8 +8,10 @@ import (
 	"time"
 
 	"github.com/avast/retry-go/v3"
+	"github.com/benbjohnson/clock"
 	"github.com/evcc-io/evcc/api"
 	"github.com/evcc-io/evcc/core/coordinator"
+	"github.com/evcc-io/evcc/core/energy"
 	"github.com/evcc-io/evcc/core/loadpoint"
 	"github.com/evcc-io/evcc/push"
 	"github.com/evcc-io/evcc/tariff"
@@ -24,7 +26,9 @@ type Updater interface {
 // Site is the main configuration container. A site can host multiple loadpoints.
 type Site struct {
 	uiChan       chan<- util.Param // client push messages
+	pushChan     chan<- push.Event // notifications
 	lpUpdateChan chan *LoadPoint
+	reloadChan   chan func()
 
 	*Health
 
@@ -32,29 +36,45 @@ type Site struct {
 	log *util.Logger
 
 	// configuration
-	Title                             string       `mapstructure:"title"`         // UI title
-	Voltage                           float64      `mapstructure:"voltage"`       // Operating voltage. 230V for Germany.
-	ResidualPower                     float64      `mapstructure:"residualPower"` // PV meter only: household usage. Grid meter: household safety margin
-	Meters                            MetersConfig // Meter references
-	PrioritySoC                       float64      `mapstructure:"prioritySoC"`                       // prefer battery up to this SoC
-	BufferSoC                         float64      `mapstructure:"bufferSoC"`                         // ignore battery above this SoC
-	MaxGridSupplyWhileBatteryCharging float64      `mapstructure:"maxGridSupplyWhileBatteryCharging"` // ignore battery charging if AC consumption is above this value
+	Title                             string          `mapstructure:"title"`         // UI title
+	Voltage                           float64         `mapstructure:"voltage"`       // Operating voltage. 230V for Germany.
+	ResidualPower                     float64         `mapstructure:"residualPower"` // PV meter only: household usage. Grid meter: household safety margin
+	Meters                            MetersConfig    // Meter references
+	PrioritySoC                       float64         `mapstructure:"prioritySoC"`                       // prefer battery up to this SoC
+	BufferSoC                         float64         `mapstructure:"bufferSoC"`                         // ignore battery above this SoC
+	MaxGridSupplyWhileBatteryCharging float64         `mapstructure:"maxGridSupplyWhileBatteryCharging"` // ignore battery charging if AC consumption is above this value
+	GridLimit                         GridLimitConfig `mapstructure:"gridLimit"`                         // grid operator curtailment signal
+	MeterFailureTimeout               time.Duration   `mapstructure:"meterFailureTimeout"`               // notify if meter fails for this long
 
 	// meters
 	gridMeter     api.Meter   // Grid usage meter
 	pvMeters      []api.Meter // PV generation meters
 	batteryMeters []api.Meter // Battery charging meters
 
-	tariffs     tariff.Tariffs           // Tariff
-	loadpoints  []*LoadPoint             // Loadpoints
-	coordinator *coordinator.Coordinator // Savings
-	savings     *Savings                 // Savings
+	tariffs      tariff.Tariffs           // Tariff
+	loadpoints   []*LoadPoint             // Loadpoints
+	coordinator  *coordinator.Coordinator // Savings
+	savings      *Savings                 // Savings
+	energyReport *energy.Report           // Energy totals per day and month
+	energyErrors map[string]bool          // Failing energy registers by meter name
 
 	// cached state
-	gridPower       float64 // Grid power
-	pvPower         float64 // PV power
-	batteryPower    float64 // Battery charge power
-	batteryBuffered bool    // Battery buffer active
+	gridPower       float64  // Grid power
+	gridEnergy      *float64 // Grid import energy register
+	pvPower         float64  // PV power
+	batteryPower    float64  // Battery charge power
+	batteryBuffered bool     // Battery buffer active
+
+	powerLimits map[string]float64 // Loadpoint power limits by source
+	powerLimit  float64            // Effective loadpoint power limit
+
+	gridLimitActive func() (bool, error) // Grid operator curtailment signal
+	gridLimited     bool                 // Grid operator curtailment active
+
+	meterFailures map[string]*failureTimer // Meter failure timers by meter name
+	healthy       bool                     // Site health status
+
+	clock clock.Clock // mockable time
 }
 
 // MetersConfig contains the loadpoint's meter configuration
@@ -130,14 +150,24 @@ func NewSiteFromConfig(
 		return nil, errors.New("missing either grid or pv meter")
 	}
 
+	// grid operator curtailment
+	if err := site.configureGridLimit(); err != nil {
+		return nil, fmt.Errorf("grid limit: %w", err)
+	}
+
 	return site, nil
 }
 
 // NewSite creates a Site with sane defaults
 func NewSite() *Site {
 	lp := &Site{
-		log:     util.NewLogger("site"),
-		Voltage: 230, // V
+		log:                 util.NewLogger("site"),
+		clock:               clock.New(),
+		Voltage:             230, // V
+		MeterFailureTimeout: 5 * time.Minute,
+		powerLimits:         make(map[string]float64),
+		meterFailures:       make(map[string]*failureTimer),
+		healthy:             true, // report sites that are unhealthy from startup
 	}
 
 	return lp
@@ -309,6 +339,8 @@ func (site *Site) updateMeters() error {
 				err = fmt.Errorf("pv meter %d: %v", id, err)
 				site.log.ERROR.Println(err)
 			}
+
+			site.updateMeterFailure(fmt.Sprintf("pv %d", id), err)
 		}
 
 		site.log.DEBUG.Printf("pv power: %.0fW", site.pvPower)
@@ -327,6 +359,8 @@ func (site *Site) updateMeters() error {
 			} else {
 				site.log.ERROR.Println(fmt.Errorf("battery meter %d: %v", id, err))
 			}
+
+			site.updateMeterFailure(fmt.Sprintf("battery %d", id), err)
 		}
 
 		site.log.DEBUG.Printf("battery power: %.0fW", site.batteryPower)
@@ -334,6 +368,9 @@ func (site *Site) updateMeters() error {
 	}
 
 	err := retryMeter("grid", site.gridMeter, &site.gridPower)
+	if site.gridMeter != nil {
+		site.updateMeterFailure("grid", err)
+	}
 
 	// currents
 	if phaseMeter, ok := site.gridMeter.(api.MeterCurrent); err == nil && ok {
@@ -349,7 +386,9 @@ func (site *Site) updateMeters() error {
 	// grid energy
 	if energyMeter, ok := site.gridMeter.(api.MeterEnergy); ok {
 		val, err := energyMeter.TotalEnergy()
+		site.gridEnergy = nil
 		if err == nil {
+			site.gridEnergy = &val
 			site.publish("gridEnergy", val)
 		} else {
 			site.log.ERROR.Println(fmt.Errorf("grid meter energy: %v", err))
@@ -432,12 +471,19 @@ func (site *Site) update(lp Updater) {
 
 	// update all loadpoint's charge power
 	var totalChargePower float64
-	for _, lp := range site.loadpoints {
-		lp.UpdateChargePower()
+	for id, lp := range site.loadpoints {
+		err := lp.UpdateChargePower()
+		if site.meterFailed(fmt.Sprintf("lp-%d charge", id+1), err) {
+			lp.publish("meterFailure", "charge")
+			lp.pushEvent(evMeterFailure)
+		}
+
 		totalChargePower += lp.GetChargePower()
 	}
 
 	if sitePower, err := site.sitePower(totalChargePower); err == nil {
+		site.updateGridLimit()
+		site.distributePowerLimit()
 		lp.Update(sitePower, cheap, site.batteryBuffered)
 
 		// ignore negative pvPower values as that means it is not an energy source but consumption
@@ -445,6 +491,8 @@ func (site *Site) update(lp Updater) {
 		homePower = math.Max(homePower, 0)
 		site.publish("homePower", homePower)
 
+		site.updateEnergy(homePower)
+
 		site.Health.Update()
 	}
 
@@ -463,6 +511,10 @@ func (site *Site) prepare() {
 	site.publish("bufferSoC", site.BufferSoC)
 	site.publish("prioritySoC", site.PrioritySoC)
 	site.publish("residualPower", site.ResidualPower)
+	site.publish("powerLimit", site.powerLimit)
+	if site.gridLimitActive != nil {
+		site.publish("gridLimitActive", site.gridLimited)
+	}
 
 	site.publish("currency", site.tariffs.Currency.String())
 	site.publish("savingsSince", site.savings.Since().Unix())
@@ -473,7 +525,9 @@ func (site *Site) prepare() {
 // Prepare attaches communication channels to site and loadpoints
 func (site *Site) Prepare(uiChan chan<- util.Param, pushChan chan<- push.Event) {
 	site.uiChan = uiChan
+	site.pushChan = pushChan
 	site.lpUpdateChan = make(chan *LoadPoint, 1) // 1 capacity to avoid deadlock
+	site.reloadChan = make(chan func())
 
 	site.prepare()
 
@@ -516,6 +570,9 @@ func (site *Site) Run(stopC chan struct{}, interval time.Duration) {
 	loadpointChan := make(chan Updater)
 	go site.loopLoadpoints(loadpointChan)
 
+	// health is evaluated outside the control loop to detect when it is stuck
+	go site.watchHealth(stopC, interval)
+
 	ticker := time.NewTicker(interval)
 	site.update(<-loadpointChan) // start immediately
 
@@ -525,6 +582,8 @@ func (site *Site) Run(stopC chan struct{}, interval time.Duration) {
 			site.update(<-loadpointChan)
 		case lp := <-site.lpUpdateChan:
 			site.update(lp)
+		case fn := <-site.reloadChan:
+			fn()
 		case <-stopC:
 			return
 		}
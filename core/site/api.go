@@ -26,6 +26,11 @@ type API interface {
 	GetResidualPower() float64
 	SetResidualPower(float64) error
 
+	// SetPowerLimit limits total loadpoint power on behalf of source. Zero removes the source's limit.
+	SetPowerLimit(source string, power float64)
+	// GetPowerLimit returns the effective loadpoint power limit or zero if not limited
+	GetPowerLimit() float64
+
 	//
 	// vehicles
 	//
@@ -0,0 +1,109 @@
+package core
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/avast/retry-go/v3"
+	"github.com/evcc-io/evcc/api"
+	"github.com/evcc-io/evcc/core/energy"
+)
+
+// SetEnergyReport attaches the energy report updated by the site
+func (site *Site) SetEnergyReport(report *energy.Report) {
+	site.energyReport = report
+}
+
+// meterEnergy returns the meter's energy register or nil if not available.
+// Failures are logged as error once until the register recovers.
+func (site *Site) meterEnergy(name string, meter api.Meter) *float64 {
+	m, ok := meter.(api.MeterEnergy)
+	if !ok {
+		return nil
+	}
+
+	var val float64
+	err := retry.Do(func() (err error) {
+		val, err = m.TotalEnergy()
+		return err
+	}, retryOptions...)
+
+	if site.energyErrors == nil {
+		site.energyErrors = make(map[string]bool)
+	}
+
+	if err != nil {
+		if !site.energyErrors[name] {
+			site.log.ERROR.Printf("%s meter energy: %v", name, err)
+		} else {
+			site.log.DEBUG.Printf("%s meter energy: %v", name, err)
+		}
+
+		site.energyErrors[name] = true
+		return nil
+	}
+
+	if site.energyErrors[name] {
+		site.log.INFO.Printf("%s meter energy: recovered", name)
+		delete(site.energyErrors, name)
+	}
+
+	return &val
+}
+
+// metersEnergy returns the sum of the meters' energy registers or nil if not all meters provide energy
+func (site *Site) metersEnergy(name string, meters []api.Meter) *float64 {
+	if len(meters) == 0 {
+		return nil
+	}
+
+	var sum float64
+	for id, meter := range meters {
+		val := site.meterEnergy(fmt.Sprintf("%s %d", name, id), meter)
+		if val == nil {
+			return nil
+		}
+
+		sum += *val
+	}
+
+	return &sum
+}
+
+// updateEnergy adds the current measurement to the energy report and publishes the current day and month totals
+func (site *Site) updateEnergy(homePower float64) {
+	if site.energyReport == nil {
+		return
+	}
+
+	m := energy.Measurement{
+		Time:          time.Now(),
+		PVPower:       site.pvPower,
+		GridPower:     site.gridPower,
+		BatteryPower:  site.batteryPower,
+		HomePower:     homePower,
+		PVEnergy:      site.metersEnergy("pv", site.pvMeters),
+		GridEnergy:    site.gridEnergy,
+		BatteryEnergy: site.metersEnergy("battery", site.batteryMeters),
+	}
+
+	for id, lp := range site.loadpoints {
+		m.ChargePower = append(m.ChargePower, lp.GetChargePower())
+		m.ChargeEnergy = append(m.ChargeEnergy, site.meterEnergy(fmt.Sprintf("lp-%d charge", id+1), lp.chargeMeter))
+	}
+
+	site.energyReport.Add(m)
+
+	for key, period := range map[string]energy.Period{"energyToday": energy.Day, "energyMonth": energy.Month} {
+		totals := site.energyReport.Current(period, m.Time)
+		site.publish(key, totals.Map())
+
+		for id, lp := range site.loadpoints {
+			var charged energy.Charged
+			if id < len(totals.LoadPoints) {
+				charged = totals.LoadPoints[id]
+			}
+			lp.publish(key, charged.Map())
+		}
+	}
+}
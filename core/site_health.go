@@ -0,0 +1,81 @@
+package core
+
+import (
+	"time"
+)
+
+const evSiteUnhealthy = "unhealthy" // site stopped updating
+
+// meterFailed tracks meter read errors and returns true once the meter has been failing for longer than the failure timeout
+func (site *Site) meterFailed(name string, err error) bool {
+	if site.meterFailures == nil {
+		site.meterFailures = make(map[string]*failureTimer)
+	}
+
+	ft, ok := site.meterFailures[name]
+	if !ok {
+		ft = new(failureTimer)
+		site.meterFailures[name] = ft
+	}
+
+	if err == nil {
+		if ft.ok() {
+			site.log.INFO.Printf("%s meter: recovered", name)
+		}
+		return false
+	}
+
+	if ft.fail(site.clock.Now(), site.MeterFailureTimeout) {
+		site.log.ERROR.Printf("%s meter: failing for more than %v", name, site.MeterFailureTimeout)
+		return true
+	}
+
+	return false
+}
+
+// updateMeterFailure notifies about site meters failing for longer than the failure timeout
+func (site *Site) updateMeterFailure(name string, err error) {
+	if site.meterFailed(name, err) {
+		site.publish("meterFailure", name)
+		site.pushEvent(evMeterFailure)
+	}
+}
+
+// watchHealth periodically updates the site health until stopped
+func (site *Site) watchHealth(stopC chan struct{}, interval time.Duration) {
+	ticker := site.clock.Ticker(interval)
+	defer ticker.Stop()
+
+	// give the first update a chance to complete before reporting
+	start := site.clock.Now()
+
+	for {
+		select {
+		case <-ticker.C:
+			if site.clock.Since(start) < site.Health.timeout {
+				continue
+			}
+			site.updateHealth()
+		case <-stopC:
+			return
+		}
+	}
+}
+
+// updateHealth notifies when the site turns unhealthy
+func (site *Site) updateHealth() {
+	healthy := site.Health.Healthy()
+	if healthy == site.healthy {
+		return
+	}
+
+	site.healthy = healthy
+
+	if healthy {
+		site.log.INFO.Println("site healthy")
+		return
+	}
+
+	site.log.ERROR.Println("site unhealthy")
+	site.pushEvent(evSiteUnhealthy)
+}
@@ -0,0 +1,197 @@
+package core
+
+import (
+	"errors"
+	"math"
+	"sort"
+
+	"github.com/evcc-io/evcc/provider"
+	"github.com/evcc-io/evcc/push"
+)
+
+const (
+	gridLimitSource = "grid"
+
+	standbyPowerLimit = 1 // W, loadpoint limit if no power is left
+
+	evGridLimitStart = "gridlimitstart" // grid operator curtailment started
+	evGridLimitStop  = "gridlimitstop"  // grid operator curtailment ended
+)
+
+// GridLimitConfig is the grid operator curtailment configuration, e.g. ripple control receiver or dimming signal
+type GridLimitConfig struct {
+	Limit  float64          // loadpoint power limit in W while curtailment is active
+	Active *provider.Config // bool provider signalling curtailment
+	Value  *provider.Config // float provider, non-zero values signal curtailment
+}
+
+// SetPowerLimit limits total loadpoint power on behalf of source. Zero removes the source's limit.
+func (site *Site) SetPowerLimit(source string, power float64) {
+	site.Lock()
+	defer site.Unlock()
+
+	if power > 0 {
+		site.powerLimits[source] = power
+	} else {
+		delete(site.powerLimits, source)
+	}
+
+	var limit float64
+	for _, l := range site.powerLimits {
+		if limit == 0 {
+			limit = l
+		}
+		limit = math.Min(limit, l)
+	}
+
+	if limit == site.powerLimit {
+		return
+	}
+
+	if limit > 0 {
+		site.log.INFO.Printf("power limit: %.0fW (%s)", limit, source)
+	} else {
+		site.log.INFO.Printf("power limit removed (%s)", source)
+	}
+
+	site.powerLimit = limit
+	site.publish("powerLimit", limit)
+}
+
+// GetPowerLimit returns the effective loadpoint power limit or zero if not limited
+func (site *Site) GetPowerLimit() float64 {
+	site.Lock()
+	defer site.Unlock()
+	return site.powerLimit
+}
+
+// distributePowerLimit shares the power limit between loadpoints by demand
+func (site *Site) distributePowerLimit() {
+	limit := site.GetPowerLimit()
+
+	demands := make([]float64, len(site.loadpoints))
+	if limit > 0 {
+		for id, lp := range site.loadpoints {
+			demands[id] = lp.powerDemand()
+		}
+	}
+
+	for id, share := range shareByDemand(limit, demands) {
+		site.loadpoints[id].setPowerLimit(share)
+	}
+}
+
+// shareByDemand divides the power limit. Smaller demands are served first, larger demands
+// share the remaining power equally. Loadpoints without demand share the unused power.
+// Zero limit returns zero shares, i.e. no limit.
+func shareByDemand(limit float64, demands []float64) []float64 {
+	res := make([]float64, len(demands))
+	if limit <= 0 {
+		return res
+	}
+
+	var active []int
+	for id, demand := range demands {
+		if demand > 0 {
+			active = append(active, id)
+		}
+	}
+
+	sort.SliceStable(active, func(i, j int) bool {
+		return demands[active[i]] < demands[active[j]]
+	})
+
+	remaining := limit
+	for i, id := range active {
+		res[id] = math.Min(demands[id], remaining/float64(len(active)-i))
+		remaining -= res[id]
+	}
+
+	// zero would remove the limit
+	if idle := len(demands) - len(active); idle > 0 {
+		share := math.Max(remaining/float64(idle), standbyPowerLimit)
+		for id, demand := range demands {
+			if demand == 0 {
+				res[id] = share
+			}
+		}
+	}
+
+	return res
+}
+
+// configureGridLimit creates the grid operator curtailment signal from config
+func (site *Site) configureGridLimit() error {
+	cc := site.GridLimit
+
+	if cc.Active == nil && cc.Value == nil {
+		return nil
+	}
+
+	if cc.Active != nil && cc.Value != nil {
+		return errors.New("cannot have active and value both")
+	}
+
+	if cc.Limit <= 0 {
+		return errors.New("missing limit")
+	}
+
+	if cc.Active != nil {
+		active, err := provider.NewBoolGetterFromConfig(*cc.Active)
+		site.gridLimitActive = active
+		return err
+	}
+
+	value, err := provider.NewFloatGetterFromConfig(*cc.Value)
+	site.gridLimitActive = func() (bool, error) {
+		f, err := value()
+		return f != 0, err
+	}
+
+	return err
+}
+
+// updateGridLimit applies the grid operator curtailment signal
+func (site *Site) updateGridLimit() {
+	if site.gridLimitActive == nil {
+		return
+	}
+
+	active, err := site.gridLimitActive()
+	if err != nil {
+		site.log.ERROR.Printf("grid limit: %v", err)
+		return
+	}
+
+	if active == site.gridLimited {
+		return
+	}
+
+	site.gridLimited = active
+	site.publish("gridLimitActive", active)
+
+	if active {
+		site.log.WARN.Printf("grid limit started: %.0fW", site.GridLimit.Limit)
+		site.SetPowerLimit(gridLimitSource, site.GridLimit.Limit)
+		site.pushEvent(evGridLimitStart)
+	} else {
+		site.log.WARN.Println("grid limit ended")
+		site.SetPowerLimit(gridLimitSource, 0)
+		site.pushEvent(evGridLimitStop)
+	}
+}
+
+// pushEvent sends push messages to clients
+func (site *Site) pushEvent(event string) {
+	// test helper
+	if site.pushChan == nil {
+		return
+	}
+
+	// don't block the control loop on slow messengers
+	select {
+	case site.pushChan <- push.Event{Event: event}:
+	default:
+		site.log.WARN.Printf("push: dropped %s event", event)
+	}
+}
@@ -0,0 +1,67 @@
+package core
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/evcc-io/evcc/api"
+	"github.com/evcc-io/evcc/tariff"
+)
+
+// Devices maps devices to their rebuilt instances
+type Devices struct {
+	Meters   map[api.Meter]api.Meter
+	Chargers map[api.Charger]api.Charger
+	Vehicles map[api.Vehicle]api.Vehicle
+}
+
+// Reload replaces rebuilt devices, the available vehicles and the tariffs if not nil.
+// It is executed by the site's main loop, loadpoints keep their session state.
+func (site *Site) Reload(devices Devices, vehicles []api.Vehicle, tariffs *tariff.Tariffs) error {
+	if site.reloadChan == nil {
+		return errors.New("site not running")
+	}
+
+	errC := make(chan error, 1)
+	site.reloadChan <- func() {
+		errC <- site.reload(devices, vehicles, tariffs)
+	}
+
+	return <-errC
+}
+
+func (site *Site) reload(devices Devices, vehicles []api.Vehicle, tariffs *tariff.Tariffs) error {
+	// validate before applying any change
+	for id, lp := range site.loadpoints {
+		if err := lp.checkReload(devices); err != nil {
+			return fmt.Errorf("lp-%d: %w", id+1, err)
+		}
+	}
+
+	if meter, ok := devices.Meters[site.gridMeter]; ok {
+		site.gridMeter = meter
+	}
+
+	for _, meters := range [][]api.Meter{site.pvMeters, site.batteryMeters} {
+		for i, m := range meters {
+			if meter, ok := devices.Meters[m]; ok {
+				meters[i] = meter
+			}
+		}
+	}
+
+	if tariffs != nil {
+		site.tariffs = *tariffs
+		site.savings.tariffs = *tariffs
+		site.publish("currency", site.tariffs.Currency.String())
+	}
+
+	site.coordinator.SetVehicles(vehicles)
+	site.publish("vehicles", vehicleTitles(vehicles))
+
+	for _, lp := range site.loadpoints {
+		lp.reload(devices, vehicles)
+	}
+
+	return nil
+}
@@ -0,0 +1,72 @@
+package core
+
+import (
+	"testing"
+
+	"github.com/evcc-io/evcc/api"
+	"github.com/evcc-io/evcc/core/coordinator"
+	"github.com/evcc-io/evcc/mock"
+	"github.com/evcc-io/evcc/util"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSiteReload(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	grid, pv := mock.NewMockMeter(ctrl), mock.NewMockMeter(ctrl)
+	charger := mock.NewMockCharger(ctrl)
+	vehicle := mock.NewMockVehicle(ctrl)
+
+	lp := NewLoadPoint(util.NewLogger("foo"))
+	lp.charger = charger
+	lp.configureChargerType(charger)
+	lp.vehicle = vehicle
+	lp.chargedEnergy = 1000
+
+	site := &Site{
+		log:         util.NewLogger("foo"),
+		gridMeter:   grid,
+		pvMeters:    []api.Meter{pv},
+		loadpoints:  []*LoadPoint{lp},
+		coordinator: coordinator.New(util.NewLogger("foo"), []api.Vehicle{vehicle}),
+	}
+	lp.coordinator = coordinator.NewAdapter(lp, site.coordinator)
+
+	newGrid := mock.NewMockMeter(ctrl)
+	newCharger := mock.NewMockCharger(ctrl)
+	newVehicle := mock.NewMockVehicle(ctrl)
+	newVehicle.EXPECT().Title().Return("new").AnyTimes()
+	newVehicle.EXPECT().Capacity().Return(int64(50)).AnyTimes()
+
+	err := site.reload(Devices{
+		Meters:   map[api.Meter]api.Meter{grid: newGrid},
+		Chargers: map[api.Charger]api.Charger{charger: newCharger},
+		Vehicles: map[api.Vehicle]api.Vehicle{vehicle: newVehicle},
+	}, []api.Vehicle{newVehicle}, nil)
+	assert.NoError(t, err)
+
+	assert.Equal(t, newGrid, site.gridMeter)
+	assert.Equal(t, pv, site.pvMeters[0])
+	assert.Equal(t, newCharger, lp.charger)
+	assert.Equal(t, newVehicle, lp.vehicle)
+	assert.Equal(t, "new", lp.vehicleTitle)
+	assert.Equal(t, []api.Vehicle{newVehicle}, site.GetVehicles())
+
+	// session state is kept
+	assert.Equal(t, 1000.0, lp.chargedEnergy)
+
+	// changed capabilities require restart
+	phaseCharger := struct {
+		*mock.MockCharger
+		*mock.MockPhaseSwitcher
+	}{
+		mock.NewMockCharger(ctrl), mock.NewMockPhaseSwitcher(ctrl),
+	}
+
+	err = site.reload(Devices{
+		Chargers: map[api.Charger]api.Charger{newCharger: phaseCharger},
+	}, []api.Vehicle{newVehicle}, nil)
+	assert.Error(t, err)
+	assert.Equal(t, newCharger, lp.charger)
+}
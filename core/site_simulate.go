@@ -0,0 +1,33 @@
+package core
+
+import (
+	"time"
+
+	"github.com/benbjohnson/clock"
+	"github.com/evcc-io/evcc/core/soc"
+)
+
+// Simulate prepares site and loadpoints for simulation. The clock replaces the system time
+// of the control logic and the loadpoints are updated by Step instead of Run.
+func (site *Site) Simulate(clck clock.Clock) {
+	site.Health = NewHealth(time.Minute)
+	site.clock = clck
+
+	site.savings.clock = clck
+	site.savings.started = clck.Now()
+	site.savings.updated = clck.Now()
+
+	for _, lp := range site.loadpoints {
+		lp.clock = clck
+		lp.wakeUpTimer.clck = clck
+		lp.socTimer = soc.NewTimer(lp.log, &adapter{LoadPoint: lp}, clck)
+
+		// no ui and push messages, update requests are ignored
+		lp.Prepare(nil, nil, nil)
+	}
+}
+
+// Step runs a single control cycle for the loadpoint like a tick of Run
+func (site *Site) Step(lp Updater) {
+	site.update(lp)
+}
@@ -1,9 +1,17 @@
 package core
 
 import (
+	"errors"
 	"testing"
+	"time"
 
+	"github.com/benbjohnson/clock"
+	"github.com/evcc-io/evcc/api"
+	"github.com/evcc-io/evcc/mock"
+	"github.com/evcc-io/evcc/push"
 	"github.com/evcc-io/evcc/util"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
 )
 
 func TestSitePower(t *testing.T) {
@@ -30,3 +38,192 @@ func TestSitePower(t *testing.T) {
 		}
 	}
 }
+
+func TestSitePowerLimit(t *testing.T) {
+	site := &Site{
+		log:         util.NewLogger("foo"),
+		powerLimits: make(map[string]float64),
+	}
+
+	tc := []struct {
+		source string
+		power  float64
+		limit  float64
+	}{
+		{"a", 6000, 6000},
+		{"b", 4200, 4200},
+		{"a", 3000, 3000},
+		{"a", 0, 4200},
+		{"b", 0, 0},
+	}
+
+	for _, tc := range tc {
+		site.SetPowerLimit(tc.source, tc.power)
+		if res := site.GetPowerLimit(); res != tc.limit {
+			t.Errorf("power limit wanted %.f, got %.f", tc.limit, res)
+		}
+	}
+}
+
+func TestSiteGridLimit(t *testing.T) {
+	var active bool
+
+	site := &Site{
+		log:             util.NewLogger("foo"),
+		powerLimits:     make(map[string]float64),
+		GridLimit:       GridLimitConfig{Limit: 4200},
+		gridLimitActive: func() (bool, error) { return active, nil },
+	}
+
+	site.updateGridLimit()
+	if res := site.GetPowerLimit(); res != 0 {
+		t.Errorf("power limit wanted 0, got %.f", res)
+	}
+
+	active = true
+	site.updateGridLimit()
+	if res := site.GetPowerLimit(); res != 4200 {
+		t.Errorf("power limit wanted 4200, got %.f", res)
+	}
+
+	active = false
+	site.updateGridLimit()
+	if res := site.GetPowerLimit(); res != 0 {
+		t.Errorf("power limit wanted 0, got %.f", res)
+	}
+}
+
+func TestShareByDemand(t *testing.T) {
+	tc := []struct {
+		limit   float64
+		demands []float64
+		shares  []float64
+	}{
+		{0, []float64{11000, 0}, []float64{0, 0}},
+		{6000, []float64{11000, 0}, []float64{6000, standbyPowerLimit}},
+		{6000, []float64{11000, 11000}, []float64{3000, 3000}},
+		{8000, []float64{11000, 1400, 0}, []float64{6600, 1400, standbyPowerLimit}},
+		{20000, []float64{11000, 4000, 0}, []float64{11000, 4000, 5000}},
+		{6000, []float64{0, 0}, []float64{3000, 3000}},
+		{9000, []float64{4000, 0, 0}, []float64{4000, 2500, 2500}},
+		{6000, []float64{6000, 0, 0}, []float64{6000, standbyPowerLimit, standbyPowerLimit}},
+	}
+
+	for _, tc := range tc {
+		assert.Equal(t, tc.shares, shareByDemand(tc.limit, tc.demands), "%+v", tc)
+	}
+}
+
+func TestSitePushEventNonBlocking(t *testing.T) {
+	site := &Site{
+		log:      util.NewLogger("foo"),
+		pushChan: make(chan push.Event),
+	}
+
+	done := make(chan struct{})
+	go func() {
+		site.pushEvent(evGridLimitStart)
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Error("push event blocked")
+	}
+}
+
+func TestSiteHealthWatchdog(t *testing.T) {
+	clck := clock.NewMock()
+	pushChan := make(chan push.Event, 1)
+
+	site := &Site{
+		log:      util.NewLogger("foo"),
+		pushChan: pushChan,
+		clock:    clck,
+		Health:   NewHealth(time.Minute),
+		healthy:  true,
+	}
+
+	// control loop is stuck and never updates health
+	stopC := make(chan struct{})
+	defer close(stopC)
+
+	go site.watchHealth(stopC, time.Second)
+
+	assert.Eventually(t, func() bool {
+		clck.Add(time.Second)
+
+		select {
+		case ev := <-pushChan:
+			return ev.Event == evSiteUnhealthy
+		default:
+			return false
+		}
+	}, time.Second, 10*time.Millisecond)
+}
+
+func TestSiteHealthStartup(t *testing.T) {
+	clck := clock.NewMock()
+	pushChan := make(chan push.Event, 1)
+
+	site := NewSite()
+	site.clock = clck
+	site.pushChan = pushChan
+	site.Health = NewHealth(time.Minute)
+
+	// control loop never completes its first update
+	stopC := make(chan struct{})
+	defer close(stopC)
+
+	go site.watchHealth(stopC, time.Second)
+
+	assert.Eventually(t, func() bool {
+		clck.Add(time.Second)
+
+		select {
+		case ev := <-pushChan:
+			return ev.Event == evSiteUnhealthy
+		default:
+			return false
+		}
+	}, time.Second, 10*time.Millisecond)
+}
+
+type energyMeter struct {
+	energy float64
+	err    error
+}
+
+func (m *energyMeter) CurrentPower() (float64, error) {
+	return 0, nil
+}
+
+func (m *energyMeter) TotalEnergy() (float64, error) {
+	return m.energy, m.err
+}
+
+func TestSiteMeterEnergy(t *testing.T) {
+	site := &Site{
+		log: util.NewLogger("foo"),
+	}
+
+	m := &energyMeter{energy: 10}
+	pv := []api.Meter{m, &energyMeter{energy: 5}}
+
+	if e := site.metersEnergy("pv", pv); assert.NotNil(t, e) {
+		assert.Equal(t, 15.0, *e)
+	}
+
+	// meters without register
+	assert.Nil(t, site.metersEnergy("pv", []api.Meter{m, mock.NewMockMeter(gomock.NewController(t))}))
+
+	// failures are retried and remembered until recovered
+	m.err = errors.New("failed")
+	assert.Nil(t, site.metersEnergy("pv", pv))
+	assert.True(t, site.energyErrors["pv 0"])
+
+	m.err = nil
+	assert.NotNil(t, site.metersEnergy("pv", pv))
+	assert.False(t, site.energyErrors["pv 0"])
+}
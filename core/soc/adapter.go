@@ -6,5 +6,6 @@ import "github.com/evcc-io/evcc/core/loadpoint"
 type Adapter interface {
 	loadpoint.API
 	Publish(key string, val interface{})
+	PushEvent(event string)
 	SocEstimator() *Estimator
 }
@@ -4,30 +4,36 @@ import (
 	"math"
 	"time"
 
+	"github.com/benbjohnson/clock"
 	"github.com/evcc-io/evcc/api"
 	"github.com/evcc-io/evcc/util"
 )
 
 const (
 	deviation = 30 * time.Minute
+
+	evTargetMiss = "targetmiss" // target time can no longer be met
 )
 
 // Timer is the target charging handler
 type Timer struct {
 	Adapter
 	log       *util.Logger
+	clck      clock.Clock
 	current   float64
 	SoC       int
 	Time      time.Time
 	finishAt  time.Time
 	active    bool
 	validated bool
+	missed    bool
 }
 
 // NewTimer creates a Timer
-func NewTimer(log *util.Logger, api Adapter) *Timer {
+func NewTimer(log *util.Logger, api Adapter, clck clock.Clock) *Timer {
 	lp := &Timer{
 		log:     log,
+		clck:    clck,
 		Adapter: api,
 	}
 
@@ -72,6 +78,7 @@ func (lp *Timer) Set(t time.Time) {
 	}
 
 	lp.Time = t
+	lp.missed = false
 
 	if lp.Time.IsZero() {
 		lp.Publish("targetTime", nil)
@@ -114,7 +121,7 @@ func (lp *Timer) DemandActive() bool {
 
 	// time
 	remainingDuration := time.Duration(float64(se.AssumedChargeDuration(lp.SoC, power)) / chargeEfficiency)
-	lp.finishAt = time.Now().Add(remainingDuration).Round(time.Minute)
+	lp.finishAt = lp.clck.Now().Add(remainingDuration).Round(time.Minute)
 
 	lp.log.DEBUG.Printf("estimated charge duration: %v to %d%% at %.0fW", remainingDuration.Round(time.Minute), lp.SoC, power)
 	if lp.active {
@@ -129,10 +136,12 @@ func (lp *Timer) DemandActive() bool {
 
 	// timer charging is already active- only deactivate once charging has stopped
 	if lp.active {
-		if time.Now().After(lp.Time) && lp.GetStatus() != api.StatusC {
+		if lp.clck.Now().After(lp.Time) && lp.GetStatus() != api.StatusC {
 			lp.Stop()
 		}
 
+		lp.checkMissed()
+
 		return lp.active
 	}
 
@@ -143,11 +152,24 @@ func (lp *Timer) DemandActive() bool {
 
 		lp.current = lp.GetMaxCurrent()
 		lp.log.INFO.Printf("target charging active for %v: projected %v (%v remaining)", lp.Time, lp.finishAt, remainingDuration.Round(time.Minute))
+
+		lp.checkMissed()
 	}
 
 	return lp.active
 }
 
+// checkMissed notifies once if the target time can't be met even when charging at maximum current
+func (lp *Timer) checkMissed() {
+	if !lp.active || lp.missed || lp.current < lp.GetMaxCurrent() || !lp.finishAt.After(lp.Time.Add(deviation)) {
+		return
+	}
+
+	lp.missed = true
+	lp.log.WARN.Printf("target charging: projected end %v misses target %v", lp.finishAt, lp.Time)
+	lp.PushEvent(evTargetMiss)
+}
+
 // Handle adjusts current up/down to achieve desired target time taking.
 func (lp *Timer) Handle() float64 {
 	action := "steady"
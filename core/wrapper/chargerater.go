@@ -33,6 +33,14 @@ func NewChargeRater(log *util.Logger, meter api.Meter) *ChargeRater {
 	}
 }
 
+// SetMeter replaces the charge meter
+func (cr *ChargeRater) SetMeter(meter api.Meter) {
+	cr.Lock()
+	defer cr.Unlock()
+
+	cr.meter = meter
+}
+
 // StartCharge records meter start energy. If meter does not supply TotalEnergy,
 // start time is recorded and  charged energy set to zero.
 func (cr *ChargeRater) StartCharge(continued bool) {
@@ -74,6 +74,7 @@ require (
 	github.com/spf13/pflag v1.0.5
 	github.com/spf13/viper v1.12.0
 	github.com/stretchr/testify v1.7.1
+	github.com/thoas/go-funk v0.9.2
 	github.com/tv42/httpunix v0.0.0-20191220191345-2ba4b9c3382c
 	github.com/volkszaehler/mbmd v0.0.0-20220528181824-7251dd80a3fb
 	github.com/writeas/go-strip-markdown v2.0.1+incompatible
@@ -151,7 +152,6 @@ require (
 	github.com/spf13/cast v1.5.0 // indirect
 	github.com/subosito/gotenv v1.4.0 // indirect
 	github.com/teivah/onecontext v1.3.0 // indirect
-	github.com/thoas/go-funk v0.9.2 // indirect
 	github.com/vmihailenco/msgpack/v5 v5.3.5 // indirect
 	github.com/vmihailenco/tagparser/v2 v2.0.0 // indirect
 	go.opencensus.io v0.23.0 // indirect
@@ -0,0 +1,148 @@
+package eebus
+
+import (
+	"github.com/evcc-io/eebus/device/entity"
+	"github.com/evcc-io/eebus/device/feature"
+	"github.com/evcc-io/eebus/spine"
+	"github.com/evcc-io/eebus/spine/model"
+	"github.com/evcc-io/evcc/server"
+)
+
+const (
+	useCaseLPC   = model.UseCaseNameType("limitationOfPowerConsumption")
+	actorControl = model.UseCaseActorType("ControllableSystem")
+)
+
+// device creates the local spine device exposing the LPC server features
+func (c *EEBus) device() spine.Device {
+	details := server.EEBusInstance.DeviceInfo()
+
+	deviceName := model.DeviceClassificationStringType(details.DeviceName)
+	deviceCode := model.DeviceClassificationStringType(details.DeviceCode)
+	brandName := model.DeviceClassificationStringType(details.BrandName)
+
+	manufacturerData := model.DeviceClassificationManufacturerDataType{
+		DeviceName: &deviceName,
+		DeviceCode: &deviceCode,
+		BrandName:  &brandName,
+		VendorName: &brandName,
+	}
+
+	dev := &spine.DeviceImpl{
+		Address: model.AddressDeviceType(details.DeviceAddress),
+		Type:    model.DeviceTypeType(model.DeviceTypeEnumTypeEnergyManagementSystem),
+	}
+
+	eid := entity.Numerator([]uint{0})
+
+	{
+		e := &spine.EntityImpl{
+			Type: model.EntityTypeType(model.EntityTypeEnumTypeDeviceInformation),
+		}
+		e.SetAddress(eid())
+		e.SetManufacturerData(manufacturerData)
+
+		fid := entity.FeatureNumerator(0)
+		for _, f := range []spine.Feature{
+			newNodeManagement(),
+			feature.NewDeviceClassificationServer(),
+		} {
+			f.SetID(fid())
+			e.Add(f)
+		}
+
+		dev.Add(e)
+	}
+
+	// the connection controller requires a CEM entity
+	{
+		e := &spine.EntityImpl{
+			Type: model.EntityTypeType(model.EntityTypeEnumTypeCEM),
+		}
+		e.SetAddress(eid())
+		e.SetManufacturerData(manufacturerData)
+		e.SetOperationState(model.DeviceDiagnosisOperatingStateType(model.DeviceDiagnosisOperatingStateEnumTypeNormalOperation))
+
+		fid := entity.FeatureNumerator(1)
+		for _, f := range []spine.Feature{
+			feature.NewDeviceDiagnosisServer(),
+			newLoadControl(c),
+			newDeviceConfiguration(c),
+		} {
+			f.SetID(fid())
+			e.Add(f)
+		}
+
+		dev.Add(e)
+	}
+
+	return dev
+}
+
+// nodeManagement announces the LPC use case instead of the library's EV charging use cases
+type nodeManagement struct {
+	*feature.NodeManagement
+}
+
+func newNodeManagement() spine.Feature {
+	return &nodeManagement{
+		NodeManagement: feature.NewNodeManagement().(*feature.NodeManagement),
+	}
+}
+
+func (f *nodeManagement) Handle(ctrl spine.Context, rf model.FeatureAddressType, op model.CmdClassifierType, cmd model.CmdType, isPartialForCmd bool) error {
+	if cmd.NodeManagementUseCaseData == nil || op != model.CmdClassifierTypeRead {
+		return f.NodeManagement.Handle(ctrl, rf, op, cmd, isPartialForCmd)
+	}
+
+	deviceAddress := f.GetEntity().GetDevice().GetAddress()
+	actor := actorControl
+	useCase := useCaseLPC
+	version := model.SpecificationVersionType("1.0.0")
+	available := true
+
+	res := model.CmdType{
+		NodeManagementUseCaseData: &model.NodeManagementUseCaseDataType{
+			UseCaseInformation: []model.UseCaseInformationDataType{
+				{
+					Address: &model.FeatureAddressType{Device: &deviceAddress},
+					Actor:   &actor,
+					UseCaseSupport: []model.UseCaseSupportType{
+						{
+							UseCaseName:      &useCase,
+							UseCaseVersion:   &version,
+							UseCaseAvailable: &available,
+							ScenarioSupport:  []model.UseCaseScenarioSupportType{1, 2, 3, 4},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	return ctrl.Reply(model.CmdClassifierTypeReply, res)
+}
+
+// notify sends cmd to all subscribers of the local server feature
+func notify(ctrl spine.Context, f spine.Feature, cmd model.CmdType) {
+	addr := f.GetAddress()
+
+	for _, sub := range ctrl.Subscriptions() {
+		if server := sub.ServerAddress; server != nil && server.Feature != nil &&
+			*server.Feature == *addr.Feature && entityEqual(server.Entity, addr.Entity) {
+			_ = ctrl.Notify(addr, sub.ClientAddress, []model.CmdType{cmd})
+		}
+	}
+}
+
+func entityEqual(a, b []model.AddressEntityType) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,135 @@
+package eebus
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/dylanmei/iso8601"
+	"github.com/evcc-io/eebus/spine"
+	"github.com/evcc-io/eebus/spine/model"
+)
+
+const (
+	failsafeLimitKeyID    = model.DeviceConfigurationKeyIdType(1)
+	failsafeDurationKeyID = model.DeviceConfigurationKeyIdType(2)
+)
+
+// deviceConfiguration is the DeviceConfiguration server feature holding the failsafe values
+type deviceConfiguration struct {
+	*spine.FeatureImpl
+	hems *EEBus
+}
+
+func newDeviceConfiguration(hems *EEBus) spine.Feature {
+	f := &deviceConfiguration{
+		FeatureImpl: &spine.FeatureImpl{
+			Type:        model.FeatureTypeEnumTypeDeviceConfiguration,
+			Role:        model.RoleTypeServer,
+			Description: "Failsafe Configuration",
+		},
+		hems: hems,
+	}
+
+	f.Add(model.FunctionEnumTypeDeviceConfigurationKeyValueDescriptionListData, true, false)
+	f.Add(model.FunctionEnumTypeDeviceConfigurationKeyValueListData, true, true)
+
+	return f
+}
+
+func (f *deviceConfiguration) descriptionListData() *model.DeviceConfigurationKeyValueDescriptionListDataType {
+	limitID, durationID := failsafeLimitKeyID, failsafeDurationKeyID
+	limitName, durationName := "failsafeConsumptionActivePowerLimit", "failsafeDurationMinimum"
+	limitType, durationType := model.DeviceConfigurationKeyValueTypeTypeScalednumber, model.DeviceConfigurationKeyValueTypeTypeDuration
+	unit := string(model.UnitOfMeasurementEnumTypeW)
+
+	return &model.DeviceConfigurationKeyValueDescriptionListDataType{
+		DeviceConfigurationKeyValueDescriptionData: []model.DeviceConfigurationKeyValueDescriptionDataType{
+			{KeyId: &limitID, KeyName: &limitName, ValueType: &limitType, Unit: &unit},
+			{KeyId: &durationID, KeyName: &durationName, ValueType: &durationType},
+		},
+	}
+}
+
+func (f *deviceConfiguration) listData() *model.DeviceConfigurationKeyValueListDataType {
+	limitID, durationID := failsafeLimitKeyID, failsafeDurationKeyID
+	changeable := true
+
+	limit, duration := f.hems.failsafe()
+	isoDuration := iso8601.FormatDuration(duration)
+
+	return &model.DeviceConfigurationKeyValueListDataType{
+		DeviceConfigurationKeyValueData: []model.DeviceConfigurationKeyValueDataType{
+			{
+				KeyId:             &limitID,
+				Value:             &model.DeviceConfigurationKeyValueValueType{ScaledNumber: model.NewScaledNumberType(limit)},
+				IsValueChangeable: &changeable,
+			},
+			{
+				KeyId:             &durationID,
+				Value:             &model.DeviceConfigurationKeyValueValueType{Duration: &isoDuration},
+				IsValueChangeable: &changeable,
+			},
+		},
+	}
+}
+
+// write applies the failsafe values written by the control box
+func (f *deviceConfiguration) write(data *model.DeviceConfigurationKeyValueListDataType) error {
+	var (
+		limit    float64
+		duration time.Duration
+	)
+
+	for _, item := range data.DeviceConfigurationKeyValueData {
+		if item.KeyId == nil || item.Value == nil {
+			continue
+		}
+
+		switch *item.KeyId {
+		case failsafeLimitKeyID:
+			if item.Value.ScaledNumber != nil {
+				limit = item.Value.ScaledNumber.GetValue()
+			}
+
+		case failsafeDurationKeyID:
+			if item.Value.Duration != nil {
+				var err error
+				if duration, err = iso8601.ParseDuration(*item.Value.Duration); err != nil {
+					return fmt.Errorf("invalid failsafe duration: %w", err)
+				}
+			}
+		}
+	}
+
+	f.hems.setFailsafe(limit, duration)
+
+	return nil
+}
+
+func (f *deviceConfiguration) Handle(ctrl spine.Context, rf model.FeatureAddressType, op model.CmdClassifierType, cmd model.CmdType, isPartialForCmd bool) error {
+	switch {
+	case cmd.DeviceConfigurationKeyValueDescriptionListData != nil && op == model.CmdClassifierTypeRead:
+		return ctrl.Reply(model.CmdClassifierTypeReply, model.CmdType{
+			DeviceConfigurationKeyValueDescriptionListData: f.descriptionListData(),
+		})
+
+	case cmd.DeviceConfigurationKeyValueListData != nil && op == model.CmdClassifierTypeRead:
+		return ctrl.Reply(model.CmdClassifierTypeReply, model.CmdType{
+			DeviceConfigurationKeyValueListData: f.listData(),
+		})
+
+	case cmd.DeviceConfigurationKeyValueListData != nil && op == model.CmdClassifierTypeWrite:
+		if err := f.write(cmd.DeviceConfigurationKeyValueListData); err != nil {
+			return err
+		}
+
+		notify(ctrl, f, model.CmdType{DeviceConfigurationKeyValueListData: f.listData()})
+		return nil
+
+	case cmd.ResultData != nil:
+		return f.HandleResultData(ctrl, op)
+
+	default:
+		return fmt.Errorf("deviceconfiguration.Handle: %s not implemented", op)
+	}
+}
@@ -0,0 +1,222 @@
+package eebus
+
+import (
+	"encoding/json"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/evcc-io/eebus/communication"
+	"github.com/evcc-io/eebus/ship"
+	"github.com/evcc-io/evcc/core/site"
+	"github.com/evcc-io/evcc/server"
+	"github.com/evcc-io/evcc/util"
+)
+
+// EEBus is an EEBus controllable system accepting limitation of power consumption (LPC)
+// requests from a control box according to §14a EnWG
+type EEBus struct {
+	mu   sync.Mutex
+	log  *util.Logger
+	site site.API
+	cc   *communication.ConnectionController
+
+	connected   bool
+	lastContact time.Time // last message from the control box, zero if none since failsafe was entered
+
+	limitActive bool      // consumption limit active
+	limit       float64   // consumption limit in W
+	limitUntil  time.Time // consumption limit end, zero if unlimited
+
+	failsafeLimit    float64       // failsafe consumption limit in W
+	failsafeDuration time.Duration // minimum failsafe duration
+	failsafeUntil    time.Time     // failsafe end, zero if not in failsafe state
+}
+
+const (
+	source           = "eebus"
+	interval         = 10 * time.Second
+	heartbeatTimeout = 2 * time.Minute // failsafe is entered if the control box stops sending
+)
+
+// New creates EEBus HEMS from generic config
+func New(other map[string]interface{}, site site.API) (*EEBus, error) {
+	cc := struct {
+		Ski      string
+		Failsafe struct {
+			Limit    float64
+			Duration time.Duration
+		}
+	}{}
+
+	cc.Failsafe.Limit = 4200
+	cc.Failsafe.Duration = 2 * time.Hour
+
+	if err := util.DecodeOther(other, &cc); err != nil {
+		return nil, err
+	}
+
+	if server.EEBusInstance == nil {
+		return nil, errors.New("eebus not configured")
+	}
+
+	c := &EEBus{
+		log:              util.NewLogger("eebus"),
+		site:             site,
+		failsafeLimit:    cc.Failsafe.Limit,
+		failsafeDuration: cc.Failsafe.Duration,
+	}
+
+	// failsafe applies until the control box sets a limit
+	c.failsafeUntil = time.Now().Add(c.failsafeDuration)
+	c.log.INFO.Printf("failsafe limit %.0fW for %v until contacted by control box", c.failsafeLimit, c.failsafeDuration)
+
+	server.EEBusInstance.Register(cc.Ski, c.onConnect, c.onDisconnect)
+
+	return c, nil
+}
+
+// Run applies the effective consumption limit to the site
+func (c *EEBus) Run() {
+	c.apply()
+
+	for range time.NewTicker(interval).C {
+		c.apply()
+	}
+}
+
+func (c *EEBus) onConnect(ski string, conn ship.Conn) error {
+	c.log.INFO.Printf("%s: connected", ski)
+
+	cc := communication.NewConnectionController(c.log.TRACE, &heartbeatConn{Conn: conn, hems: c}, c.device())
+
+	c.mu.Lock()
+	c.connected = true
+	c.lastContact = time.Now()
+	c.cc = cc
+	c.mu.Unlock()
+
+	return cc.Boot()
+}
+
+// heartbeatConn records messages received from the control box as sign of life
+type heartbeatConn struct {
+	ship.Conn
+	hems *EEBus
+}
+
+func (c *heartbeatConn) Read() (json.RawMessage, error) {
+	msg, err := c.Conn.Read()
+	if err == nil {
+		c.hems.contact(time.Now())
+	}
+	return msg, err
+}
+
+// contact records a message of the control box
+func (c *EEBus) contact(now time.Time) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.lastContact = now
+}
+
+func (c *EEBus) onDisconnect(ski string) {
+	c.mu.Lock()
+	c.connected = false
+	c.lastContact = time.Time{}
+	c.limitActive = false
+	c.failsafeUntil = time.Now().Add(c.failsafeDuration)
+	c.mu.Unlock()
+
+	c.log.WARN.Printf("%s: disconnected, failsafe limit %.0fW for %v", ski, c.failsafeLimit, c.failsafeDuration)
+
+	c.apply()
+}
+
+// consumptionLimit returns the limit state as reported to the control box
+func (c *EEBus) consumptionLimit() (bool, float64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.limitActive, c.limit
+}
+
+// setConsumptionLimit applies the control box's limit. A zero duration means unlimited.
+func (c *EEBus) setConsumptionLimit(active bool, limit float64, duration time.Duration) {
+	c.mu.Lock()
+
+	c.limitActive = active
+	c.limit = limit
+
+	c.limitUntil = time.Time{}
+	if duration > 0 {
+		c.limitUntil = time.Now().Add(duration)
+	}
+
+	// any limit update ends the failsafe state
+	c.failsafeUntil = time.Time{}
+
+	c.mu.Unlock()
+
+	if active {
+		c.log.DEBUG.Printf("consumption limit: %.0fW", limit)
+	} else {
+		c.log.DEBUG.Println("consumption limit: inactive")
+	}
+
+	c.apply()
+}
+
+// failsafe returns the failsafe configuration as reported to the control box
+func (c *EEBus) failsafe() (float64, time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.failsafeLimit, c.failsafeDuration
+}
+
+// setFailsafe updates the failsafe configuration
+func (c *EEBus) setFailsafe(limit float64, duration time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if limit > 0 {
+		c.failsafeLimit = limit
+	}
+	if duration > 0 {
+		c.failsafeDuration = duration
+	}
+
+	c.log.DEBUG.Printf("failsafe limit: %.0fW for %v", c.failsafeLimit, c.failsafeDuration)
+}
+
+// effectiveLimit returns the consumption limit in W valid at given time, zero if unlimited
+func (c *EEBus) effectiveLimit(now time.Time) float64 {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	// control box stopped sending heartbeats
+	if c.connected && !c.lastContact.IsZero() && now.Sub(c.lastContact) > heartbeatTimeout {
+		c.lastContact = time.Time{}
+		c.limitActive = false
+		c.failsafeUntil = now.Add(c.failsafeDuration)
+		c.log.WARN.Printf("heartbeat timeout, failsafe limit %.0fW for %v", c.failsafeLimit, c.failsafeDuration)
+	}
+
+	if !c.failsafeUntil.IsZero() {
+		if now.Before(c.failsafeUntil) {
+			return c.failsafeLimit
+		}
+
+		c.failsafeUntil = time.Time{}
+		c.log.INFO.Println("failsafe duration expired")
+	}
+
+	if c.limitActive && (c.limitUntil.IsZero() || now.Before(c.limitUntil)) {
+		return c.limit
+	}
+
+	return 0
+}
+
+func (c *EEBus) apply() {
+	c.site.SetPowerLimit(source, c.effectiveLimit(time.Now()))
+}
@@ -0,0 +1,55 @@
+package eebus
+
+import (
+	"testing"
+	"time"
+
+	"github.com/evcc-io/evcc/util"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEffectiveLimit(t *testing.T) {
+	c := &EEBus{
+		log:              util.NewLogger("foo"),
+		failsafeLimit:    4200,
+		failsafeDuration: 2 * time.Hour,
+	}
+
+	now := time.Now()
+	assert.Equal(t, 0.0, c.effectiveLimit(now), "unlimited")
+
+	c.limitActive, c.limit = true, 6000
+	assert.Equal(t, 6000.0, c.effectiveLimit(now), "limit")
+
+	c.limitUntil = now.Add(time.Minute)
+	assert.Equal(t, 0.0, c.effectiveLimit(now.Add(time.Hour)), "limit expired")
+
+	c.limitUntil = time.Time{}
+	c.failsafeUntil = now.Add(c.failsafeDuration)
+	assert.Equal(t, 4200.0, c.effectiveLimit(now.Add(time.Hour)), "failsafe")
+	assert.Equal(t, 6000.0, c.effectiveLimit(now.Add(3*time.Hour)), "failsafe expired")
+	assert.True(t, c.failsafeUntil.IsZero())
+}
+
+func TestHeartbeatTimeout(t *testing.T) {
+	c := &EEBus{
+		log:              util.NewLogger("foo"),
+		failsafeLimit:    4200,
+		failsafeDuration: 2 * time.Hour,
+	}
+
+	now := time.Now()
+	c.connected, c.lastContact = true, now
+	c.limitActive, c.limit = true, 6000
+
+	assert.Equal(t, 6000.0, c.effectiveLimit(now.Add(heartbeatTimeout)), "heartbeat")
+	assert.Equal(t, 4200.0, c.effectiveLimit(now.Add(heartbeatTimeout+time.Second)), "heartbeat timeout")
+	assert.False(t, c.limitActive)
+
+	// failsafe continues when heartbeats resume until a new limit is set
+	c.contact(now.Add(time.Hour))
+	assert.Equal(t, 4200.0, c.effectiveLimit(now.Add(time.Hour)))
+
+	c.contact(now.Add(3 * time.Hour))
+	assert.Equal(t, 0.0, c.effectiveLimit(now.Add(3*time.Hour)), "failsafe expired")
+}
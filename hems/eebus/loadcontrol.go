@@ -0,0 +1,128 @@
+package eebus
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/dylanmei/iso8601"
+	"github.com/evcc-io/eebus/spine"
+	"github.com/evcc-io/eebus/spine/model"
+)
+
+const lpcLimitID = model.LoadControlLimitIdType(1)
+
+// loadControl is the LoadControl server feature receiving the active power consumption limit
+type loadControl struct {
+	*spine.FeatureImpl
+	hems *EEBus
+}
+
+func newLoadControl(hems *EEBus) spine.Feature {
+	f := &loadControl{
+		FeatureImpl: &spine.FeatureImpl{
+			Type:        model.FeatureTypeEnumTypeLoadControl,
+			Role:        model.RoleTypeServer,
+			Description: "Limitation of Power Consumption",
+		},
+		hems: hems,
+	}
+
+	f.Add(model.FunctionEnumTypeLoadControlLimitDescriptionListData, true, false)
+	f.Add(model.FunctionEnumTypeLoadControlLimitListData, true, true)
+
+	return f
+}
+
+func (f *loadControl) descriptionListData() *model.LoadControlLimitDescriptionListDataType {
+	id := lpcLimitID
+	typ := model.LoadControlLimitTypeType(model.LoadControlLimitTypeEnumTypeMaxvaluelimit)
+	category := model.LoadControlCategoryType(model.LoadControlCategoryEnumTypeObligation)
+	direction := model.EnergyDirectionType(model.EnergyDirectionEnumTypeConsume)
+	unit := model.UnitOfMeasurementType(model.UnitOfMeasurementEnumTypeW)
+	scope := model.ScopeTypeType("activePowerLimit")
+
+	return &model.LoadControlLimitDescriptionListDataType{
+		LoadControlLimitDescriptionData: []model.LoadControlLimitDescriptionDataType{{
+			LimitId:        &id,
+			LimitType:      &typ,
+			LimitCategory:  &category,
+			LimitDirection: &direction,
+			Unit:           &unit,
+			ScopeType:      &scope,
+		}},
+	}
+}
+
+func (f *loadControl) listData() *model.LoadControlLimitListDataType {
+	id := lpcLimitID
+	changeable := true
+	active, limit := f.hems.consumptionLimit()
+
+	return &model.LoadControlLimitListDataType{
+		LoadControlLimitData: []model.LoadControlLimitDataType{{
+			LimitId:           &id,
+			IsLimitChangeable: &changeable,
+			IsLimitActive:     &active,
+			Value:             model.NewScaledNumberType(limit),
+		}},
+	}
+}
+
+// write applies the limit written by the control box
+func (f *loadControl) write(data *model.LoadControlLimitListDataType) error {
+	for _, item := range data.LoadControlLimitData {
+		if item.LimitId == nil || *item.LimitId != lpcLimitID {
+			continue
+		}
+
+		active, limit := f.hems.consumptionLimit()
+
+		if item.IsLimitActive != nil {
+			active = *item.IsLimitActive
+		}
+
+		if item.Value != nil {
+			limit = item.Value.GetValue()
+		}
+
+		var duration time.Duration
+		if tp := item.TimePeriod; tp != nil && tp.EndTime != nil {
+			var err error
+			if duration, err = iso8601.ParseDuration(*tp.EndTime); err != nil {
+				return fmt.Errorf("invalid limit duration: %w", err)
+			}
+		}
+
+		f.hems.setConsumptionLimit(active, limit, duration)
+	}
+
+	return nil
+}
+
+func (f *loadControl) Handle(ctrl spine.Context, rf model.FeatureAddressType, op model.CmdClassifierType, cmd model.CmdType, isPartialForCmd bool) error {
+	switch {
+	case cmd.LoadControlLimitDescriptionListData != nil && op == model.CmdClassifierTypeRead:
+		return ctrl.Reply(model.CmdClassifierTypeReply, model.CmdType{
+			LoadControlLimitDescriptionListData: f.descriptionListData(),
+		})
+
+	case cmd.LoadControlLimitListData != nil && op == model.CmdClassifierTypeRead:
+		return ctrl.Reply(model.CmdClassifierTypeReply, model.CmdType{
+			LoadControlLimitListData: f.listData(),
+		})
+
+	case cmd.LoadControlLimitListData != nil && op == model.CmdClassifierTypeWrite:
+		if err := f.write(cmd.LoadControlLimitListData); err != nil {
+			return err
+		}
+
+		notify(ctrl, f, model.CmdType{LoadControlLimitListData: f.listData()})
+		return nil
+
+	case cmd.ResultData != nil:
+		return f.HandleResultData(ctrl, op)
+
+	default:
+		return fmt.Errorf("loadcontrol.Handle: %s not implemented", op)
+	}
+}
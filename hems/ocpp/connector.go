@@ -0,0 +1,69 @@
+package ocpp
+
+import (
+	"time"
+
+	"github.com/evcc-io/evcc/api"
+	"github.com/evcc-io/evcc/core/loadpoint"
+	"github.com/lorenzodonini/ocpp-go/ocpp1.6/core"
+)
+
+// connector is the OCPP representation of a loadpoint
+type connector struct {
+	id int
+	lp loadpoint.API
+
+	status   core.ChargePointStatus // last reported status
+	register float64                // energy register in Wh
+	charged  float64                // last loadpoint charged energy in Wh
+
+	idTag     string      // pending authorization from remote start
+	txn       int         // active transaction id
+	txnStart  time.Time   // active transaction start
+	stop      core.Reason // pending transaction stop
+	blocked   bool        // no transaction until vehicle disconnects
+	lastMeter time.Time
+	profiles  profiles
+	limit     float64 // applied current limit
+	limited   bool
+}
+
+// meter updates the energy register from the loadpoint's charged energy which is reset when the vehicle connects
+func (c *connector) meter(charged float64) {
+	delta := charged - c.charged
+	if delta < 0 {
+		delta = charged
+	}
+
+	c.register += delta
+	c.charged = charged
+}
+
+// chargePointStatus maps the loadpoint status to the OCPP connector status
+func (c *connector) chargePointStatus(status api.ChargeStatus, mode api.ChargeMode) (core.ChargePointStatus, core.ChargePointErrorCode) {
+	switch status {
+	case api.StatusA:
+		return core.ChargePointStatusAvailable, core.NoError
+
+	case api.StatusB:
+		switch {
+		case c.txn == 0 && c.blocked:
+			return core.ChargePointStatusFinishing, core.NoError
+		case c.txn == 0:
+			return core.ChargePointStatusPreparing, core.NoError
+		case mode == api.ModeOff || c.limited && c.limit == 0:
+			return core.ChargePointStatusSuspendedEVSE, core.NoError
+		default:
+			return core.ChargePointStatusSuspendedEV, core.NoError
+		}
+
+	case api.StatusC, api.StatusD:
+		return core.ChargePointStatusCharging, core.NoError
+
+	case api.StatusE, api.StatusF:
+		return core.ChargePointStatusFaulted, core.OtherError
+
+	default:
+		return core.ChargePointStatusUnavailable, core.NoError
+	}
+}
@@ -2,41 +2,70 @@ package ocpp
 
 import (
 	"fmt"
+	"math"
 	"math/rand"
+	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/evcc-io/evcc/api"
+	"github.com/evcc-io/evcc/core/loadpoint"
 	"github.com/evcc-io/evcc/core/site"
 	"github.com/evcc-io/evcc/hems/ocpp/profile"
 	"github.com/evcc-io/evcc/util"
 
 	"github.com/denisbrodbeck/machineid"
 	ocpp16 "github.com/lorenzodonini/ocpp-go/ocpp1.6"
-	ocppcore "github.com/lorenzodonini/ocpp-go/ocpp1.6/core"
+	"github.com/lorenzodonini/ocpp-go/ocpp1.6/core"
+	"github.com/lorenzodonini/ocpp-go/ocpp1.6/types"
 	"github.com/lorenzodonini/ocpp-go/ws"
 )
 
-// OCPP is an OCPP client
+// OCPP is an OCPP client exposing each loadpoint as charge point connector
 type OCPP struct {
-	log  *util.Logger
-	site site.API
-	cp   ocpp16.ChargePoint
+	mu            sync.Mutex
+	log           *util.Logger
+	cp            ocpp16.ChargePoint
+	idTag         string
+	mode          api.ChargeMode
+	meterInterval time.Duration
+	heartbeat     time.Duration
+	connectors    []*connector
+	profiles      profiles // charge point wide profiles (connector 0)
+	updateC       chan struct{}
+	file          string // state file, empty to disable persistence
 }
 
-const retryTimeout = 5 * time.Second
+const (
+	retryTimeout = 5 * time.Second
+	remoteSource = "ocpp"
+	voltage      = 230
+)
 
 // New generates OCPP chargepoint client
 func New(conf map[string]interface{}, site site.API) (*OCPP, error) {
-	var cc struct {
-		URI       string
-		StationID string
+	cc := struct {
+		URI           string
+		StationID     string
+		IdTag         string
+		Mode          string
+		MeterInterval time.Duration
+		File          string // state file, default ~/.evcc/ocpp.json
+	}{
+		Mode:          string(api.ModeNow),
+		MeterInterval: time.Minute,
 	}
 
 	if err := util.DecodeOther(conf, &cc); err != nil {
 		return nil, err
 	}
 
+	mode, err := api.ChargeModeString(cc.Mode)
+	if err != nil {
+		return nil, err
+	}
+
 	log := util.NewLogger("ocpp")
 
 	if cc.StationID == "" {
@@ -49,25 +78,46 @@ func New(conf map[string]interface{}, site site.API) (*OCPP, error) {
 		log.DEBUG.Println("station id:", cc.StationID)
 	}
 
+	if cc.File == "" {
+		if cc.File, err = DefaultFile(); err != nil {
+			return nil, err
+		}
+	}
+
 	ws := ws.NewClient()
 	cp := ocpp16.NewChargePoint(cc.StationID, nil, ws)
 
 	s := &OCPP{
-		log:  log,
-		site: site,
-		cp:   cp,
+		log:           log,
+		cp:            cp,
+		idTag:         cc.IdTag,
+		mode:          mode,
+		meterInterval: cc.MeterInterval,
+		heartbeat:     time.Hour,
+		updateC:       make(chan struct{}, 1),
+		file:          cc.File,
+	}
+
+	for id, lp := range site.LoadPoints() {
+		s.connectors = append(s.connectors, &connector{id: id + 1, lp: lp})
+	}
+
+	// energy registers must not go backwards and open transactions are continued
+	if err := s.load(); err != nil {
+		return nil, fmt.Errorf("%s: %w", cc.File, err)
 	}
 
-	err := cp.Start(cc.URI)
-	if err == nil {
-		cp.SetCoreHandler(profile.NewCore(log, profile.GetDefaultConfig()))
-		cp.SetSmartChargingHandler(profile.NewSmartCharging(log))
+	cp.SetCoreHandler(profile.NewCore(log, profile.GetDefaultConfig(len(s.connectors), cc.MeterInterval), s))
+	cp.SetSmartChargingHandler(profile.NewSmartCharging(log, s))
 
-		go s.errorHandler(ws.Errors())
-		go s.errorHandler(cp.Errors())
+	if err := cp.Start(cc.URI); err != nil {
+		return nil, err
 	}
 
-	return s, err
+	go s.errorHandler(ws.Errors())
+	go s.errorHandler(cp.Errors())
+
+	return s, nil
 }
 
 // errorHandler logs error channel
@@ -79,21 +129,405 @@ func (s *OCPP) errorHandler(errC <-chan error) {
 
 // Run executes the OCPP chargepoint client
 func (s *OCPP) Run() {
+	s.boot()
+
+	tick := time.NewTicker(retryTimeout)
+	heartbeat := time.NewTicker(s.heartbeat)
+
 	for {
-		for id, lp := range s.site.LoadPoints() {
-			connector := id + 1
+		for _, c := range s.connectors {
+			s.update(c)
+		}
 
-			status := ocppcore.ChargePointStatusAvailable
-			if lp.GetStatus() == api.StatusC {
-				status = ocppcore.ChargePointStatusCharging
+		select {
+		case <-tick.C:
+		case <-s.updateC:
+		case <-heartbeat.C:
+			if _, err := s.cp.Heartbeat(); err != nil {
+				s.log.ERROR.Printf("heartbeat: %v", err)
 			}
+		}
+	}
+}
 
-			s.log.DEBUG.Printf("send: lp-%d status: %+v", connector, status)
-			if _, err := s.cp.StatusNotification(connector, ocppcore.NoError, status); err != nil {
-				s.log.ERROR.Printf("lp-%d: %v", connector, err)
+// boot registers the charge point with the central system
+func (s *OCPP) boot() {
+	for {
+		res, err := s.cp.BootNotification("evcc", "evcc.io")
+		if err == nil && res.Status == core.RegistrationStatusAccepted {
+			if res.Interval > 0 {
+				s.heartbeat = time.Duration(res.Interval) * time.Second
 			}
+			return
 		}
 
-		time.Sleep(retryTimeout)
+		delay := retryTimeout
+		if err != nil {
+			s.log.ERROR.Printf("boot: %v", err)
+		} else {
+			s.log.WARN.Printf("boot: %s", res.Status)
+			if res.Interval > 0 {
+				delay = time.Duration(res.Interval) * time.Second
+			}
+		}
+
+		time.Sleep(delay)
+	}
+}
+
+// requestUpdate triggers immediate connector updates
+func (s *OCPP) requestUpdate() {
+	select {
+	case s.updateC <- struct{}{}:
+	default:
 	}
 }
+
+// update synchronizes connector state with the loadpoint and the central system
+func (s *OCPP) update(c *connector) {
+	status := c.lp.GetStatus()
+	mode := c.lp.GetMode()
+	power := c.lp.GetChargePower()
+	charged := c.lp.GetChargedEnergy()
+	now := time.Now()
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	c.meter(charged)
+
+	connected := status == api.StatusB || status == api.StatusC || status == api.StatusD
+	if !connected {
+		c.blocked = false
+	}
+
+	switch {
+	case c.txn != 0 && (!connected || c.stop != ""):
+		s.stopTransaction(c, now)
+
+	case c.txn == 0 && connected && !c.blocked && (c.idTag != "" || s.idTag != ""):
+		s.startTransaction(c, now)
+	}
+
+	s.applyLimit(c, now)
+
+	if cps, code := c.chargePointStatus(status, mode); cps != c.status {
+		if err := s.unlocked(func() error {
+			_, err := s.cp.StatusNotification(c.id, code, cps)
+			return err
+		}); err != nil {
+			s.log.ERROR.Printf("lp-%d: status: %v", c.id, err)
+		} else {
+			s.log.DEBUG.Printf("lp-%d: status: %s", c.id, cps)
+			c.status = cps
+		}
+	}
+
+	if c.txn != 0 && s.meterInterval > 0 && now.Sub(c.lastMeter) >= s.meterInterval {
+		s.meterValues(c, power, now)
+	}
+}
+
+// unlocked executes fn without holding the lock, allowing central system requests to be handled meanwhile
+func (s *OCPP) unlocked(fn func() error) error {
+	s.mu.Unlock()
+	defer s.mu.Lock()
+	return fn()
+}
+
+func (s *OCPP) startTransaction(c *connector, now time.Time) {
+	idTag := c.idTag
+	if idTag == "" {
+		idTag = s.idTag
+	}
+
+	var res *core.StartTransactionConfirmation
+	if err := s.unlocked(func() (err error) {
+		res, err = s.cp.StartTransaction(c.id, idTag, int(math.Round(c.register)), types.NewDateTime(now))
+		return err
+	}); err != nil {
+		s.log.ERROR.Printf("lp-%d: start transaction: %v", c.id, err)
+		return
+	}
+
+	c.txn, c.txnStart, c.idTag = res.TransactionId, now, ""
+	c.lastMeter = now
+	s.save()
+
+	if res.IdTagInfo == nil || res.IdTagInfo.Status != types.AuthorizationStatusAccepted {
+		s.log.WARN.Printf("lp-%d: transaction %d not authorized: %+v", c.id, c.txn, res.IdTagInfo)
+		c.stop, c.blocked = core.ReasonDeAuthorized, true
+		return
+	}
+
+	s.log.DEBUG.Printf("lp-%d: started transaction %d", c.id, c.txn)
+}
+
+func (s *OCPP) stopTransaction(c *connector, now time.Time) {
+	reason := c.stop
+	if reason == "" {
+		reason = core.ReasonEVDisconnected
+	}
+
+	txn := c.txn
+	if err := s.unlocked(func() error {
+		_, err := s.cp.StopTransaction(int(math.Round(c.register)), types.NewDateTime(now), txn, func(request *core.StopTransactionRequest) {
+			request.Reason = reason
+		})
+		return err
+	}); err != nil {
+		s.log.ERROR.Printf("lp-%d: stop transaction: %v", c.id, err)
+		return
+	}
+
+	s.log.DEBUG.Printf("lp-%d: stopped transaction %d: %s", c.id, txn, reason)
+
+	c.txn, c.txnStart, c.stop = 0, time.Time{}, ""
+	c.profiles.clear(nil, types.ChargingProfilePurposeTxProfile, nil)
+	s.save()
+}
+
+func (s *OCPP) meterValues(c *connector, power float64, now time.Time) {
+	meterValues := []types.MeterValue{{
+		Timestamp: types.NewDateTime(now),
+		SampledValue: []types.SampledValue{
+			{
+				Value:     strconv.FormatFloat(c.register, 'f', 0, 64),
+				Measurand: types.MeasurandEnergyActiveImportRegister,
+				Unit:      types.UnitOfMeasureWh,
+			},
+			{
+				Value:     strconv.FormatFloat(power, 'f', 0, 64),
+				Measurand: types.MeasurandPowerActiveImport,
+				Unit:      types.UnitOfMeasureW,
+			},
+		},
+	}}
+
+	txn := c.txn
+	if err := s.unlocked(func() error {
+		_, err := s.cp.MeterValues(c.id, meterValues, func(request *core.MeterValuesRequest) {
+			request.TransactionId = &txn
+		})
+		return err
+	}); err != nil {
+		s.log.ERROR.Printf("lp-%d: meter values: %v", c.id, err)
+		return
+	}
+
+	c.lastMeter = now
+	s.save()
+}
+
+// currentLimit returns the current limit in A resulting from the installed charging profiles
+func (s *OCPP) currentLimit(c *connector, now time.Time) (float64, bool) {
+	var (
+		res float64
+		ok  bool
+	)
+
+	apply := func(p profiles, purpose types.ChargingProfilePurposeType) bool {
+		unit, period, found := p.active(purpose, now, c.txnStart)
+		if !found {
+			return false
+		}
+
+		limit := period.Limit
+		if unit == types.ChargingRateUnitWatts {
+			phases := c.lp.GetPhases()
+			if period.NumberPhases != nil {
+				phases = *period.NumberPhases
+			}
+			if phases == 0 {
+				phases = 3
+			}
+
+			limit /= voltage * float64(phases)
+		}
+
+		if !ok || limit < res {
+			res, ok = limit, true
+		}
+
+		return true
+	}
+
+	apply(s.profiles, types.ChargingProfilePurposeChargePointMaxProfile)
+
+	// transaction profiles override default profiles
+	if c.txn == 0 || !apply(c.profiles, types.ChargingProfilePurposeTxProfile) {
+		if !apply(c.profiles, types.ChargingProfilePurposeTxDefaultProfile) {
+			apply(s.profiles, types.ChargingProfilePurposeTxDefaultProfile)
+		}
+	}
+
+	return res, ok
+}
+
+// applyLimit applies the charging profile limit as loadpoint power limit.
+// Limits below the loadpoint's min current and blocked connectors disable charging.
+func (s *OCPP) applyLimit(c *connector, now time.Time) {
+	limit, ok := s.currentLimit(c, now)
+
+	// remotely stopped or unauthorized transactions block charging until the vehicle disconnects
+	if c.blocked {
+		limit, ok = 0, true
+	}
+
+	switch {
+	case ok && (!c.limited || limit != c.limit):
+		if limit < c.lp.GetMinCurrent() {
+			c.lp.RemoteControl(remoteSource, loadpoint.RemoteHardDisable)
+			c.lp.SetPowerLimit(remoteSource, 0)
+			limit = 0
+		} else {
+			phases := c.lp.GetPhases()
+			if phases == 0 {
+				phases = 3
+			}
+
+			c.lp.RemoteControl(remoteSource, loadpoint.RemoteEnable)
+			c.lp.SetPowerLimit(remoteSource, limit*voltage*float64(phases))
+		}
+
+		s.log.DEBUG.Printf("lp-%d: charging profile limit: %.1fA", c.id, limit)
+		c.limit, c.limited = limit, true
+
+	case !ok && c.limited:
+		c.lp.RemoteControl(remoteSource, loadpoint.RemoteEnable)
+		c.lp.SetPowerLimit(remoteSource, 0)
+
+		s.log.DEBUG.Printf("lp-%d: charging profile limit removed", c.id)
+		c.limit, c.limited = 0, false
+	}
+}
+
+// connector returns the connector with given id
+func (s *OCPP) connector(id int) *connector {
+	if id < 1 || id > len(s.connectors) {
+		return nil
+	}
+	return s.connectors[id-1]
+}
+
+// RemoteStart implements the profile.Transactions interface
+func (s *OCPP) RemoteStart(id int, idTag string, profile *types.ChargingProfile) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	c := s.connector(id)
+
+	// select first idle connector, preferring connected vehicles
+	if id == 0 {
+		for _, cc := range s.connectors {
+			if cc.txn != 0 || cc.idTag != "" {
+				continue
+			}
+
+			if c == nil || c.status == core.ChargePointStatusAvailable && cc.status != core.ChargePointStatusAvailable {
+				c = cc
+			}
+		}
+	}
+
+	if c == nil || c.txn != 0 {
+		return false
+	}
+
+	if profile != nil {
+		if profile.ChargingProfilePurpose != types.ChargingProfilePurposeTxProfile {
+			return false
+		}
+		c.profiles.set(profile)
+	}
+
+	c.idTag, c.blocked = idTag, false
+
+	if c.lp.GetMode() == api.ModeOff {
+		c.lp.SetMode(s.mode)
+	}
+
+	s.requestUpdate()
+
+	return true
+}
+
+// RemoteStop implements the profile.Transactions interface
+func (s *OCPP) RemoteStop(txn int) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, c := range s.connectors {
+		if c.txn == txn {
+			// charging is blocked by the connector, the user's mode is kept
+			c.stop, c.blocked = core.ReasonRemote, true
+
+			s.requestUpdate()
+
+			return true
+		}
+	}
+
+	return false
+}
+
+// SetChargingProfile implements the profile.Limiter interface
+func (s *OCPP) SetChargingProfile(id int, profile *types.ChargingProfile) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if id == 0 {
+		if profile.ChargingProfilePurpose == types.ChargingProfilePurposeTxProfile {
+			return false
+		}
+
+		s.profiles.set(profile)
+		s.requestUpdate()
+
+		return true
+	}
+
+	c := s.connector(id)
+	if c == nil {
+		return false
+	}
+
+	switch profile.ChargingProfilePurpose {
+	case types.ChargingProfilePurposeChargePointMaxProfile:
+		return false
+
+	case types.ChargingProfilePurposeTxProfile:
+		if c.txn == 0 || profile.TransactionId != 0 && profile.TransactionId != c.txn {
+			return false
+		}
+	}
+
+	c.profiles.set(profile)
+	s.requestUpdate()
+
+	return true
+}
+
+// ClearChargingProfile implements the profile.Limiter interface
+func (s *OCPP) ClearChargingProfile(id, connector *int, purpose types.ChargingProfilePurposeType, stackLevel *int) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var removed bool
+
+	if connector == nil || *connector == 0 {
+		removed = s.profiles.clear(id, purpose, stackLevel)
+	}
+
+	for _, c := range s.connectors {
+		if connector == nil || *connector == c.id {
+			removed = c.profiles.clear(id, purpose, stackLevel) || removed
+		}
+	}
+
+	if removed {
+		s.requestUpdate()
+	}
+
+	return removed
+}
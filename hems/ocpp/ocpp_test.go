@@ -0,0 +1,65 @@
+package ocpp
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/evcc-io/evcc/api"
+	"github.com/evcc-io/evcc/core/loadpoint"
+	"github.com/evcc-io/evcc/util"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testLoadpoint struct {
+	loadpoint.API
+	mode   api.ChargeMode
+	demand loadpoint.RemoteDemand
+}
+
+func (lp *testLoadpoint) GetMode() api.ChargeMode                          { return lp.mode }
+func (lp *testLoadpoint) SetMode(mode api.ChargeMode)                      { lp.mode = mode }
+func (lp *testLoadpoint) GetMinCurrent() float64                           { return 6 }
+func (lp *testLoadpoint) GetPhases() int                                   { return 3 }
+func (lp *testLoadpoint) SetPowerLimit(string, float64)                    {}
+func (lp *testLoadpoint) RemoteControl(_ string, d loadpoint.RemoteDemand) { lp.demand = d }
+
+func TestRemoteStopKeepsMode(t *testing.T) {
+	lp := &testLoadpoint{mode: api.ModePV}
+	s := &OCPP{log: util.NewLogger("foo"), mode: api.ModeNow, updateC: make(chan struct{}, 1)}
+	s.connectors = []*connector{{id: 1, lp: lp, txn: 42}}
+
+	require.True(t, s.RemoteStop(42))
+	s.applyLimit(s.connectors[0], time.Now())
+
+	assert.Equal(t, api.ModePV, lp.mode)
+	assert.Equal(t, loadpoint.RemoteHardDisable, lp.demand)
+
+	// remote start releases the connector
+	s.connectors[0].txn = 0
+	require.True(t, s.RemoteStart(1, "tag", nil))
+	s.applyLimit(s.connectors[0], time.Now())
+
+	assert.Equal(t, api.ModePV, lp.mode)
+	assert.Equal(t, loadpoint.RemoteEnable, lp.demand)
+}
+
+func TestState(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "ocpp.json")
+	start := time.Now().Truncate(time.Second)
+
+	s := &OCPP{log: util.NewLogger("foo"), file: file}
+	s.connectors = []*connector{{id: 1, register: 1234, txn: 42, txnStart: start}, {id: 2, register: 10}}
+	s.Shutdown()
+
+	s = &OCPP{log: util.NewLogger("foo"), file: file}
+	s.connectors = []*connector{{id: 1}, {id: 2}}
+	require.NoError(t, s.load())
+
+	assert.Equal(t, 1234.0, s.connectors[0].register)
+	assert.Equal(t, 42, s.connectors[0].txn)
+	assert.True(t, start.Equal(s.connectors[0].txnStart))
+	assert.Equal(t, 10.0, s.connectors[1].register)
+	assert.Equal(t, 0, s.connectors[1].txn)
+}
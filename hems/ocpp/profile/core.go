@@ -6,15 +6,23 @@ import (
 	"github.com/lorenzodonini/ocpp-go/ocpp1.6/types"
 )
 
+// Transactions starts and stops transactions on request of the central system
+type Transactions interface {
+	RemoteStart(connector int, idTag string, profile *types.ChargingProfile) bool
+	RemoteStop(transactionID int) bool
+}
+
 type Core struct {
 	log           *util.Logger
 	configuration ConfigMap
+	transactions  Transactions
 }
 
-func NewCore(log *util.Logger, config ConfigMap) *Core {
+func NewCore(log *util.Logger, config ConfigMap, transactions Transactions) *Core {
 	return &Core{
 		log:           log,
 		configuration: config,
+		transactions:  transactions,
 	}
 }
 
@@ -33,11 +41,28 @@ func (s *Core) OnUnlockConnector(request *core.UnlockConnectorRequest) (confirma
 // OnRemoteStartTransaction handles the CS message
 func (s *Core) OnRemoteStartTransaction(request *core.RemoteStartTransactionRequest) (confirmation *core.RemoteStartTransactionConfirmation, err error) {
 	s.log.TRACE.Printf("recv: %s %+v", request.GetFeatureName(), request)
-	return core.NewRemoteStartTransactionConfirmation(types.RemoteStartStopStatusRejected), nil
+
+	var connector int
+	if request.ConnectorId != nil {
+		connector = *request.ConnectorId
+	}
+
+	status := types.RemoteStartStopStatusRejected
+	if s.transactions.RemoteStart(connector, request.IdTag, request.ChargingProfile) {
+		status = types.RemoteStartStopStatusAccepted
+	}
+
+	return core.NewRemoteStartTransactionConfirmation(status), nil
 }
 
 // OnRemoteStopTransaction handles the CS message
 func (s *Core) OnRemoteStopTransaction(request *core.RemoteStopTransactionRequest) (confirmation *core.RemoteStopTransactionConfirmation, err error) {
 	s.log.TRACE.Printf("recv: %s %+v", request.GetFeatureName(), request)
-	return core.NewRemoteStopTransactionConfirmation(types.RemoteStartStopStatusRejected), nil
+
+	status := types.RemoteStartStopStatusRejected
+	if s.transactions.RemoteStop(request.TransactionId) {
+		status = types.RemoteStartStopStatusAccepted
+	}
+
+	return core.NewRemoteStopTransactionConfirmation(status), nil
 }
@@ -3,28 +3,49 @@ package profile
 import (
 	"github.com/evcc-io/evcc/util"
 	sc "github.com/lorenzodonini/ocpp-go/ocpp1.6/smartcharging"
+	"github.com/lorenzodonini/ocpp-go/ocpp1.6/types"
 )
 
+// Limiter applies charging profiles received from the central system
+type Limiter interface {
+	SetChargingProfile(connector int, profile *types.ChargingProfile) bool
+	ClearChargingProfile(id, connector *int, purpose types.ChargingProfilePurposeType, stackLevel *int) bool
+}
+
 type SmartCharging struct {
-	log *util.Logger
+	log     *util.Logger
+	limiter Limiter
 }
 
-func NewSmartCharging(log *util.Logger) *SmartCharging {
+func NewSmartCharging(log *util.Logger, limiter Limiter) *SmartCharging {
 	return &SmartCharging{
-		log: log,
+		log:     log,
+		limiter: limiter,
 	}
 }
 
 // OnSetChargingProfile handles the CS message
 func (s *SmartCharging) OnSetChargingProfile(request *sc.SetChargingProfileRequest) (confirmation *sc.SetChargingProfileConfirmation, err error) {
 	s.log.TRACE.Printf("recv: %s %+v", request.GetFeatureName(), request)
-	return sc.NewSetChargingProfileConfirmation(sc.ChargingProfileStatusRejected), nil
+
+	status := sc.ChargingProfileStatusRejected
+	if s.limiter.SetChargingProfile(request.ConnectorId, request.ChargingProfile) {
+		status = sc.ChargingProfileStatusAccepted
+	}
+
+	return sc.NewSetChargingProfileConfirmation(status), nil
 }
 
 // OnClearChargingProfile handles the CS message
 func (s *SmartCharging) OnClearChargingProfile(request *sc.ClearChargingProfileRequest) (confirmation *sc.ClearChargingProfileConfirmation, err error) {
 	s.log.TRACE.Printf("recv: %s %+v", request.GetFeatureName(), request)
-	return sc.NewClearChargingProfileConfirmation(sc.ClearChargingProfileStatusUnknown), nil
+
+	status := sc.ClearChargingProfileStatusUnknown
+	if s.limiter.ClearChargingProfile(request.Id, request.ConnectorId, request.ChargingProfilePurpose, request.StackLevel) {
+		status = sc.ClearChargingProfileStatusAccepted
+	}
+
+	return sc.NewClearChargingProfileConfirmation(status), nil
 }
 
 // OnGetCompositeSchedule handles the CS message
@@ -0,0 +1,132 @@
+package ocpp
+
+import (
+	"sort"
+	"time"
+
+	"github.com/lorenzodonini/ocpp-go/ocpp1.6/types"
+)
+
+// profiles is a set of charging profiles installed on a connector
+type profiles []*types.ChargingProfile
+
+// set installs profile, replacing profiles with same id or same purpose and stack level
+func (p *profiles) set(profile *types.ChargingProfile) {
+	res := (*p)[:0]
+	for _, pp := range *p {
+		if pp.ChargingProfileId == profile.ChargingProfileId ||
+			pp.ChargingProfilePurpose == profile.ChargingProfilePurpose && pp.StackLevel == profile.StackLevel {
+			continue
+		}
+		res = append(res, pp)
+	}
+
+	*p = append(res, profile)
+}
+
+// clear removes all profiles matching the given criteria. Returns true if any profile was removed.
+func (p *profiles) clear(id *int, purpose types.ChargingProfilePurposeType, stackLevel *int) bool {
+	var removed bool
+
+	res := (*p)[:0]
+	for _, pp := range *p {
+		if (id == nil || pp.ChargingProfileId == *id) &&
+			(purpose == "" || pp.ChargingProfilePurpose == purpose) &&
+			(stackLevel == nil || pp.StackLevel == *stackLevel) {
+			removed = true
+			continue
+		}
+		res = append(res, pp)
+	}
+
+	*p = res
+
+	return removed
+}
+
+// active returns the active schedule period of the highest stack level profile of given purpose.
+// Relative profiles are evaluated against the transaction start.
+func (p profiles) active(purpose types.ChargingProfilePurposeType, now, txStart time.Time) (types.ChargingRateUnitType, types.ChargingSchedulePeriod, bool) {
+	candidates := make(profiles, 0, len(p))
+	for _, pp := range p {
+		if pp.ChargingProfilePurpose == purpose {
+			candidates = append(candidates, pp)
+		}
+	}
+
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return candidates[i].StackLevel > candidates[j].StackLevel
+	})
+
+	for _, profile := range candidates {
+		if period, ok := activePeriod(profile, now, txStart); ok {
+			return profile.ChargingSchedule.ChargingRateUnit, period, true
+		}
+	}
+
+	return "", types.ChargingSchedulePeriod{}, false
+}
+
+// activePeriod returns the profile's schedule period valid at now
+func activePeriod(profile *types.ChargingProfile, now, txStart time.Time) (types.ChargingSchedulePeriod, bool) {
+	schedule := profile.ChargingSchedule
+	if schedule == nil || len(schedule.ChargingSchedulePeriod) == 0 {
+		return types.ChargingSchedulePeriod{}, false
+	}
+
+	if profile.ValidFrom != nil && now.Before(profile.ValidFrom.Time) ||
+		profile.ValidTo != nil && !now.Before(profile.ValidTo.Time) {
+		return types.ChargingSchedulePeriod{}, false
+	}
+
+	var start time.Time
+	switch profile.ChargingProfileKind {
+	case types.ChargingProfileKindRelative:
+		if txStart.IsZero() {
+			return types.ChargingSchedulePeriod{}, false
+		}
+		start = txStart
+
+	case types.ChargingProfileKindRecurring:
+		if schedule.StartSchedule == nil {
+			return types.ChargingSchedulePeriod{}, false
+		}
+
+		recurrence := 24 * time.Hour
+		if profile.RecurrencyKind == types.RecurrencyKindWeekly {
+			recurrence *= 7
+		}
+
+		start = schedule.StartSchedule.Time
+		if now.After(start) {
+			start = start.Add(now.Sub(start) / recurrence * recurrence)
+		}
+
+	default:
+		if schedule.StartSchedule != nil {
+			start = schedule.StartSchedule.Time
+		} else if !txStart.IsZero() {
+			start = txStart
+		} else {
+			start = now
+		}
+	}
+
+	elapsed := now.Sub(start)
+	if elapsed < 0 || schedule.Duration != nil && elapsed >= time.Duration(*schedule.Duration)*time.Second {
+		return types.ChargingSchedulePeriod{}, false
+	}
+
+	var (
+		res types.ChargingSchedulePeriod
+		ok  bool
+	)
+
+	for _, period := range schedule.ChargingSchedulePeriod {
+		if time.Duration(period.StartPeriod)*time.Second <= elapsed && (!ok || period.StartPeriod >= res.StartPeriod) {
+			res, ok = period, true
+		}
+	}
+
+	return res, ok
+}
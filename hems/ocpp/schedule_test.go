@@ -0,0 +1,99 @@
+package ocpp
+
+import (
+	"testing"
+	"time"
+
+	"github.com/lorenzodonini/ocpp-go/ocpp1.6/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func chargingProfile(id, stackLevel int, kind types.ChargingProfileKindType, start *time.Time, periods ...types.ChargingSchedulePeriod) *types.ChargingProfile {
+	profile := &types.ChargingProfile{
+		ChargingProfileId:      id,
+		StackLevel:             stackLevel,
+		ChargingProfilePurpose: types.ChargingProfilePurposeTxDefaultProfile,
+		ChargingProfileKind:    kind,
+		ChargingSchedule: &types.ChargingSchedule{
+			ChargingRateUnit:       types.ChargingRateUnitAmperes,
+			ChargingSchedulePeriod: periods,
+		},
+	}
+
+	if start != nil {
+		profile.ChargingSchedule.StartSchedule = types.NewDateTime(*start)
+	}
+
+	return profile
+}
+
+func TestProfilesActive(t *testing.T) {
+	now := time.Date(2022, 1, 1, 12, 0, 0, 0, time.UTC)
+	start := now.Add(-time.Hour)
+
+	tc := []struct {
+		name     string
+		profiles profiles
+		txStart  time.Time
+		limit    float64
+		ok       bool
+	}{
+		{"none", nil, time.Time{}, 0, false},
+		{"absolute", profiles{
+			chargingProfile(1, 0, types.ChargingProfileKindAbsolute, &start,
+				types.ChargingSchedulePeriod{StartPeriod: 0, Limit: 16},
+				types.ChargingSchedulePeriod{StartPeriod: 1800, Limit: 10},
+				types.ChargingSchedulePeriod{StartPeriod: 7200, Limit: 6},
+			),
+		}, time.Time{}, 10, true},
+		{"not started", profiles{
+			chargingProfile(1, 0, types.ChargingProfileKindAbsolute, &now,
+				types.ChargingSchedulePeriod{StartPeriod: 3600, Limit: 16},
+			),
+		}, time.Time{}, 0, false},
+		{"relative without transaction", profiles{
+			chargingProfile(1, 0, types.ChargingProfileKindRelative, nil,
+				types.ChargingSchedulePeriod{StartPeriod: 0, Limit: 16},
+			),
+		}, time.Time{}, 0, false},
+		{"relative", profiles{
+			chargingProfile(1, 0, types.ChargingProfileKindRelative, nil,
+				types.ChargingSchedulePeriod{StartPeriod: 0, Limit: 16},
+				types.ChargingSchedulePeriod{StartPeriod: 600, Limit: 8},
+			),
+		}, now.Add(-5 * time.Minute), 16, true},
+		{"recurring", profiles{
+			chargingProfile(1, 0, types.ChargingProfileKindRecurring, func() *time.Time { t := start.Add(-72 * time.Hour); return &t }(),
+				types.ChargingSchedulePeriod{StartPeriod: 0, Limit: 16},
+				types.ChargingSchedulePeriod{StartPeriod: 1800, Limit: 0},
+			),
+		}, time.Time{}, 0, true},
+		{"stack level", profiles{
+			chargingProfile(1, 0, types.ChargingProfileKindAbsolute, &start, types.ChargingSchedulePeriod{Limit: 16}),
+			chargingProfile(2, 1, types.ChargingProfileKindAbsolute, &start, types.ChargingSchedulePeriod{Limit: 6}),
+		}, time.Time{}, 6, true},
+	}
+
+	for _, tc := range tc {
+		_, period, ok := tc.profiles.active(types.ChargingProfilePurposeTxDefaultProfile, now, tc.txStart)
+		assert.Equal(t, tc.ok, ok, tc.name)
+		assert.Equal(t, tc.limit, period.Limit, tc.name)
+	}
+}
+
+func TestProfilesSetClear(t *testing.T) {
+	var p profiles
+
+	p.set(chargingProfile(1, 0, types.ChargingProfileKindAbsolute, nil))
+	p.set(chargingProfile(2, 1, types.ChargingProfileKindAbsolute, nil))
+	p.set(chargingProfile(3, 1, types.ChargingProfileKindAbsolute, nil))
+	assert.Len(t, p, 2, "same purpose and stack level replaces profile")
+
+	id := 1
+	assert.True(t, p.clear(&id, "", nil))
+	assert.False(t, p.clear(&id, "", nil))
+	assert.Len(t, p, 1)
+
+	assert.True(t, p.clear(nil, types.ChargingProfilePurposeTxDefaultProfile, nil))
+	assert.Empty(t, p)
+}
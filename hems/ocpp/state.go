@@ -0,0 +1,91 @@
+package ocpp
+
+import (
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// connectorState is the connector state persisted across restarts
+type connectorState struct {
+	Register float64   `json:"register"`           // energy register in Wh
+	Txn      int       `json:"txn,omitempty"`      // open transaction id
+	TxnStart time.Time `json:"txnStart,omitempty"` // open transaction start
+}
+
+// DefaultFile returns the default state file in the user's home directory
+func DefaultFile() (string, error) {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(home, ".evcc", "ocpp.json"), nil
+}
+
+// load restores energy registers and open transactions of the connectors from file
+func (s *OCPP) load() error {
+	if s.file == "" {
+		return nil
+	}
+
+	if err := os.MkdirAll(filepath.Dir(s.file), 0o755); err != nil {
+		return err
+	}
+
+	b, err := os.ReadFile(s.file)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	var state []connectorState
+	if err := json.Unmarshal(b, &state); err != nil {
+		return err
+	}
+
+	for i, st := range state {
+		if i < len(s.connectors) {
+			c := s.connectors[i]
+			c.register, c.txn, c.txnStart = st.Register, st.Txn, st.TxnStart
+		}
+	}
+
+	return nil
+}
+
+// save writes the connector state to file, must be called with lock held
+func (s *OCPP) save() {
+	if s.file == "" {
+		return
+	}
+
+	state := make([]connectorState, 0, len(s.connectors))
+	for _, c := range s.connectors {
+		state = append(state, connectorState{Register: c.register, Txn: c.txn, TxnStart: c.txnStart})
+	}
+
+	b, err := json.Marshal(state)
+	if err == nil {
+		// write atomically
+		tmp := s.file + ".tmp"
+		if err = os.WriteFile(tmp, b, 0o644); err == nil {
+			err = os.Rename(tmp, s.file)
+		}
+	}
+
+	if err != nil {
+		s.log.ERROR.Printf("saving state: %v", err)
+	}
+}
+
+// Shutdown saves the connector state
+func (s *OCPP) Shutdown() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.save()
+}
@@ -5,13 +5,16 @@ import (
 	"encoding/hex"
 	"encoding/xml"
 	"fmt"
+	"math"
 	"net"
 	"net/http"
 	"os"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
+	"github.com/benbjohnson/clock"
 	"github.com/denisbrodbeck/machineid"
 	"github.com/evcc-io/evcc/api"
 	"github.com/evcc-io/evcc/core/loadpoint"
@@ -32,6 +35,8 @@ const (
 	sempCharger      = "EVCharger"
 	basePath         = "/semp"
 	maxAge           = 1800
+	voltage          = 230
+	controlTimeout   = 5 * time.Minute // recommendations expire if not renewed
 )
 
 var serverName = "EVCC SEMP Server " + server.Version
@@ -48,6 +53,9 @@ type SEMP struct {
 	hostURI      string
 	port         int
 	site         site.API
+	clock        clock.Clock
+	mu           sync.Mutex
+	recommended  map[int]time.Time // last power recommendation by loadpoint
 }
 
 // New generates SEMP Gateway listening at /semp endpoint
@@ -96,6 +104,8 @@ func New(conf map[string]interface{}, site site.API, httpd *server.HTTPd) (*SEMP
 		vid:          cc.VendorID,
 		did:          did,
 		controllable: cc.AllowControl,
+		clock:        clock.New(),
+		recommended:  make(map[int]time.Time),
 	}
 
 	// find external port
@@ -139,7 +149,11 @@ func (s *SEMP) Run() {
 		ads = append(ads, ad)
 	}
 
-	ticker := time.NewTicker(maxAge * time.Second / 2)
+	ticker := s.clock.Ticker(maxAge * time.Second / 2)
+	defer ticker.Stop()
+
+	expiry := s.clock.Ticker(time.Minute)
+	defer expiry.Stop()
 
 ANNOUNCE:
 	for {
@@ -150,6 +164,8 @@ ANNOUNCE:
 					s.log.ERROR.Println(err)
 				}
 			}
+		case <-expiry.C:
+			s.expireRecommendations()
 		case <-s.closeC:
 			break ANNOUNCE
 		}
@@ -407,20 +423,14 @@ func (s *SEMP) allDeviceInfo() (res []DeviceInfo) {
 func (s *SEMP) deviceStatus(id int, lp loadpoint.API) DeviceStatus {
 	chargePower := lp.GetChargePower()
 
-	status := lp.GetStatus()
-	mode := lp.GetMode()
-	isPV := mode == api.ModeMinPV || mode == api.ModePV
-
 	deviceStatus := StatusOff
-	if status == api.StatusC {
+	if lp.GetStatus() == api.StatusC {
 		deviceStatus = StatusOn
 	}
 
-	connected := status == api.StatusB || status == api.StatusC
-
 	res := DeviceStatus{
 		DeviceID:          s.deviceID(id),
-		EMSignalsAccepted: s.controllable && isPV && connected,
+		EMSignalsAccepted: s.accepted(lp),
 		PowerInfo: PowerInfo{
 			AveragePower:      int(chargePower),
 			AveragingInterval: 60,
@@ -431,6 +441,17 @@ func (s *SEMP) deviceStatus(id int, lp loadpoint.API) DeviceStatus {
 	return res
 }
 
+// accepted returns true if the loadpoint follows control signals
+func (s *SEMP) accepted(lp loadpoint.API) bool {
+	status := lp.GetStatus()
+	mode := lp.GetMode()
+
+	connected := status == api.StatusB || status == api.StatusC
+	isPV := mode == api.ModeMinPV || mode == api.ModePV
+
+	return s.controllable && isPV && connected
+}
+
 func (s *SEMP) allDeviceStatus() (res []DeviceStatus) {
 	for id, lp := range s.site.LoadPoints() {
 		res = append(res, s.deviceStatus(id, lp))
@@ -439,11 +460,23 @@ func (s *SEMP) allDeviceStatus() (res []DeviceStatus) {
 	return res
 }
 
+// socEnergy returns the energy in Wh required to charge a vehicle of given capacity from soc to target soc
+func socEnergy(capacity, soc float64, target int) int {
+	if energy := int(capacity * 1e3 * (float64(target) - soc) / 100); energy > 0 {
+		return energy
+	}
+	return 0
+}
+
 func (s *SEMP) planningRequest(id int, lp loadpoint.API) (res PlanningRequest) {
 	mode := lp.GetMode()
 	charging := lp.GetStatus() == api.StatusC
 	connected := charging || lp.GetStatus() == api.StatusB
 
+	if mode == api.ModeOff || !connected {
+		return res
+	}
+
 	// remaining max demand duration in seconds
 	chargeRemainingDuration := lp.GetRemainingDuration()
 	latestEnd := int(chargeRemainingDuration / time.Second)
@@ -451,18 +484,35 @@ func (s *SEMP) planningRequest(id int, lp loadpoint.API) (res PlanningRequest) {
 		latestEnd = 24 * 3600
 	}
 
-	// remaining max energy demand in Wh
-	chargeRemainingEnergy := lp.GetRemainingEnergy()
-	maxEnergy := int(chargeRemainingEnergy)
+	var minEnergy, maxEnergy int
+
+	if soc, capacity := lp.GetVehicleSoC(), lp.GetVehicleCapacity(); soc > 0 && capacity > 0 {
+		// energy demand from soc and capacity
+		maxEnergy = socEnergy(capacity, soc, lp.GetTargetSoC())
 
-	// add 1kWh in case we're charging but battery claims full
-	if charging && maxEnergy == 0 {
-		maxEnergy = 1e3 // 1kWh
+		minEnergy = maxEnergy
+		if mode == api.ModePV {
+			minEnergy = socEnergy(capacity, soc, lp.GetMinSoC())
+		}
+	} else {
+		// remaining max energy demand in Wh
+		maxEnergy = int(lp.GetRemainingEnergy())
+
+		// add 1kWh in case we're charging but battery claims full
+		if charging && maxEnergy == 0 {
+			maxEnergy = 1e3 // 1kWh
+		}
+
+		minEnergy = maxEnergy
+		if mode == api.ModePV {
+			minEnergy = 0
+		}
 	}
 
-	minEnergy := maxEnergy
-	if mode == api.ModePV {
-		minEnergy = 0
+	// target charging requires the full energy until the deadline
+	if targetTime := lp.GetTargetTime(); mode != api.ModeNow && time.Until(targetTime) > 0 {
+		latestEnd = int(time.Until(targetTime) / time.Second)
+		minEnergy = maxEnergy
 	}
 
 	maxPowerConsumption := int(lp.GetMaxPower())
@@ -471,7 +521,7 @@ func (s *SEMP) planningRequest(id int, lp loadpoint.API) (res PlanningRequest) {
 		minPowerConsumption = maxPowerConsumption
 	}
 
-	if mode != api.ModeOff && connected && maxEnergy > 0 {
+	if maxEnergy > 0 {
 		res = PlanningRequest{
 			Timeframe: []Timeframe{{
 				DeviceID:            s.deviceID(id),
@@ -533,9 +583,58 @@ func (s *SEMP) deviceControlHandler(w http.ResponseWriter, r *http.Request) {
 				demand = loadpoint.RemoteEnable
 			}
 
+			if dev.On && dev.RecommendedPowerConsumption > 0 {
+				s.setPowerLimit(id, lp, dev.RecommendedPowerConsumption)
+			} else {
+				s.resetPowerLimit(id, lp)
+			}
+
 			lp.RemoteControl(sempController, demand)
 		}
 	}
 
 	w.WriteHeader(http.StatusOK)
 }
+
+// setPowerLimit applies the recommended power consumption as loadpoint power limit
+func (s *SEMP) setPowerLimit(id int, lp loadpoint.API, power float64) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	phases := lp.GetPhases()
+	if phases == 0 {
+		phases = 3
+	}
+
+	// recommendations keep charging at min current at least
+	power = math.Max(power, lp.GetMinCurrent()*voltage*float64(phases))
+
+	s.log.DEBUG.Printf("lp-%d: recommended power %.0fW", id+1, power)
+	lp.SetPowerLimit(sempController, power)
+	s.recommended[id] = s.clock.Now()
+}
+
+// resetPowerLimit removes the loadpoint's power limit
+func (s *SEMP) resetPowerLimit(id int, lp loadpoint.API) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.recommended[id]; ok {
+		lp.SetPowerLimit(sempController, 0)
+		delete(s.recommended, id)
+	}
+}
+
+// expireRecommendations returns control once recommendations are no longer accepted or renewed
+func (s *SEMP) expireRecommendations() {
+	for id, lp := range s.site.LoadPoints() {
+		s.mu.Lock()
+		updated, ok := s.recommended[id]
+		s.mu.Unlock()
+
+		if ok && (!s.accepted(lp) || s.clock.Since(updated) > controlTimeout) {
+			s.log.DEBUG.Printf("lp-%d: recommendation expired", id+1)
+			s.resetPowerLimit(id, lp)
+		}
+	}
+}
@@ -0,0 +1,202 @@
+package semp
+
+import (
+	"testing"
+	"time"
+
+	"github.com/benbjohnson/clock"
+	"github.com/evcc-io/evcc/api"
+	"github.com/evcc-io/evcc/core/loadpoint"
+	"github.com/evcc-io/evcc/core/site"
+	"github.com/evcc-io/evcc/util"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testSite struct {
+	site.API
+	lp loadpoint.API
+}
+
+func (s *testSite) LoadPoints() []loadpoint.API { return []loadpoint.API{s.lp} }
+
+type testLoadpoint struct {
+	loadpoint.API
+	mode              api.ChargeMode
+	status            api.ChargeStatus
+	soc, capacity     float64
+	targetSoC, minSoC int
+	remainingEnergy   float64
+	remainingDuration time.Duration
+	targetTime        time.Time
+	powerLimit        float64
+}
+
+func (lp *testLoadpoint) GetMode() api.ChargeMode                    { return lp.mode }
+func (lp *testLoadpoint) GetStatus() api.ChargeStatus                { return lp.status }
+func (lp *testLoadpoint) GetVehicleSoC() float64                     { return lp.soc }
+func (lp *testLoadpoint) GetVehicleCapacity() float64                { return lp.capacity }
+func (lp *testLoadpoint) GetTargetSoC() int                          { return lp.targetSoC }
+func (lp *testLoadpoint) GetMinSoC() int                             { return lp.minSoC }
+func (lp *testLoadpoint) GetRemainingEnergy() float64                { return lp.remainingEnergy }
+func (lp *testLoadpoint) GetRemainingDuration() time.Duration        { return lp.remainingDuration }
+func (lp *testLoadpoint) GetTargetTime() time.Time                   { return lp.targetTime }
+func (lp *testLoadpoint) GetChargePower() float64                    { return 0 }
+func (lp *testLoadpoint) GetMinPower() float64                       { return 1380 }
+func (lp *testLoadpoint) GetMaxPower() float64                       { return 11000 }
+func (lp *testLoadpoint) GetMinCurrent() float64                     { return 6 }
+func (lp *testLoadpoint) GetPhases() int                             { return 3 }
+func (lp *testLoadpoint) SetPowerLimit(source string, power float64) { lp.powerLimit = power }
+
+func newTestSEMP(lp loadpoint.API) *SEMP {
+	return &SEMP{
+		log:          util.NewLogger("semp"),
+		vid:          "28081973",
+		did:          make([]byte, 6),
+		controllable: true,
+		site:         &testSite{lp: lp},
+		clock:        clock.NewMock(),
+		recommended:  make(map[int]time.Time),
+	}
+}
+
+func TestSoCEnergy(t *testing.T) {
+	assert.Equal(t, 25000, socEnergy(50, 30, 80))
+	assert.Equal(t, 0, socEnergy(50, 90, 80))
+}
+
+func TestPlanningRequest(t *testing.T) {
+	tc := []struct {
+		name                 string
+		lp                   testLoadpoint
+		minEnergy, maxEnergy int
+		minPower, latestEnd  int
+	}{
+		{"off", testLoadpoint{mode: api.ModeOff, status: api.StatusB, remainingEnergy: 5000}, 0, 0, 0, 0},
+		{"disconnected", testLoadpoint{mode: api.ModePV, status: api.StatusA, remainingEnergy: 5000}, 0, 0, 0, 0},
+		{"pv remaining energy", testLoadpoint{mode: api.ModePV, status: api.StatusB, remainingEnergy: 5000}, 0, 5000, 1380, 24 * 3600},
+		{"pv charging full", testLoadpoint{mode: api.ModePV, status: api.StatusC}, 0, 1000, 1380, 24 * 3600},
+		{"pv soc", testLoadpoint{mode: api.ModePV, status: api.StatusB, soc: 20, capacity: 50, targetSoC: 80, minSoC: 30}, 5000, 30000, 1380, 24 * 3600},
+		{"minpv soc", testLoadpoint{mode: api.ModeMinPV, status: api.StatusB, soc: 20, capacity: 50, targetSoC: 80, minSoC: 30}, 30000, 30000, 1380, 24 * 3600},
+		{"now", testLoadpoint{mode: api.ModeNow, status: api.StatusC, remainingEnergy: 5000, remainingDuration: time.Hour}, 5000, 5000, 11000, 3600},
+		{"target soc reached", testLoadpoint{mode: api.ModePV, status: api.StatusB, soc: 90, capacity: 50, targetSoC: 80}, 0, 0, 0, 0},
+	}
+
+	for _, tc := range tc {
+		t.Run(tc.name, func(t *testing.T) {
+			s := newTestSEMP(&tc.lp)
+			res := s.planningRequest(0, &tc.lp)
+
+			if tc.maxEnergy == 0 {
+				assert.Empty(t, res.Timeframe)
+				return
+			}
+
+			require.Len(t, res.Timeframe, 1)
+			tf := res.Timeframe[0]
+			assert.Equal(t, tc.minEnergy, *tf.MinEnergy, "min energy")
+			assert.Equal(t, tc.maxEnergy, *tf.MaxEnergy, "max energy")
+			assert.Equal(t, tc.minPower, *tf.MinPowerConsumption, "min power")
+			assert.Equal(t, 11000, *tf.MaxPowerConsumption, "max power")
+			assert.Equal(t, tc.latestEnd, tf.LatestEnd, "latest end")
+		})
+	}
+}
+
+func TestPlanningRequestTargetTime(t *testing.T) {
+	lp := &testLoadpoint{mode: api.ModePV, status: api.StatusB, remainingEnergy: 5000, targetTime: time.Now().Add(2 * time.Hour)}
+	s := newTestSEMP(lp)
+
+	res := s.planningRequest(0, lp)
+	require.Len(t, res.Timeframe, 1)
+
+	tf := res.Timeframe[0]
+	assert.Equal(t, 5000, *tf.MinEnergy, "target charging requires full energy")
+	assert.InDelta(t, 2*3600, tf.LatestEnd, 5)
+}
+
+func TestPowerLimit(t *testing.T) {
+	tc := []struct {
+		name        string
+		recommended float64
+		limit       float64
+	}{
+		{"recommendation", 5000, 5000},
+		{"below min current", 1000, 6 * voltage * 3},
+	}
+
+	for _, tc := range tc {
+		t.Run(tc.name, func(t *testing.T) {
+			lp := &testLoadpoint{mode: api.ModePV, status: api.StatusC}
+			s := newTestSEMP(lp)
+
+			s.setPowerLimit(0, lp, tc.recommended)
+			assert.Equal(t, tc.limit, lp.powerLimit)
+
+			s.resetPowerLimit(0, lp)
+			assert.Equal(t, 0.0, lp.powerLimit)
+		})
+	}
+}
+
+func TestPowerLimitExpiry(t *testing.T) {
+	lp := &testLoadpoint{mode: api.ModePV, status: api.StatusC}
+	s := newTestSEMP(lp)
+	clck := s.clock.(*clock.Mock)
+
+	// status queries don't modify limits
+	s.setPowerLimit(0, lp, 5000)
+	lp.mode = api.ModeNow
+	assert.False(t, s.deviceStatus(0, lp).EMSignalsAccepted)
+	assert.Equal(t, 5000.0, lp.powerLimit)
+
+	// limit is removed once signals are no longer accepted
+	s.expireRecommendations()
+	assert.Equal(t, 0.0, lp.powerLimit)
+
+	// limit is removed once recommendations are not renewed
+	lp.mode = api.ModePV
+	s.setPowerLimit(0, lp, 5000)
+
+	clck.Add(controlTimeout)
+	s.expireRecommendations()
+	assert.Equal(t, 5000.0, lp.powerLimit)
+
+	clck.Add(time.Second)
+	s.expireRecommendations()
+	assert.Equal(t, 0.0, lp.powerLimit)
+}
+
+// limitLoadpoint reports power limit changes of a running SEMP
+type limitLoadpoint struct {
+	*testLoadpoint
+	limitC chan float64
+}
+
+func (lp *limitLoadpoint) SetPowerLimit(source string, power float64) { lp.limitC <- power }
+
+func TestRunExpiry(t *testing.T) {
+	lp := &limitLoadpoint{&testLoadpoint{mode: api.ModePV, status: api.StatusC}, make(chan float64, 1)}
+	s := newTestSEMP(lp)
+	s.doneC = make(chan struct{})
+	clck := s.clock.(*clock.Mock)
+
+	s.setPowerLimit(0, lp, 5000)
+	require.Equal(t, 5000.0, <-lp.limitC)
+
+	go s.Run()
+
+	// recommendation expires on the injected clock
+	require.Eventually(t, func() bool {
+		clck.Add(time.Minute)
+		select {
+		case limit := <-lp.limitC:
+			return limit == 0
+		default:
+			return false
+		}
+	}, 5*time.Second, 10*time.Millisecond)
+
+	s.Stop()
+	<-s.Done()
+}
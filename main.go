@@ -14,6 +14,9 @@ import (
 //go:embed dist
 var assets embed.FS
 
+//go:embed schema.json
+var schema []byte
+
 // init loads embedded assets unless live assets are already loaded
 func init() {
 	if server.Assets == nil {
@@ -23,6 +26,8 @@ func init() {
 		}
 		server.Assets = fsys
 	}
+
+	cmd.ConfigSchema = schema
 }
 
 func main() {
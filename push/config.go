@@ -2,9 +2,13 @@ package push
 
 import (
 	"fmt"
+	"net/http"
 	"strings"
+	"time"
 
+	"github.com/evcc-io/evcc/core/site"
 	"github.com/evcc-io/evcc/util"
+	"github.com/evcc-io/evcc/util/request"
 )
 
 // Sender implements message sending
@@ -12,6 +16,26 @@ type Sender interface {
 	Send(title, msg string)
 }
 
+// EventSender is implemented by senders consuming structured events instead of plain messages
+type EventSender interface {
+	SendEvent(msg EventMessage)
+}
+
+// EventMessage is the structured representation of a push event
+type EventMessage struct {
+	Event      string                 `json:"event"`
+	LoadPoint  int                    `json:"loadpoint,omitempty"` // 1-based loadpoint id, omitted for site events
+	Title      string                 `json:"title"`
+	Msg        string                 `json:"msg"`
+	Time       time.Time              `json:"time"`
+	Attributes map[string]interface{} `json:"attributes,omitempty"`
+}
+
+// Controller is implemented by senders accepting commands for controlling the site
+type Controller interface {
+	Control(site site.API)
+}
+
 var log = util.NewLogger("push")
 
 // NewMessengerFromConfig creates a new messenger
@@ -27,6 +51,19 @@ func NewMessengerFromConfig(typ string, other map[string]interface{}) (res Sende
 		if err = util.DecodeOther(other, &cc); err == nil {
 			res, err = NewTelegramMessenger(cc.Token, cc.Chats)
 		}
+	case "webhook":
+		cc := webhookConfig{
+			Method:  http.MethodPost,
+			Timeout: request.Timeout,
+		}
+		if err = util.DecodeOther(other, &cc); err == nil {
+			res, err = NewWebhookMessenger(cc.URI, cc.Method, cc.Headers, cc.Body, cc.Timeout)
+		}
+	case "mqtt":
+		var cc mqttConfig
+		if err = util.DecodeOther(other, &cc); err == nil {
+			res, err = NewMqttMessenger(cc.Config, cc.Topic, cc.Retained)
+		}
 	case "email", "shout":
 		var cc shoutrrrConfig
 		if err = util.DecodeOther(other, &cc); err == nil {
@@ -1,7 +1,9 @@
 package push
 
 import (
+	"io"
 	"strings"
+	"sync"
 	"text/template"
 	"time"
 
@@ -27,17 +29,64 @@ type EventTemplate struct {
 
 // Hub subscribes to event notifications and sends them to client devices
 type Hub struct {
+	mu          sync.Mutex
 	definitions map[string]EventTemplate
-	sender      []Sender
+	sender      []namedSender
+	rules       []*Rule
 	cache       *util.Cache
 }
 
-// NewHub creates push hub with definitions and receiver
-func NewHub(cc map[string]EventTemplateConfig, cache *util.Cache) (*Hub, error) {
+type namedSender struct {
+	name string
+	Sender
+}
+
+// defaultEvents are the templates for error and grid limit events used unless configured otherwise
+var defaultEvents = map[string]EventTemplateConfig{
+	"fault": {
+		Title: "Charger fault",
+		Msg:   "${title} charger reports an error",
+	},
+	"meterfailure": {
+		Title: "Meter failure",
+		Msg:   "Failed reading ${meterFailure} meter",
+	},
+	"targetmiss": {
+		Title: "Target charging",
+		Msg:   `${title}: charging to ${targetSoC}% will not finish by {{ .targetTime.Format "15:04" }}`,
+	},
+	"authfailure": {
+		Title: "Vehicle login failed",
+		Msg:   "${vehicleTitle}: vehicle api login failed, please check credentials",
+	},
+	"unhealthy": {
+		Title: "Site unhealthy",
+		Msg:   "evcc stopped updating, please check the logs",
+	},
+	"gridlimitstart": {
+		Title: "Grid limit",
+		Msg:   "Grid operator limited charging power",
+	},
+	"gridlimitstop": {
+		Title: "Grid limit",
+		Msg:   "Grid operator limit ended, charging power restored",
+	},
+}
+
+// NewHub creates push hub with definitions, routing rules and receiver
+func NewHub(cc map[string]EventTemplateConfig, rules []RuleConfig, cache *util.Cache) (*Hub, error) {
 	definitions := make(map[string]EventTemplate)
 
-	// instantiate all event templates
+	events := make(map[string]EventTemplateConfig, len(cc)+len(defaultEvents))
+	for k, v := range defaultEvents {
+		events[k] = v
+	}
 	for k, v := range cc {
+		events[k] = v
+	}
+
+	// instantiate all event templates
+	for k, v := range events {
 		var def EventTemplate
 		var err error
 
@@ -58,16 +107,49 @@ func NewHub(cc map[string]EventTemplateConfig, cache *util.Cache) (*Hub, error)
 		cache:       cache,
 	}
 
+	for _, rc := range rules {
+		rule, err := NewRule(rc)
+		if err != nil {
+			return nil, err
+		}
+
+		h.rules = append(h.rules, rule)
+	}
+
 	return h, nil
 }
 
-// Add adds a sender to the list of senders
-func (h *Hub) Add(sender Sender) {
-	h.sender = append(h.sender, sender)
+// Update replaces definitions, rules and senders by those of the given hub
+func (h *Hub) Update(o *Hub) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.definitions = o.definitions
+	h.rules = o.rules
+	h.sender = o.sender
+}
+
+// Close releases the senders' resources, e.g. stops receiving commands. Senders may still be used for sending.
+func (h *Hub) Close() {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	for _, sender := range h.sender {
+		if c, ok := sender.Sender.(io.Closer); ok {
+			if err := c.Close(); err != nil {
+				log.ERROR.Printf("%s: %v", sender.name, err)
+			}
+		}
+	}
 }
 
-// apply applies the event template to the content to produce the actual message
-func (h *Hub) apply(ev Event, tmpl *template.Template) (string, error) {
+// Add adds a named sender to the list of senders. The name is used for routing rules.
+func (h *Hub) Add(name string, sender Sender) {
+	h.sender = append(h.sender, namedSender{name: name, Sender: sender})
+}
+
+// attributes returns the cached values for the event
+func (h *Hub) attributes(ev Event) map[string]interface{} {
 	attr := make(map[string]interface{})
 
 	// let cache catch up, refs reverted https://github.com/evcc-io/evcc/pull/445
@@ -80,6 +162,29 @@ func (h *Hub) apply(ev Event, tmpl *template.Template) (string, error) {
 		}
 	}
 
+	return attr
+}
+
+// permits evaluates the routing rules for sender. Without rules for the event, all senders receive it.
+func (h *Hub) permits(ev Event, sender string, attr map[string]interface{}, msg string, now time.Time) bool {
+	var handled bool
+
+	for _, rule := range h.rules {
+		if !rule.appliesTo(ev.Event) {
+			continue
+		}
+
+		handled = true
+		if rule.permits(ev, sender, attr, msg, now) {
+			return true
+		}
+	}
+
+	return !handled
+}
+
+// apply applies the event template to the content to produce the actual message
+func (h *Hub) apply(attr map[string]interface{}, tmpl *template.Template) (string, error) {
 	// apply data attributes to template using sprig functions
 	applied := new(strings.Builder)
 	if err := tmpl.Execute(applied, attr); err != nil {
@@ -92,33 +197,68 @@ func (h *Hub) apply(ev Event, tmpl *template.Template) (string, error) {
 // Run is the Hub's main publishing loop
 func (h *Hub) Run(events <-chan Event) {
 	for ev := range events {
-		if len(h.sender) == 0 {
-			continue
-		}
+		h.send(ev)
+	}
+}
 
-		definition, ok := h.definitions[ev.Event]
-		if !ok {
-			continue
-		}
+// send publishes the event to all permitted senders
+func (h *Hub) send(ev Event) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
 
-		title, err := h.apply(ev, definition.Title)
-		if err != nil {
-			log.ERROR.Printf("invalid title template for %s: %v", ev.Event, err)
+	if len(h.sender) == 0 {
+		return
+	}
+
+	definition, ok := h.definitions[ev.Event]
+	if !ok {
+		return
+	}
+
+	attr := h.attributes(ev)
+
+	title, err := h.apply(attr, definition.Title)
+	if err != nil {
+		log.ERROR.Printf("invalid title template for %s: %v", ev.Event, err)
+		return
+	}
+
+	msg, err := h.apply(attr, definition.Msg)
+	if err != nil {
+		log.ERROR.Printf("invalid message template for %s: %v", ev.Event, err)
+		return
+	}
+
+	if strings.TrimSpace(msg) == "" {
+		log.DEBUG.Printf("did not send empty message template for %s", ev.Event)
+		return
+	}
+
+	now := time.Now()
+
+	em := EventMessage{
+		Event:      ev.Event,
+		Title:      title,
+		Msg:        msg,
+		Time:       now,
+		Attributes: attr,
+	}
+
+	if ev.LoadPoint != nil {
+		em.LoadPoint = *ev.LoadPoint + 1
+	}
+
+	for _, sender := range h.sender {
+		if !h.permits(ev, sender.name, attr, msg, now) {
+			log.DEBUG.Printf("%s: rules suppressed %s", sender.name, ev.Event)
 			continue
 		}
 
-		msg, err := h.apply(ev, definition.Msg)
-		if err != nil {
-			log.ERROR.Printf("invalid message template for %s: %v", ev.Event, err)
+		if es, ok := sender.Sender.(EventSender); ok {
+			go es.SendEvent(em)
 			continue
 		}
 
-		for _, sender := range h.sender {
-			if strings.TrimSpace(msg) != "" {
-				go sender.Send(title, msg)
-			} else {
-				log.DEBUG.Printf("did not send empty message template for %s: %v", ev.Event, err)
-			}
-		}
+		go sender.Send(title, msg)
 	}
 }
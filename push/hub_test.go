@@ -0,0 +1,31 @@
+package push
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type closingSender struct {
+	closed bool
+}
+
+func (s *closingSender) Send(title, msg string) {}
+
+func (s *closingSender) Close() error {
+	s.closed = true
+	return nil
+}
+
+func TestHubClose(t *testing.T) {
+	hub, err := NewHub(nil, nil, nil)
+	require.NoError(t, err)
+
+	sender := &closingSender{}
+	hub.Add("closing", sender)
+	hub.Add("other", &Webhook{})
+
+	hub.Close()
+	assert.True(t, sender.closed)
+}
@@ -0,0 +1,60 @@
+package push
+
+import (
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/evcc-io/evcc/provider/mqtt"
+	"github.com/evcc-io/evcc/util"
+)
+
+// Mqtt implements the mqtt messenger publishing event json
+type Mqtt struct {
+	client   *mqtt.Client
+	topic    string
+	retained bool
+}
+
+type mqttConfig struct {
+	mqtt.Config `mapstructure:",squash"`
+	Topic       string
+	Retained    bool
+}
+
+// NewMqttMessenger creates new mqtt messenger. Without broker the global mqtt client is used.
+func NewMqttMessenger(cc mqtt.Config, topic string, retained bool) (*Mqtt, error) {
+	if topic == "" {
+		return nil, errors.New("mqtt: missing topic")
+	}
+
+	client, err := mqtt.RegisteredClientOrDefault(util.NewLogger("mqtt"), cc)
+	if err != nil {
+		return nil, err
+	}
+
+	m := &Mqtt{
+		client:   client,
+		topic:    topic,
+		retained: retained,
+	}
+
+	return m, nil
+}
+
+// Send sends a plain message
+func (m *Mqtt) Send(title, msg string) {
+	m.SendEvent(EventMessage{Title: title, Msg: msg, Time: time.Now()})
+}
+
+// SendEvent publishes the structured event
+func (m *Mqtt) SendEvent(msg EventMessage) {
+	b, err := json.Marshal(msg)
+	if err == nil {
+		err = m.client.Publish(m.topic, m.retained, string(b))
+	}
+
+	if err != nil {
+		log.ERROR.Printf("mqtt: %v", err)
+	}
+}
@@ -0,0 +1,227 @@
+package push
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// RuleConfig is the routing rule configuration for events
+type RuleConfig struct {
+	Events     []string      // events the rule applies to, all events if empty
+	Services   []string      // services receiving the events, all services if empty
+	Conditions []string      // conditions on cached values, e.g. "vehicleSoC < 50"
+	Interval   time.Duration // minimum interval between messages per event and loadpoint
+	Dedupe     time.Duration // suppress identical messages within this window
+	Quiet      string        // quiet hours, e.g. "22:00-07:00"
+}
+
+// Rule routes events to senders
+type Rule struct {
+	events, services []string
+	conditions       []condition
+	interval, dedupe time.Duration
+	quietFrom        time.Duration
+	quietTo          time.Duration
+	quiet            bool
+
+	// last message per sender, event and loadpoint
+	sent map[string]sentMessage
+}
+
+type sentMessage struct {
+	time time.Time
+	msg  string
+}
+
+type condition struct {
+	key, op, value string
+}
+
+var operators = []string{"<=", ">=", "==", "!=", "<", ">"}
+
+// NewRule creates a routing rule from config
+func NewRule(cc RuleConfig) (*Rule, error) {
+	r := &Rule{
+		events:   cc.Events,
+		services: cc.Services,
+		interval: cc.Interval,
+		dedupe:   cc.Dedupe,
+		sent:     make(map[string]sentMessage),
+	}
+
+	for _, s := range cc.Conditions {
+		c, err := parseCondition(s)
+		if err != nil {
+			return nil, err
+		}
+		r.conditions = append(r.conditions, c)
+	}
+
+	if cc.Quiet != "" {
+		segs := strings.Split(cc.Quiet, "-")
+		if len(segs) != 2 {
+			return nil, fmt.Errorf("invalid quiet hours: %s", cc.Quiet)
+		}
+
+		var err error
+		if r.quietFrom, err = parseTimeOfDay(segs[0]); err == nil {
+			r.quietTo, err = parseTimeOfDay(segs[1])
+		}
+		if err != nil {
+			return nil, fmt.Errorf("invalid quiet hours: %s", cc.Quiet)
+		}
+
+		r.quiet = true
+	}
+
+	return r, nil
+}
+
+func parseCondition(s string) (condition, error) {
+	for _, op := range operators {
+		if segs := strings.SplitN(s, op, 2); len(segs) == 2 {
+			c := condition{
+				key:   strings.TrimSpace(segs[0]),
+				op:    op,
+				value: strings.Trim(strings.TrimSpace(segs[1]), `"'`),
+			}
+
+			if c.key == "" || c.value == "" {
+				break
+			}
+
+			return c, nil
+		}
+	}
+
+	return condition{}, fmt.Errorf("invalid condition: %s", s)
+}
+
+func parseTimeOfDay(s string) (time.Duration, error) {
+	t, err := time.Parse("15:04", strings.TrimSpace(s))
+	if err != nil {
+		return 0, err
+	}
+
+	return time.Duration(t.Hour())*time.Hour + time.Duration(t.Minute())*time.Minute, nil
+}
+
+// match evaluates the condition against the attribute values. Missing values never match.
+func (c condition) match(attr map[string]interface{}) bool {
+	val, ok := attr[c.key]
+	if !ok {
+		return false
+	}
+
+	if f, ok := toFloat(val); ok {
+		if ref, err := strconv.ParseFloat(c.value, 64); err == nil {
+			switch c.op {
+			case "<":
+				return f < ref
+			case "<=":
+				return f <= ref
+			case ">":
+				return f > ref
+			case ">=":
+				return f >= ref
+			case "==":
+				return f == ref
+			case "!=":
+				return f != ref
+			}
+		}
+	}
+
+	switch c.op {
+	case "==":
+		return fmt.Sprint(val) == c.value
+	case "!=":
+		return fmt.Sprint(val) != c.value
+	}
+
+	return false
+}
+
+func toFloat(val interface{}) (float64, bool) {
+	switch v := val.(type) {
+	case float64:
+		return v, true
+	case float32:
+		return float64(v), true
+	case int:
+		return float64(v), true
+	case int64:
+		return float64(v), true
+	case time.Duration:
+		return v.Seconds(), true
+	default:
+		return 0, false
+	}
+}
+
+func contains(list []string, s string) bool {
+	for _, v := range list {
+		if strings.EqualFold(v, s) {
+			return true
+		}
+	}
+	return false
+}
+
+// appliesTo returns true if the rule handles the event
+func (r *Rule) appliesTo(event string) bool {
+	return len(r.events) == 0 || contains(r.events, event)
+}
+
+// inQuietHours returns true if the time of day is within quiet hours
+func (r *Rule) inQuietHours(now time.Time) bool {
+	if !r.quiet {
+		return false
+	}
+
+	tod := time.Duration(now.Hour())*time.Hour + time.Duration(now.Minute())*time.Minute
+
+	if r.quietFrom <= r.quietTo {
+		return tod >= r.quietFrom && tod < r.quietTo
+	}
+
+	// wraps midnight
+	return tod >= r.quietFrom || tod < r.quietTo
+}
+
+// permits returns true if the message may be sent to the sender. Permitted messages are recorded for throttling.
+// Rules are not synchronized and must only be evaluated from the hub's main loop.
+func (r *Rule) permits(ev Event, sender string, attr map[string]interface{}, msg string, now time.Time) bool {
+	if len(r.services) > 0 && !contains(r.services, sender) {
+		return false
+	}
+
+	for _, c := range r.conditions {
+		if !c.match(attr) {
+			return false
+		}
+	}
+
+	if r.inQuietHours(now) {
+		return false
+	}
+
+	key := sender + "/" + ev.Event
+	if ev.LoadPoint != nil {
+		key = fmt.Sprintf("%s/%d", key, *ev.LoadPoint)
+	}
+
+	last, ok := r.sent[key]
+	if ok && r.interval > 0 && now.Sub(last.time) < r.interval {
+		return false
+	}
+	if ok && r.dedupe > 0 && last.msg == msg && now.Sub(last.time) < r.dedupe {
+		return false
+	}
+
+	r.sent[key] = sentMessage{time: now, msg: msg}
+
+	return true
+}
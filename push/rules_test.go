@@ -0,0 +1,86 @@
+package push
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRuleConditions(t *testing.T) {
+	r, err := NewRule(RuleConfig{Conditions: []string{"vehicleSoC < 50", "mode == pv"}})
+	require.NoError(t, err)
+
+	ev := Event{Event: "soc"}
+	now := time.Now()
+
+	assert.True(t, r.permits(ev, "telegram", map[string]interface{}{"vehicleSoC": 40.0, "mode": "pv"}, "", now))
+	assert.False(t, r.permits(ev, "telegram", map[string]interface{}{"vehicleSoC": 60.0, "mode": "pv"}, "", now))
+	assert.False(t, r.permits(ev, "telegram", map[string]interface{}{"vehicleSoC": 40.0, "mode": "now"}, "", now))
+	assert.False(t, r.permits(ev, "telegram", map[string]interface{}{"mode": "pv"}, "", now), "missing value")
+
+	_, err = NewRule(RuleConfig{Conditions: []string{"vehicleSoC"}})
+	assert.Error(t, err)
+}
+
+func TestRuleThrottling(t *testing.T) {
+	r, err := NewRule(RuleConfig{Services: []string{"telegram"}, Interval: time.Minute, Dedupe: time.Hour})
+	require.NoError(t, err)
+
+	lp := 0
+	ev := Event{Event: "soc", LoadPoint: &lp}
+	now := time.Now()
+
+	assert.False(t, r.permits(ev, "pushover", nil, "a", now), "service")
+	assert.True(t, r.permits(ev, "telegram", nil, "a", now))
+	assert.False(t, r.permits(ev, "telegram", nil, "b", now.Add(30*time.Second)), "interval")
+	assert.False(t, r.permits(ev, "telegram", nil, "a", now.Add(2*time.Minute)), "dedupe")
+	assert.True(t, r.permits(ev, "telegram", nil, "b", now.Add(2*time.Minute)))
+}
+
+func TestRuleQuietHours(t *testing.T) {
+	r, err := NewRule(RuleConfig{Quiet: "22:00-07:00"})
+	require.NoError(t, err)
+
+	day := time.Date(2022, 6, 1, 0, 0, 0, 0, time.Local)
+
+	assert.True(t, r.inQuietHours(day.Add(23*time.Hour)))
+	assert.True(t, r.inQuietHours(day.Add(6*time.Hour)))
+	assert.False(t, r.inQuietHours(day.Add(7*time.Hour)))
+	assert.False(t, r.inQuietHours(day.Add(12*time.Hour)))
+}
+
+func TestHubPermits(t *testing.T) {
+	h, err := NewHub(nil, []RuleConfig{{Events: []string{"soc"}, Services: []string{"telegram"}}}, nil)
+	require.NoError(t, err)
+
+	now := time.Now()
+
+	assert.True(t, h.permits(Event{Event: "soc"}, "telegram", nil, "", now))
+	assert.False(t, h.permits(Event{Event: "soc"}, "pushover", nil, "", now))
+	assert.True(t, h.permits(Event{Event: "start"}, "pushover", nil, "", now), "no rule for event")
+}
+
+func TestHubDefaultEvents(t *testing.T) {
+	h, err := NewHub(map[string]EventTemplateConfig{
+		"fault": {Title: "custom", Msg: "custom"},
+	}, nil, nil)
+	require.NoError(t, err)
+
+	for event := range defaultEvents {
+		assert.Contains(t, h.definitions, event)
+	}
+
+	title, err := h.apply(nil, h.definitions["fault"].Title)
+	require.NoError(t, err)
+	assert.Equal(t, "custom", title, "configured template overrides default")
+
+	msg, err := h.apply(map[string]interface{}{
+		"title":      "Garage",
+		"targetSoC":  80,
+		"targetTime": time.Date(2022, 7, 1, 7, 30, 0, 0, time.Local),
+	}, h.definitions["targetmiss"].Msg)
+	require.NoError(t, err)
+	assert.Equal(t, "Garage: charging to 80% will not finish by 07:30", msg)
+}
@@ -4,6 +4,7 @@ import (
 	"errors"
 	"sync"
 
+	"github.com/evcc-io/evcc/core/site"
 	tgbotapi "github.com/go-telegram-bot-api/telegram-bot-api/v5"
 )
 
@@ -12,6 +13,8 @@ type Telegram struct {
 	sync.Mutex
 	bot   *tgbotapi.BotAPI
 	chats map[int64]struct{}
+	site  site.API
+	once  sync.Once
 }
 
 type telegramConfig struct {
@@ -46,18 +49,86 @@ func NewTelegramMessenger(token string, chats []int64) (*Telegram, error) {
 	return m, nil
 }
 
-// trackChats captures ids of all chats that bot participates in
+var _ Controller = (*Telegram)(nil)
+
+// Control enables bot commands for controlling the site
+func (m *Telegram) Control(site site.API) {
+	m.Lock()
+	m.site = site
+	m.Unlock()
+}
+
+// Close stops receiving updates. Messages can still be sent.
+func (m *Telegram) Close() error {
+	m.once.Do(m.bot.StopReceivingUpdates)
+	return nil
+}
+
+// trackChats captures ids of all chats that bot participates in and handles commands from configured chats
 func (m *Telegram) trackChats() {
 	conf := tgbotapi.NewUpdate(0)
 	conf.Timeout = 1000
 
 	for update := range m.bot.GetUpdatesChan(conf) {
-		m.Lock()
-		if _, ok := m.chats[update.Message.Chat.ID]; !ok {
-			log.INFO.Printf("telegram: new chat id: %d", update.Message.Chat.ID)
-			// m.chats[update.Message.Chat.ID] = struct{}{}
+		chat, text, keyboard, ok := m.handleUpdate(update)
+		if !ok {
+			continue
 		}
-		m.Unlock()
+
+		if query := update.CallbackQuery; query != nil {
+			if _, err := m.bot.Request(tgbotapi.NewCallback(query.ID, text)); err != nil {
+				log.ERROR.Print(err)
+			}
+		}
+
+		m.reply(chat, text, keyboard)
+	}
+}
+
+// handleUpdate executes commands and callbacks from configured chats and returns the response
+func (m *Telegram) handleUpdate(update tgbotapi.Update) (int64, string, *tgbotapi.InlineKeyboardMarkup, bool) {
+	chat := update.FromChat()
+	if chat == nil {
+		return 0, "", nil, false
+	}
+
+	m.Lock()
+	_, ok := m.chats[chat.ID]
+	site := m.site
+	m.Unlock()
+
+	if !ok {
+		log.INFO.Printf("telegram: new chat id: %d", chat.ID)
+		return 0, "", nil, false
+	}
+
+	if site == nil {
+		return 0, "", nil, false
+	}
+
+	switch {
+	case update.Message != nil && update.Message.IsCommand():
+		log.DEBUG.Printf("telegram: command from %d: %s", chat.ID, update.Message.Text)
+		text, keyboard := command(site, update.Message.Command(), update.Message.CommandArguments())
+		return chat.ID, text, keyboard, true
+
+	case update.CallbackQuery != nil:
+		log.DEBUG.Printf("telegram: callback from %d: %s", chat.ID, update.CallbackQuery.Data)
+		return chat.ID, callback(site, update.CallbackQuery.Data), nil, true
+	}
+
+	return 0, "", nil, false
+}
+
+// reply sends a response to a single chat
+func (m *Telegram) reply(chat int64, text string, keyboard *tgbotapi.InlineKeyboardMarkup) {
+	msg := tgbotapi.NewMessage(chat, text)
+	if keyboard != nil {
+		msg.ReplyMarkup = keyboard
+	}
+
+	if _, err := m.bot.Send(msg); err != nil {
+		log.ERROR.Print(err)
 	}
 }
 
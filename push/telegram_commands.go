@@ -0,0 +1,256 @@
+package push
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/evcc-io/evcc/api"
+	"github.com/evcc-io/evcc/core/loadpoint"
+	"github.com/evcc-io/evcc/core/site"
+	tgbotapi "github.com/go-telegram-bot-api/telegram-bot-api/v5"
+)
+
+const telegramUsage = `/status - show site status
+/mode <loadpoint> [off|now|minpv|pv] - show or set charge mode
+/targetsoc <loadpoint> <soc> - set target soc
+/plan <loadpoint> <hh:mm> <soc> - charge to soc by time
+/plan <loadpoint> off - remove charging plan`
+
+var chargeModes = []api.ChargeMode{api.ModeOff, api.ModeNow, api.ModeMinPV, api.ModePV}
+
+// command executes a bot command and returns the response and optional keyboard
+func command(site site.API, cmd, args string) (string, *tgbotapi.InlineKeyboardMarkup) {
+	fields := strings.Fields(args)
+
+	var (
+		res      string
+		keyboard *tgbotapi.InlineKeyboardMarkup
+		err      error
+	)
+
+	switch strings.ToLower(cmd) {
+	case "status":
+		res, keyboard = status(site), modeKeyboard(site, 0)
+
+	case "mode":
+		res, keyboard, err = modeCommand(site, fields)
+
+	case "targetsoc":
+		res, err = targetSoCCommand(site, fields)
+
+	case "plan":
+		res, err = planCommand(site, fields, time.Now())
+
+	default:
+		res = telegramUsage
+	}
+
+	if err != nil {
+		res = err.Error()
+	}
+
+	return res, keyboard
+}
+
+// callback executes an inline keyboard action
+func callback(site site.API, data string) string {
+	segs := strings.Split(data, ":")
+	if len(segs) != 3 || segs[0] != "mode" {
+		return "invalid action"
+	}
+
+	res, _, err := modeCommand(site, segs[1:])
+	if err != nil {
+		return err.Error()
+	}
+
+	return res
+}
+
+// loadpointByID returns the loadpoint for the 1-based id
+func loadpointByID(site site.API, id string) (int, loadpoint.API, error) {
+	lps := site.LoadPoints()
+
+	i, err := strconv.Atoi(id)
+	if err != nil || i < 1 || i > len(lps) {
+		return 0, nil, fmt.Errorf("invalid loadpoint: %s", id)
+	}
+
+	return i, lps[i-1], nil
+}
+
+func status(site site.API) string {
+	var b strings.Builder
+
+	for i, lp := range site.LoadPoints() {
+		fmt.Fprintf(&b, "%d %s: %s, ", i+1, lp.Name(), lp.GetMode())
+
+		switch lp.GetStatus() {
+		case api.StatusA:
+			b.WriteString("disconnected")
+		case api.StatusC:
+			fmt.Fprintf(&b, "charging %.1fkW", lp.GetChargePower()/1e3)
+		default:
+			b.WriteString("connected")
+		}
+
+		if soc := lp.GetVehicleSoC(); soc > 0 {
+			fmt.Fprintf(&b, ", soc %.0f%%", soc)
+		}
+
+		fmt.Fprintf(&b, ", target %d%%", lp.GetTargetSoC())
+
+		if ts := lp.GetTargetTime(); !ts.IsZero() {
+			fmt.Fprintf(&b, " by %s", ts.Format("Mon 15:04"))
+		}
+
+		b.WriteString("\n")
+	}
+
+	if limit := site.GetPowerLimit(); limit > 0 {
+		fmt.Fprintf(&b, "power limit: %.1fkW\n", limit/1e3)
+	}
+
+	if !site.Healthy() {
+		b.WriteString("site unhealthy\n")
+	}
+
+	return strings.TrimSpace(b.String())
+}
+
+// modeKeyboard creates mode buttons for the given loadpoint or all loadpoints if id is zero
+func modeKeyboard(site site.API, id int) *tgbotapi.InlineKeyboardMarkup {
+	var rows [][]tgbotapi.InlineKeyboardButton
+
+	for i := range site.LoadPoints() {
+		if id != 0 && id != i+1 {
+			continue
+		}
+
+		var row []tgbotapi.InlineKeyboardButton
+		for _, mode := range chargeModes {
+			text := string(mode)
+			if id == 0 {
+				text = fmt.Sprintf("%d: %s", i+1, mode)
+			}
+
+			row = append(row, tgbotapi.NewInlineKeyboardButtonData(text, fmt.Sprintf("mode:%d:%s", i+1, mode)))
+		}
+
+		rows = append(rows, row)
+	}
+
+	if len(rows) == 0 {
+		return nil
+	}
+
+	keyboard := tgbotapi.NewInlineKeyboardMarkup(rows...)
+	return &keyboard
+}
+
+func modeCommand(site site.API, args []string) (string, *tgbotapi.InlineKeyboardMarkup, error) {
+	if len(args) < 1 {
+		return "", nil, errors.New("usage: /mode <loadpoint> [mode]")
+	}
+
+	id, lp, err := loadpointByID(site, args[0])
+	if err != nil {
+		return "", nil, err
+	}
+
+	if len(args) == 1 {
+		return fmt.Sprintf("%d %s: %s", id, lp.Name(), lp.GetMode()), modeKeyboard(site, id), nil
+	}
+
+	mode, err := api.ChargeModeString(args[1])
+	if err != nil {
+		return "", nil, err
+	}
+
+	lp.SetMode(mode)
+
+	return fmt.Sprintf("%d %s: mode %s", id, lp.Name(), mode), nil, nil
+}
+
+func targetSoCCommand(site site.API, args []string) (string, error) {
+	if len(args) != 2 {
+		return "", errors.New("usage: /targetsoc <loadpoint> <soc>")
+	}
+
+	id, lp, err := loadpointByID(site, args[0])
+	if err != nil {
+		return "", err
+	}
+
+	soc, err := parseSoC(args[1])
+	if err != nil {
+		return "", err
+	}
+
+	lp.SetTargetSoC(soc)
+
+	return fmt.Sprintf("%d %s: target soc %d%%", id, lp.Name(), soc), nil
+}
+
+func planCommand(site site.API, args []string, now time.Time) (string, error) {
+	if len(args) == 2 && strings.EqualFold(args[1], "off") {
+		id, lp, err := loadpointByID(site, args[0])
+		if err != nil {
+			return "", err
+		}
+
+		lp.SetTargetCharge(time.Time{}, lp.GetTargetSoC())
+
+		return fmt.Sprintf("%d %s: plan removed", id, lp.Name()), nil
+	}
+
+	if len(args) != 3 {
+		return "", errors.New("usage: /plan <loadpoint> <hh:mm> <soc>")
+	}
+
+	id, lp, err := loadpointByID(site, args[0])
+	if err != nil {
+		return "", err
+	}
+
+	ts, err := parsePlanTime(args[1], now)
+	if err != nil {
+		return "", err
+	}
+
+	soc, err := parseSoC(args[2])
+	if err != nil {
+		return "", err
+	}
+
+	lp.SetTargetCharge(ts, soc)
+
+	return fmt.Sprintf("%d %s: charge to %d%% by %s", id, lp.Name(), soc, ts.Format("Mon 15:04")), nil
+}
+
+func parseSoC(s string) (int, error) {
+	soc, err := strconv.Atoi(strings.TrimSuffix(s, "%"))
+	if err != nil || soc < 0 || soc > 100 {
+		return 0, fmt.Errorf("invalid soc: %s", s)
+	}
+
+	return soc, nil
+}
+
+// parsePlanTime returns the next occurrence of the hh:mm time of day
+func parsePlanTime(s string, now time.Time) (time.Time, error) {
+	t, err := time.ParseInLocation("15:04", s, now.Location())
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid time: %s", s)
+	}
+
+	ts := time.Date(now.Year(), now.Month(), now.Day(), t.Hour(), t.Minute(), 0, 0, now.Location())
+	if !ts.After(now) {
+		ts = ts.AddDate(0, 0, 1)
+	}
+
+	return ts, nil
+}
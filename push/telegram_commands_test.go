@@ -0,0 +1,197 @@
+package push
+
+import (
+	"testing"
+	"time"
+
+	"github.com/evcc-io/evcc/api"
+	"github.com/evcc-io/evcc/core/loadpoint"
+	"github.com/evcc-io/evcc/core/site"
+	tgbotapi "github.com/go-telegram-bot-api/telegram-bot-api/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testSite struct {
+	site.API
+	lps []loadpoint.API
+}
+
+func (s *testSite) LoadPoints() []loadpoint.API { return s.lps }
+
+type testLoadpoint struct {
+	loadpoint.API
+	mode       api.ChargeMode
+	targetSoC  int
+	targetTime time.Time
+}
+
+func (lp *testLoadpoint) Name() string                { return "garage" }
+func (lp *testLoadpoint) GetMode() api.ChargeMode     { return lp.mode }
+func (lp *testLoadpoint) SetMode(mode api.ChargeMode) { lp.mode = mode }
+func (lp *testLoadpoint) GetTargetSoC() int           { return lp.targetSoC }
+func (lp *testLoadpoint) SetTargetSoC(soc int)        { lp.targetSoC = soc }
+func (lp *testLoadpoint) SetTargetCharge(ts time.Time, soc int) {
+	lp.targetTime, lp.targetSoC = ts, soc
+}
+
+func commandUpdate(chat int64, text string, length int) tgbotapi.Update {
+	return tgbotapi.Update{
+		Message: &tgbotapi.Message{
+			Chat:     &tgbotapi.Chat{ID: chat},
+			Text:     text,
+			Entities: []tgbotapi.MessageEntity{{Type: "bot_command", Length: length}},
+		},
+	}
+}
+
+func TestParsePlanTime(t *testing.T) {
+	now := time.Date(2022, 6, 1, 12, 0, 0, 0, time.Local)
+
+	tc := []struct {
+		in  string
+		out time.Time
+	}{
+		{"13:30", time.Date(2022, 6, 1, 13, 30, 0, 0, time.Local)},
+		{"07:00", time.Date(2022, 6, 2, 7, 0, 0, 0, time.Local)},
+		{"12:00", time.Date(2022, 6, 2, 12, 0, 0, 0, time.Local)},
+	}
+
+	for _, tc := range tc {
+		res, err := parsePlanTime(tc.in, now)
+		assert.NoError(t, err, tc.in)
+		assert.Equal(t, tc.out, res, tc.in)
+	}
+
+	_, err := parsePlanTime("7am", now)
+	assert.Error(t, err)
+}
+
+func TestParseSoC(t *testing.T) {
+	soc, err := parseSoC("80%")
+	assert.NoError(t, err)
+	assert.Equal(t, 80, soc)
+
+	_, err = parseSoC("120")
+	assert.Error(t, err)
+}
+
+func TestCommandMode(t *testing.T) {
+	lp := &testLoadpoint{mode: api.ModeOff}
+	site := &testSite{lps: []loadpoint.API{lp}}
+
+	res, keyboard := command(site, "mode", "1")
+	assert.Equal(t, "1 garage: off", res)
+	require.NotNil(t, keyboard)
+	assert.Len(t, keyboard.InlineKeyboard[0], len(chargeModes))
+
+	res, keyboard = command(site, "mode", "1 pv")
+	assert.Equal(t, "1 garage: mode pv", res)
+	assert.Nil(t, keyboard)
+	assert.Equal(t, api.ModePV, lp.mode)
+
+	res, _ = command(site, "mode", "2 pv")
+	assert.Equal(t, "invalid loadpoint: 2", res)
+
+	res, _ = command(site, "mode", "1 foo")
+	assert.Equal(t, api.ModePV, lp.mode)
+	assert.NotEmpty(t, res)
+}
+
+func TestCommandTargetSoC(t *testing.T) {
+	lp := &testLoadpoint{targetSoC: 100}
+	site := &testSite{lps: []loadpoint.API{lp}}
+
+	res, _ := command(site, "targetsoc", "1 80%")
+	assert.Equal(t, "1 garage: target soc 80%", res)
+	assert.Equal(t, 80, lp.targetSoC)
+
+	res, _ = command(site, "targetsoc", "1 120")
+	assert.Equal(t, "invalid soc: 120", res)
+	assert.Equal(t, 80, lp.targetSoC)
+}
+
+func TestCommandPlan(t *testing.T) {
+	lp := &testLoadpoint{targetSoC: 100}
+	site := &testSite{lps: []loadpoint.API{lp}}
+
+	res, _ := command(site, "plan", "1 07:30 60")
+	assert.Contains(t, res, "1 garage: charge to 60% by")
+	assert.Equal(t, 60, lp.targetSoC)
+	assert.True(t, lp.targetTime.After(time.Now()))
+	assert.Equal(t, 7, lp.targetTime.Hour())
+	assert.Equal(t, 30, lp.targetTime.Minute())
+
+	res, _ = command(site, "plan", "1 off")
+	assert.Equal(t, "1 garage: plan removed", res)
+	assert.True(t, lp.targetTime.IsZero())
+	assert.Equal(t, 60, lp.targetSoC)
+
+	res, _ = command(site, "plan", "1 7am 60")
+	assert.Equal(t, "invalid time: 7am", res)
+}
+
+func TestCommandUsage(t *testing.T) {
+	res, keyboard := command(&testSite{}, "foo", "")
+	assert.Equal(t, telegramUsage, res)
+	assert.Nil(t, keyboard)
+}
+
+func TestCallback(t *testing.T) {
+	lp := &testLoadpoint{mode: api.ModeOff}
+	site := &testSite{lps: []loadpoint.API{lp}}
+
+	assert.Equal(t, "1 garage: mode now", callback(site, "mode:1:now"))
+	assert.Equal(t, api.ModeNow, lp.mode)
+
+	assert.Equal(t, "invalid action", callback(site, "foo:1:now"))
+	assert.Equal(t, "invalid action", callback(site, "mode:1"))
+	assert.Equal(t, "invalid loadpoint: 0", callback(site, "mode:0:pv"))
+	assert.Equal(t, api.ModeNow, lp.mode)
+}
+
+func TestTelegramChatAllowList(t *testing.T) {
+	lp := &testLoadpoint{mode: api.ModeOff}
+
+	m := &Telegram{
+		chats: map[int64]struct{}{1: {}},
+		site:  &testSite{lps: []loadpoint.API{lp}},
+	}
+
+	// unknown chat
+	_, _, _, ok := m.handleUpdate(commandUpdate(2, "/mode 1 now", 5))
+	assert.False(t, ok)
+	assert.Equal(t, api.ModeOff, lp.mode)
+
+	_, _, _, ok = m.handleUpdate(tgbotapi.Update{
+		CallbackQuery: &tgbotapi.CallbackQuery{
+			Message: &tgbotapi.Message{Chat: &tgbotapi.Chat{ID: 2}},
+			Data:    "mode:1:now",
+		},
+	})
+	assert.False(t, ok)
+	assert.Equal(t, api.ModeOff, lp.mode)
+
+	// configured chat
+	chat, res, _, ok := m.handleUpdate(commandUpdate(1, "/mode 1 now", 5))
+	assert.True(t, ok)
+	assert.Equal(t, int64(1), chat)
+	assert.Equal(t, "1 garage: mode now", res)
+	assert.Equal(t, api.ModeNow, lp.mode)
+
+	chat, res, _, ok = m.handleUpdate(tgbotapi.Update{
+		CallbackQuery: &tgbotapi.CallbackQuery{
+			Message: &tgbotapi.Message{Chat: &tgbotapi.Chat{ID: 1}},
+			Data:    "mode:1:pv",
+		},
+	})
+	assert.True(t, ok)
+	assert.Equal(t, int64(1), chat)
+	assert.Equal(t, "1 garage: mode pv", res)
+	assert.Equal(t, api.ModePV, lp.mode)
+
+	// no site control
+	m.site = nil
+	_, _, _, ok = m.handleUpdate(commandUpdate(1, "/mode 1 now", 5))
+	assert.False(t, ok)
+}
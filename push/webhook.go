@@ -0,0 +1,105 @@
+package push
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/Masterminds/sprig/v3"
+	"github.com/evcc-io/evcc/util"
+	"github.com/evcc-io/evcc/util/request"
+)
+
+// Webhook implements the generic http messenger
+type Webhook struct {
+	*request.Helper
+	uri, method string
+	headers     map[string]string
+	body        *template.Template
+}
+
+type webhookConfig struct {
+	URI, Method string
+	Headers     map[string]string
+	Body        string // body template, defaults to the event json
+	Timeout     time.Duration
+}
+
+// NewWebhookMessenger creates new webhook messenger
+func NewWebhookMessenger(uri, method string, headers map[string]string, body string, timeout time.Duration) (*Webhook, error) {
+	if uri == "" {
+		return nil, errors.New("webhook: missing uri")
+	}
+
+	m := &Webhook{
+		Helper:  request.NewHelper(util.NewLogger("webhook")),
+		uri:     util.DefaultScheme(uri, "http"),
+		method:  strings.ToUpper(method),
+		headers: map[string]string{"Content-Type": request.JSONContent},
+	}
+
+	m.Client.Timeout = timeout
+
+	for k, v := range headers {
+		m.headers[k] = v
+	}
+
+	if body != "" {
+		tmpl, err := template.New("body").Funcs(template.FuncMap(sprig.FuncMap())).Parse(body)
+		if err != nil {
+			return nil, err
+		}
+
+		m.body = tmpl
+	}
+
+	return m, nil
+}
+
+// Send sends a plain message
+func (m *Webhook) Send(title, msg string) {
+	m.SendEvent(EventMessage{Title: title, Msg: msg, Time: time.Now()})
+}
+
+// SendEvent sends the structured event
+func (m *Webhook) SendEvent(msg EventMessage) {
+	body, err := m.render(msg)
+	if err == nil {
+		var req *http.Request
+		if req, err = request.New(m.method, m.uri, bytes.NewReader(body), m.headers); err == nil {
+			_, err = m.DoBody(req)
+		}
+	}
+
+	if err != nil {
+		log.ERROR.Printf("webhook: %v", err)
+	}
+}
+
+// render creates the request body from the template or as json if no template is configured.
+// Templates can access the event attributes and the event, loadpoint, title, msg and time fields.
+func (m *Webhook) render(msg EventMessage) ([]byte, error) {
+	if m.body == nil {
+		return json.Marshal(msg)
+	}
+
+	data := make(map[string]interface{}, len(msg.Attributes)+5)
+	for k, v := range msg.Attributes {
+		data[k] = v
+	}
+
+	data["event"] = msg.Event
+	data["loadpoint"] = msg.LoadPoint
+	data["title"] = msg.Title
+	data["msg"] = msg.Msg
+	data["time"] = msg.Time
+
+	var b bytes.Buffer
+	err := m.body.Execute(&b, data)
+
+	return b.Bytes(), err
+}
@@ -0,0 +1,63 @@
+package push
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWebhook(t *testing.T) {
+	type request struct {
+		method, auth string
+		body         []byte
+	}
+
+	reqC := make(chan request, 1)
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		b, _ := io.ReadAll(r.Body)
+		reqC <- request{method: r.Method, auth: r.Header.Get("Authorization"), body: b}
+	}))
+	defer srv.Close()
+
+	msg := EventMessage{
+		Event:      "start",
+		LoadPoint:  1,
+		Title:      "Charge started",
+		Msg:        "Started charging",
+		Time:       time.Now(),
+		Attributes: map[string]interface{}{"mode": "pv"},
+	}
+
+	// default json body
+	m, err := NewWebhookMessenger(srv.URL, "post", map[string]string{"Authorization": "Bearer foo"}, "", time.Second)
+	require.NoError(t, err)
+
+	m.SendEvent(msg)
+	req := <-reqC
+
+	assert.Equal(t, http.MethodPost, req.method)
+	assert.Equal(t, "Bearer foo", req.auth)
+
+	var res map[string]interface{}
+	require.NoError(t, json.Unmarshal(req.body, &res))
+	assert.Equal(t, "start", res["event"])
+	assert.Equal(t, float64(1), res["loadpoint"])
+	assert.Equal(t, map[string]interface{}{"mode": "pv"}, res["attributes"])
+
+	// body template
+	m, err = NewWebhookMessenger(srv.URL, "PUT", nil, `{"text":{{ .msg | toJson }},"mode":"{{ .mode }}","lp":{{ .loadpoint }}}`, time.Second)
+	require.NoError(t, err)
+
+	m.SendEvent(msg)
+	req = <-reqC
+
+	assert.Equal(t, http.MethodPut, req.method)
+	assert.JSONEq(t, `{"text":"Started charging","mode":"pv","lp":1}`, string(req.body))
+}
@@ -0,0 +1,406 @@
+package server
+
+import (
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Role is the access level of a client
+type Role int
+
+// Roles
+const (
+	RoleNone    Role = iota // no access
+	RoleRead                // read-only access
+	RoleControl             // full access
+)
+
+var roles = []string{"none", "read", "control"}
+
+func (r Role) String() string {
+	if int(r) < len(roles) {
+		return roles[r]
+	}
+	return fmt.Sprintf("Role(%d)", r)
+}
+
+// RoleString converts string to Role
+func RoleString(s string) (Role, error) {
+	for i, name := range roles {
+		if strings.EqualFold(name, s) {
+			return Role(i), nil
+		}
+	}
+	return RoleNone, fmt.Errorf("invalid role: %s", s)
+}
+
+const sessionCookie = "evcc_session"
+
+// AuthConfig is the web ui and api authentication configuration
+type AuthConfig struct {
+	Password       string            // admin password, empty to disable login
+	Public         string            // role for unauthenticated clients if authentication is enabled: none (default) or read
+	Tokens         []AuthTokenConfig // api tokens
+	Origins        []string          // allowed CORS and websocket origins, empty to allow all
+	SessionTimeout time.Duration     // session lifetime
+	ConfigEditor   bool              // enable configuration editor without authentication
+}
+
+// AuthTokenConfig is an api token with its role
+type AuthTokenConfig struct {
+	Token string
+	Role  string // read or control (default)
+}
+
+// Auth authenticates web ui and api clients
+type Auth struct {
+	mu       sync.Mutex
+	password string
+	public   Role
+	tokens   map[string]Role
+	origins  []string
+	timeout  time.Duration
+	sessions map[string]time.Time // session expiry by session id
+}
+
+// NewAuth creates the authenticator from config
+func NewAuth(cc AuthConfig) (*Auth, error) {
+	a := &Auth{
+		password: cc.Password,
+		tokens:   make(map[string]Role),
+		origins:  cc.Origins,
+		timeout:  cc.SessionTimeout,
+		sessions: make(map[string]time.Time),
+	}
+
+	if a.timeout == 0 {
+		a.timeout = 30 * 24 * time.Hour
+	}
+
+	if cc.Public != "" {
+		role, err := RoleString(cc.Public)
+		if err != nil || role == RoleControl {
+			return nil, fmt.Errorf("invalid public role: %s", cc.Public)
+		}
+		a.public = role
+	}
+
+	for _, t := range cc.Tokens {
+		if t.Token == "" {
+			return nil, errors.New("missing token")
+		}
+
+		role := RoleControl
+		if t.Role != "" {
+			var err error
+			if role, err = RoleString(t.Role); err != nil || role == RoleNone {
+				return nil, fmt.Errorf("invalid token role: %s", t.Role)
+			}
+		}
+
+		a.tokens[t.Token] = role
+	}
+
+	return a, nil
+}
+
+// Enabled returns true if clients need to authenticate
+func (a *Auth) Enabled() bool {
+	return a != nil && (a.password != "" || len(a.tokens) > 0)
+}
+
+// bearer returns the request's api token
+func bearer(r *http.Request) string {
+	if h := r.Header.Get("Authorization"); strings.HasPrefix(h, "Bearer ") {
+		return strings.TrimPrefix(h, "Bearer ")
+	}
+
+	// browsers can't send headers when opening websockets
+	if r.URL.Path == "/ws" {
+		return r.URL.Query().Get("token")
+	}
+
+	return ""
+}
+
+// Role returns the request's role
+func (a *Auth) Role(r *http.Request) Role {
+	if !a.Enabled() {
+		return RoleControl
+	}
+
+	if token := bearer(r); token != "" {
+		for t, role := range a.tokens {
+			if subtle.ConstantTimeCompare([]byte(t), []byte(token)) == 1 {
+				return role
+			}
+		}
+	}
+
+	if c, err := r.Cookie(sessionCookie); err == nil && a.validSession(c.Value) {
+		return RoleControl
+	}
+
+	return a.public
+}
+
+func (a *Auth) validSession(id string) bool {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	exp, ok := a.sessions[id]
+	if ok && time.Now().After(exp) {
+		delete(a.sessions, id)
+		ok = false
+	}
+
+	return ok
+}
+
+func (a *Auth) newSession() (string, time.Time, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", time.Time{}, err
+	}
+
+	id := hex.EncodeToString(b)
+	now := time.Now()
+	exp := now.Add(a.timeout)
+
+	a.mu.Lock()
+	// prune sessions that expired without being presented again
+	for sid, sexp := range a.sessions {
+		if now.After(sexp) {
+			delete(a.sessions, sid)
+		}
+	}
+	a.sessions[id] = exp
+	a.mu.Unlock()
+
+	return id, exp, nil
+}
+
+var (
+	// publicPaths and publicPrefixes are accessible without authentication
+	publicPaths    = []string{"/", "/login", "/api/health"}
+	publicPrefixes = []string{"/assets/", "/meta/", "/api/auth/"}
+)
+
+// public returns true if the path is accessible without authentication
+func public(path string) bool {
+	for _, p := range publicPaths {
+		if path == p {
+			return true
+		}
+	}
+
+	for _, p := range publicPrefixes {
+		if strings.HasPrefix(path, p) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// requiredRole returns the role required for the request or RoleNone for public resources
+func requiredRole(r *http.Request) Role {
+	switch {
+	case r.Method == http.MethodOptions:
+		return RoleNone
+	case public(r.URL.Path):
+		return RoleNone
+	case r.Method == http.MethodGet || r.Method == http.MethodHead:
+		return RoleRead
+	default:
+		return RoleControl
+	}
+}
+
+// Middleware enforces authentication and roles for the api and websocket
+func (a *Auth) Middleware(h http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		required := requiredRole(r)
+		if required == RoleNone {
+			h.ServeHTTP(w, r)
+			return
+		}
+
+		switch role := a.Role(r); {
+		case role >= required:
+			h.ServeHTTP(w, r)
+		case role == RoleNone:
+			w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+			jsonError(w, http.StatusUnauthorized, errors.New("unauthorized"))
+		default:
+			w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+			jsonError(w, http.StatusForbidden, fmt.Errorf("forbidden for role %s", role))
+		}
+	})
+}
+
+// CheckOrigin returns true if the request's origin is allowed
+func (a *Auth) CheckOrigin(r *http.Request) bool {
+	if a == nil || len(a.origins) == 0 {
+		return true
+	}
+
+	origin := r.Header.Get("Origin")
+	if origin == "" {
+		return true
+	}
+
+	if u, err := url.Parse(origin); err == nil && strings.EqualFold(u.Host, r.Host) {
+		return true
+	}
+
+	for _, o := range a.origins {
+		if strings.EqualFold(strings.TrimSuffix(o, "/"), origin) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Origins returns the allowed CORS origins
+func (a *Auth) Origins() []string {
+	if a == nil {
+		return nil
+	}
+	return a.origins
+}
+
+// loginHandler validates the admin password and creates a session.
+// Form posts are redirected to the ui, json requests receive the role.
+func (a *Auth) loginHandler(w http.ResponseWriter, r *http.Request) {
+	form := strings.HasPrefix(r.Header.Get("Content-Type"), "application/x-www-form-urlencoded")
+
+	var req struct {
+		Password string `json:"password"`
+	}
+
+	if form {
+		req.Password = r.PostFormValue("password")
+	} else if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		jsonError(w, http.StatusBadRequest, fmt.Errorf("invalid request body: %w", err))
+		return
+	}
+
+	if a.password == "" || subtle.ConstantTimeCompare([]byte(a.password), []byte(req.Password)) != 1 {
+		log.WARN.Printf("httpd: failed login from %s", r.RemoteAddr)
+
+		if form {
+			http.Redirect(w, r, "/login?failed", http.StatusSeeOther)
+			return
+		}
+
+		jsonError(w, http.StatusUnauthorized, errors.New("invalid password"))
+		return
+	}
+
+	id, exp, err := a.newSession()
+	if err != nil {
+		jsonError(w, http.StatusInternalServerError, err)
+		return
+	}
+
+	http.SetCookie(w, &http.Cookie{
+		Name:     sessionCookie,
+		Value:    id,
+		Path:     "/",
+		Expires:  exp,
+		HttpOnly: true,
+		SameSite: http.SameSiteStrictMode,
+	})
+
+	if form {
+		http.Redirect(w, r, "/", http.StatusSeeOther)
+		return
+	}
+
+	jsonResult(w, authStatus{Enabled: true, Role: RoleControl.String()})
+}
+
+// logoutHandler removes the session
+func (a *Auth) logoutHandler(w http.ResponseWriter, r *http.Request) {
+	if c, err := r.Cookie(sessionCookie); err == nil {
+		a.mu.Lock()
+		delete(a.sessions, c.Value)
+		a.mu.Unlock()
+	}
+
+	http.SetCookie(w, &http.Cookie{
+		Name:     sessionCookie,
+		Path:     "/",
+		MaxAge:   -1,
+		HttpOnly: true,
+		SameSite: http.SameSiteStrictMode,
+	})
+
+	jsonResult(w, struct{}{})
+}
+
+type authStatus struct {
+	Enabled bool   `json:"enabled"`
+	Role    string `json:"role"`
+}
+
+// statusHandler returns the client's role
+func (a *Auth) statusHandler(w http.ResponseWriter, r *http.Request) {
+	jsonResult(w, authStatus{Enabled: a.Enabled(), Role: a.Role(r).String()})
+}
+
+// indexGuard redirects unauthenticated ui clients to the login page
+func (a *Auth) indexGuard(h http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if a.password != "" && a.Role(r) == RoleNone {
+			http.Redirect(w, r, "/login", http.StatusSeeOther)
+			return
+		}
+
+		h(w, r)
+	}
+}
+
+const loginPage = `<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8">
+<meta name="viewport" content="width=device-width, initial-scale=1">
+<title>evcc login</title>
+<style>
+body { font-family: sans-serif; display: flex; justify-content: center; margin-top: 20vh; }
+form { display: flex; flex-direction: column; gap: 0.5em; }
+.error { color: #c00; }
+</style>
+</head>
+<body>
+<form method="post" action="/api/auth/login">
+<h1>evcc</h1>
+%s<input type="password" name="password" placeholder="Password" autofocus>
+<button type="submit">Login</button>
+</form>
+</body>
+</html>
+`
+
+// loginPageHandler serves the login page
+func loginPageHandler(w http.ResponseWriter, r *http.Request) {
+	var msg string
+	if _, failed := r.URL.Query()["failed"]; failed {
+		msg = `<div class="error">Invalid password</div>`
+	}
+
+	w.Header().Set("Content-Type", "text/html; charset=UTF-8")
+	fmt.Fprintf(w, loginPage, msg)
+}
@@ -0,0 +1,184 @@
+package server
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/evcc-io/evcc/api"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewAuth(t *testing.T) {
+	_, err := NewAuth(AuthConfig{Public: "control"})
+	assert.Error(t, err)
+
+	_, err = NewAuth(AuthConfig{Tokens: []AuthTokenConfig{{Token: "t", Role: "none"}}})
+	assert.Error(t, err)
+
+	_, err = NewAuth(AuthConfig{Tokens: []AuthTokenConfig{{Role: "read"}}})
+	assert.Error(t, err)
+
+	a, err := NewAuth(AuthConfig{})
+	require.NoError(t, err)
+	assert.False(t, a.Enabled())
+
+	var nilAuth *Auth
+	assert.Equal(t, RoleControl, nilAuth.Role(httptest.NewRequest(http.MethodGet, "/api/state", nil)))
+}
+
+func TestAuthRole(t *testing.T) {
+	a, err := NewAuth(AuthConfig{
+		Public: "read",
+		Tokens: []AuthTokenConfig{
+			{Token: "reader", Role: "read"},
+			{Token: "admin"},
+		},
+	})
+	require.NoError(t, err)
+
+	req := func(token string) *http.Request {
+		r := httptest.NewRequest(http.MethodGet, "/api/state", nil)
+		if token != "" {
+			r.Header.Set("Authorization", "Bearer "+token)
+		}
+		return r
+	}
+
+	assert.Equal(t, RoleRead, a.Role(req("")))
+	assert.Equal(t, RoleRead, a.Role(req("reader")))
+	assert.Equal(t, RoleControl, a.Role(req("admin")))
+	assert.Equal(t, RoleRead, a.Role(req("invalid")))
+
+	// query token only for websocket
+	assert.Equal(t, RoleControl, a.Role(httptest.NewRequest(http.MethodGet, "/ws?token=admin", nil)))
+	assert.Equal(t, RoleRead, a.Role(httptest.NewRequest(http.MethodGet, "/api/state?token=admin", nil)))
+}
+
+func TestAuthSessionPrune(t *testing.T) {
+	a, err := NewAuth(AuthConfig{})
+	require.NoError(t, err)
+
+	a.sessions["expired"] = time.Now().Add(-time.Minute)
+
+	id, _, err := a.newSession()
+	require.NoError(t, err)
+
+	assert.NotContains(t, a.sessions, "expired")
+	assert.Contains(t, a.sessions, id)
+}
+
+func TestAuthMiddleware(t *testing.T) {
+	a, err := NewAuth(AuthConfig{
+		Tokens: []AuthTokenConfig{{Token: "reader", Role: "read"}},
+	})
+	require.NoError(t, err)
+
+	h := a.Middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+
+	tc := []struct {
+		method, path, token string
+		status              int
+	}{
+		{http.MethodGet, "/", "", http.StatusOK},
+		{http.MethodGet, "/login", "", http.StatusOK},
+		{http.MethodGet, "/assets/js/index.js", "", http.StatusOK},
+		{http.MethodGet, "/meta/favicon.ico", "", http.StatusOK},
+		{http.MethodGet, "/api/health", "", http.StatusOK},
+		{http.MethodPost, "/api/auth/login", "", http.StatusOK},
+		{http.MethodGet, "/api/state", "", http.StatusUnauthorized},
+		{http.MethodGet, "/ws", "", http.StatusUnauthorized},
+		{http.MethodGet, "/metrics", "", http.StatusUnauthorized},
+		{http.MethodGet, "/debug/pprof/", "", http.StatusUnauthorized},
+		{http.MethodGet, "/oauth/callback", "", http.StatusUnauthorized},
+		{http.MethodGet, "/foo", "", http.StatusUnauthorized},
+		{http.MethodGet, "/debug/pprof/", "reader", http.StatusOK},
+		{http.MethodGet, "/api/state", "reader", http.StatusOK},
+		{http.MethodPost, "/api/buffersoc/50", "reader", http.StatusForbidden},
+		{http.MethodOptions, "/api/buffersoc/50", "", http.StatusOK},
+	}
+
+	for _, tc := range tc {
+		r := httptest.NewRequest(tc.method, tc.path, nil)
+		if tc.token != "" {
+			r.Header.Set("Authorization", "Bearer "+tc.token)
+		}
+
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, r)
+
+		assert.Equal(t, tc.status, w.Code, "%s %s", tc.method, tc.path)
+	}
+}
+
+func TestAuthLogin(t *testing.T) {
+	a, err := NewAuth(AuthConfig{Password: "secret"})
+	require.NoError(t, err)
+
+	lp := &httpLoadpoint{mode: api.ModeOff}
+	router := NewHTTPd(":0", &httpSite{lp: lp}, nil, nil, a).Router()
+
+	serve := func(r *http.Request) *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, r)
+		return w
+	}
+
+	// ui redirects to login
+	w := serve(httptest.NewRequest(http.MethodGet, "/", nil))
+	assert.Equal(t, http.StatusSeeOther, w.Code)
+	assert.Equal(t, "/login", w.Header().Get("Location"))
+
+	w = serve(httptest.NewRequest(http.MethodPost, "/api/loadpoints/0/mode/pv", nil))
+	assert.Equal(t, http.StatusUnauthorized, w.Code)
+
+	// invalid password
+	w = serve(httptest.NewRequest(http.MethodPost, "/api/auth/login", strings.NewReader(`{"password":"wrong"}`)))
+	assert.Equal(t, http.StatusUnauthorized, w.Code)
+
+	// login
+	w = serve(httptest.NewRequest(http.MethodPost, "/api/auth/login", strings.NewReader(`{"password":"secret"}`)))
+	require.Equal(t, http.StatusOK, w.Code)
+
+	cookies := w.Result().Cookies()
+	require.Len(t, cookies, 1)
+	assert.Equal(t, sessionCookie, cookies[0].Name)
+	assert.True(t, cookies[0].HttpOnly)
+
+	r := httptest.NewRequest(http.MethodPost, "/api/loadpoints/0/mode/pv", nil)
+	r.AddCookie(cookies[0])
+	w = serve(r)
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, api.ModePV, lp.mode)
+
+	// logout
+	r = httptest.NewRequest(http.MethodPost, "/api/auth/logout", nil)
+	r.AddCookie(cookies[0])
+	serve(r)
+
+	r = httptest.NewRequest(http.MethodPost, "/api/loadpoints/0/mode/off", nil)
+	r.AddCookie(cookies[0])
+	w = serve(r)
+	assert.Equal(t, http.StatusUnauthorized, w.Code)
+}
+
+func TestAuthCheckOrigin(t *testing.T) {
+	a, err := NewAuth(AuthConfig{Origins: []string{"https://dashboard.example.com/"}})
+	require.NoError(t, err)
+
+	req := func(origin string) *http.Request {
+		r := httptest.NewRequest(http.MethodGet, "http://evcc.local:7070/ws", nil)
+		if origin != "" {
+			r.Header.Set("Origin", origin)
+		}
+		return r
+	}
+
+	assert.True(t, a.CheckOrigin(req("")))
+	assert.True(t, a.CheckOrigin(req("http://evcc.local:7070")))
+	assert.True(t, a.CheckOrigin(req("https://dashboard.example.com")))
+	assert.False(t, a.CheckOrigin(req("https://evil.example.com")))
+}
@@ -222,9 +222,11 @@ func (c *EEBus) discoverDNS(results <-chan *zeroconf.ServiceEntry, connector fun
 	for entry := range results {
 		c.log.TRACE.Println("mDNS:", entry.HostName, entry.AddrIPv4, entry.Text)
 
+		// accept all types, connection requires the ski to be registered (e.g. EVSE or control box)
 		for _, typ := range entry.Text {
-			if strings.HasPrefix(typ, "type=") && typ == "type=EVSE" {
+			if strings.HasPrefix(typ, "type=") {
 				connector(entry)
+				break
 			}
 		}
 	}
@@ -0,0 +1,82 @@
+package history
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/evcc-io/evcc/util"
+)
+
+// recorded site and loadpoint values
+var (
+	siteKeys      = []string{"gridPower", "pvPower", "batteryPower", "homePower", "batterySoC", "gridEnergy"}
+	loadpointKeys = []string{"chargePower", "chargedEnergy", "vehicleSoC"}
+)
+
+// Key returns the history key of a site or loadpoint value, loadpoint ids start at 1
+func Key(p util.Param) string {
+	if p.LoadPoint == nil {
+		return p.Key
+	}
+	return fmt.Sprintf("lp%d.%s", *p.LoadPoint+1, p.Key)
+}
+
+func recorded(p util.Param) bool {
+	keys := siteKeys
+	if p.LoadPoint != nil {
+		keys = loadpointKeys
+	}
+
+	for _, k := range keys {
+		if k == p.Key {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Run records the values received from the channel and saves the store periodically
+func (s *Store) Run(in <-chan util.Param) {
+	ticker := time.NewTicker(saveInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case p, ok := <-in:
+			if !ok {
+				return
+			}
+
+			if !recorded(p) {
+				continue
+			}
+
+			var val float64
+			switch v := p.Val.(type) {
+			case float64:
+				val = v
+			case int:
+				val = float64(v)
+			case int64:
+				val = float64(v)
+			default:
+				continue
+			}
+
+			s.Record(Key(p), time.Now(), val)
+
+		case <-ticker.C:
+			if err := s.Save(); err != nil {
+				s.log.ERROR.Println(err)
+			}
+		}
+	}
+}
+
+// Shutdown saves the store
+func (s *Store) Shutdown() {
+	if err := s.Save(); err != nil {
+		s.log.ERROR.Println(err)
+	}
+}
@@ -0,0 +1,272 @@
+package history
+
+import (
+	"encoding/gob"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/evcc-io/evcc/util"
+)
+
+// Config is the history configuration
+type Config struct {
+	Disable bool   // disable recording
+	File    string // storage file, default ~/.evcc/history.gob
+}
+
+// tier is a resolution and its retention, zero retention keeps data forever
+type tier struct {
+	resolution, retention time.Duration
+}
+
+// tiers are ordered from raw to coarsest resolution
+var tiers = [3]tier{
+	{0, 48 * time.Hour},
+	{5 * time.Minute, 90 * 24 * time.Hour},
+	{time.Hour, 0},
+}
+
+// saveInterval limits storage writes
+const saveInterval = time.Hour
+
+// Sample is a single value
+type Sample struct {
+	Time  time.Time `json:"ts"`
+	Value float64   `json:"val"`
+}
+
+// Bucket accumulates samples of a downsampled tier
+type Bucket struct {
+	Start time.Time
+	Sum   float64
+	Count int
+}
+
+// Series contains the samples of a single key per tier
+type Series struct {
+	Tiers   [len(tiers)][]Sample
+	Pending [len(tiers)]Bucket
+}
+
+// Store is an embedded time-series store with downsampling
+type Store struct {
+	mu     sync.Mutex
+	log    *util.Logger
+	file   string
+	series map[string]*Series
+}
+
+// New creates a store and loads existing data from file. If file is empty, data is not persisted.
+func New(file string) (*Store, error) {
+	s := &Store{
+		log:    util.NewLogger("history"),
+		file:   file,
+		series: make(map[string]*Series),
+	}
+
+	if file == "" {
+		return s, nil
+	}
+
+	if err := os.MkdirAll(filepath.Dir(file), 0o755); err != nil {
+		return nil, err
+	}
+
+	f, err := os.Open(file)
+	if errors.Is(err, os.ErrNotExist) {
+		return s, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	if err := gob.NewDecoder(f).Decode(&s.series); err != nil {
+		return nil, fmt.Errorf("%s: %w", file, err)
+	}
+
+	return s, nil
+}
+
+// DefaultFile returns the default storage file in the user's home directory
+func DefaultFile() (string, error) {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(home, ".evcc", "history.gob"), nil
+}
+
+// Record adds a value
+func (s *Store) Record(key string, ts time.Time, val float64) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	sr, ok := s.series[key]
+	if !ok {
+		sr = new(Series)
+		s.series[key] = sr
+	}
+
+	sr.Tiers[0] = append(sr.Tiers[0], Sample{Time: ts, Value: val})
+
+	for i := 1; i < len(tiers); i++ {
+		start := ts.Truncate(tiers[i].resolution)
+		pending := &sr.Pending[i]
+
+		// close previous bucket
+		if pending.Count > 0 && !pending.Start.Equal(start) {
+			sr.Tiers[i] = append(sr.Tiers[i], Sample{Time: pending.Start, Value: pending.Sum / float64(pending.Count)})
+			*pending = Bucket{}
+		}
+
+		pending.Start = start
+		pending.Sum += val
+		pending.Count++
+	}
+
+	sr.prune(ts)
+}
+
+// prune removes samples exceeding the tier retention. To avoid copying on each
+// record, samples are removed once the oldest exceeds the retention by 2%.
+func (sr *Series) prune(now time.Time) {
+	for i, t := range tiers {
+		if t.retention == 0 {
+			continue
+		}
+
+		samples := sr.Tiers[i]
+		if len(samples) == 0 || !samples[0].Time.Before(now.Add(-t.retention-t.retention/50)) {
+			continue
+		}
+
+		limit := now.Add(-t.retention)
+
+		n := sort.Search(len(samples), func(j int) bool { return !samples[j].Time.Before(limit) })
+		if n > 0 {
+			sr.Tiers[i] = append([]Sample(nil), samples[n:]...)
+		}
+	}
+}
+
+// tierFor selects the coarsest tier with data for from and resolution not exceeding step,
+// or the finest tier with data for from if step is smaller
+func tierFor(now, from time.Time, step time.Duration) int {
+	res := len(tiers) - 1
+
+	for i := len(tiers) - 1; i >= 0; i-- {
+		if t := tiers[i]; t.retention > 0 && from.Before(now.Add(-t.retention)) {
+			break
+		}
+
+		res = i
+		if tiers[i].resolution <= step {
+			break
+		}
+	}
+
+	return res
+}
+
+// Query returns the values of key from inclusive to to exclusive, averaged over step if step is not zero
+func (s *Store) Query(key string, from, to time.Time, step time.Duration) []Sample {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	res := []Sample{}
+
+	sr, ok := s.series[key]
+	if !ok {
+		return res
+	}
+
+	samples := sr.Tiers[tierFor(time.Now(), from, step)]
+
+	i := sort.Search(len(samples), func(j int) bool { return !samples[j].Time.Before(from) })
+	for ; i < len(samples) && samples[i].Time.Before(to); i++ {
+		res = append(res, samples[i])
+	}
+
+	if step > 0 {
+		res = resample(res, from, step)
+	}
+
+	return res
+}
+
+// resample averages samples over step intervals starting at from
+func resample(samples []Sample, from time.Time, step time.Duration) []Sample {
+	res := []Sample{}
+
+	var sum float64
+	var count int
+	var start time.Time
+
+	for _, s := range samples {
+		bucket := from.Add(s.Time.Sub(from) / step * step)
+
+		if count > 0 && !bucket.Equal(start) {
+			res = append(res, Sample{Time: start, Value: sum / float64(count)})
+			sum, count = 0, 0
+		}
+
+		start = bucket
+		sum += s.Value
+		count++
+	}
+
+	if count > 0 {
+		res = append(res, Sample{Time: start, Value: sum / float64(count)})
+	}
+
+	return res
+}
+
+// Keys returns the recorded keys
+func (s *Store) Keys() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	res := make([]string, 0, len(s.series))
+	for key := range s.series {
+		res = append(res, key)
+	}
+	sort.Strings(res)
+
+	return res
+}
+
+// Save writes the store to file
+func (s *Store) Save() error {
+	if s.file == "" {
+		return nil
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	// write atomically
+	tmp := s.file + ".tmp"
+
+	f, err := os.Create(tmp)
+	if err != nil {
+		return err
+	}
+
+	if err := gob.NewEncoder(f).Encode(s.series); err != nil {
+		f.Close()
+		return err
+	}
+
+	if err := f.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(tmp, s.file)
+}
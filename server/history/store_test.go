@@ -0,0 +1,105 @@
+package history
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/evcc-io/evcc/util"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTierFor(t *testing.T) {
+	now := time.Now()
+
+	tc := []struct {
+		from time.Duration
+		step time.Duration
+		tier int
+	}{
+		{time.Hour, 0, 0},
+		{time.Hour, time.Minute, 0},
+		{time.Hour, 10 * time.Minute, 1},
+		{time.Hour, 2 * time.Hour, 2},
+		{72 * time.Hour, 0, 1},
+		{72 * time.Hour, time.Hour, 2},
+		{100 * 24 * time.Hour, 0, 2},
+	}
+
+	for _, tc := range tc {
+		assert.Equal(t, tc.tier, tierFor(now, now.Add(-tc.from), tc.step), "from %v step %v", tc.from, tc.step)
+	}
+}
+
+func TestStore(t *testing.T) {
+	s, err := New("")
+	require.NoError(t, err)
+
+	// 3h of values every minute, 10 minutes ago
+	start := time.Now().Add(-3*time.Hour - 10*time.Minute).Truncate(time.Hour)
+	for i := 0; i < 180; i++ {
+		s.Record("gridPower", start.Add(time.Duration(i)*time.Minute), float64(i))
+	}
+
+	end := start.Add(3 * time.Hour)
+
+	raw := s.Query("gridPower", start, end, 0)
+	assert.Len(t, raw, 180)
+
+	// downsampled, last bucket still pending
+	fiveMin := s.Query("gridPower", start, end, 5*time.Minute)
+	require.Len(t, fiveMin, 35)
+	assert.Equal(t, Sample{Time: start, Value: 2}, fiveMin[0])
+
+	hourly := s.Query("gridPower", start, end, time.Hour)
+	require.Len(t, hourly, 2)
+	assert.Equal(t, Sample{Time: start.Add(time.Hour), Value: 89.5}, hourly[1])
+
+	// resampled
+	resampled := s.Query("gridPower", start, start.Add(time.Hour), 30*time.Minute)
+	require.Len(t, resampled, 2)
+	assert.Equal(t, 14.5, resampled[0].Value)
+
+	assert.Empty(t, s.Query("foo", start, end, 0))
+	assert.Equal(t, []string{"gridPower"}, s.Keys())
+}
+
+func TestStorePrune(t *testing.T) {
+	s, err := New("")
+	require.NoError(t, err)
+
+	now := time.Now()
+	s.Record("pvPower", now.Add(-49*time.Hour), 1)
+	s.Record("pvPower", now, 2)
+
+	assert.Len(t, s.series["pvPower"].Tiers[0], 1)
+	assert.Len(t, s.series["pvPower"].Tiers[2], 1)
+}
+
+func TestStoreSave(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "history.gob")
+
+	s, err := New(file)
+	require.NoError(t, err)
+
+	ts := time.Now().Truncate(time.Second)
+	s.Record("lp1.chargePower", ts, 11000)
+	require.NoError(t, s.Save())
+
+	s, err = New(file)
+	require.NoError(t, err)
+
+	res := s.Query("lp1.chargePower", ts.Add(-time.Minute), ts.Add(time.Minute), 0)
+	require.Len(t, res, 1)
+	assert.Equal(t, 11000.0, res[0].Value)
+	assert.True(t, ts.Equal(res[0].Time))
+}
+
+func TestKey(t *testing.T) {
+	lp := 0
+	assert.Equal(t, "gridPower", Key(util.Param{Key: "gridPower"}))
+	assert.Equal(t, "lp1.chargePower", Key(util.Param{LoadPoint: &lp, Key: "chargePower"}))
+	assert.True(t, recorded(util.Param{LoadPoint: &lp, Key: "chargePower"}))
+	assert.False(t, recorded(util.Param{Key: "chargePower"}))
+}
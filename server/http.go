@@ -6,6 +6,9 @@ import (
 	"net/http"
 	"time"
 
+	"github.com/evcc-io/evcc/api"
+	"github.com/evcc-io/evcc/core/loadpoint"
+	"github.com/evcc-io/evcc/core/metrics"
 	"github.com/evcc-io/evcc/core/site"
 	"github.com/evcc-io/evcc/util"
 	"github.com/gorilla/handlers"
@@ -15,10 +18,16 @@ import (
 // Assets is the embedded assets file system
 var Assets fs.FS
 
-type route struct {
+// Route is an api route relative to /api
+type Route struct {
 	Methods     []string
 	Pattern     string
 	HandlerFunc http.HandlerFunc
+
+	// api documentation
+	Summary string
+	Body    interface{} // json request body sample
+	Result  interface{} // json result sample
 }
 
 // routeLogger traces matched routes including their executing time
@@ -40,67 +49,71 @@ func routeLogger(inner http.Handler) http.HandlerFunc {
 // HTTPd wraps an http.Server and adds the root router
 type HTTPd struct {
 	*http.Server
+	api              *mux.Router
+	routes, lpRoutes map[string]Route
 }
 
-// NewHTTPd creates HTTP server with configured routes for loadpoint
-func NewHTTPd(addr string, site site.API, hub *SocketHub, cache *util.Cache) *HTTPd {
-	routes := map[string]route{
-		"health":        {[]string{"GET"}, "/health", healthHandler(site)},
-		"state":         {[]string{"GET"}, "/state", stateHandler(cache)},
-		"buffersoc":     {[]string{"POST", "OPTIONS"}, "/buffersoc/{value:[0-9.]+}", floatHandler(site.SetBufferSoC, site.GetBufferSoC)},
-		"prioritysoc":   {[]string{"POST", "OPTIONS"}, "/prioritysoc/{value:[0-9.]+}", floatHandler(site.SetPrioritySoC, site.GetPrioritySoC)},
-		"residualpower": {[]string{"POST", "OPTIONS"}, "/residualpower/{value:[-0-9.]+}", floatHandler(site.SetResidualPower, site.GetResidualPower)},
+// NewHTTPd creates HTTP server with configured routes for loadpoint.
+// If auth is nil, all clients have full access.
+func NewHTTPd(addr string, site site.API, hub *SocketHub, cache *util.Cache, auth *Auth) *HTTPd {
+	routes := map[string]Route{
+		"health":           {[]string{"GET"}, "/health", healthHandler(site), "Site health", nil, nil},
+		"diagnostics":      {[]string{"GET"}, "/diagnostics", getHandler(metrics.Diagnostics), "Device request statistics", nil, []metrics.Stats{}},
+		"state":            {[]string{"GET"}, "/state", stateHandler(cache), "Complete state", nil, map[string]interface{}{}},
+		"getBufferSoC":     {[]string{"GET"}, "/buffersoc", getHandler(site.GetBufferSoC), "Get battery buffer soc", nil, 0.0},
+		"setBufferSoC":     {[]string{"POST", "OPTIONS"}, "/buffersoc", floatHandler(site.SetBufferSoC, site.GetBufferSoC), "Set battery buffer soc", floatValue{}, 0.0},
+		"buffersoc":        {[]string{"POST", "OPTIONS"}, "/buffersoc/{value:[0-9.]+}", floatHandler(site.SetBufferSoC, site.GetBufferSoC), "Set battery buffer soc", nil, 0.0},
+		"getPrioritySoC":   {[]string{"GET"}, "/prioritysoc", getHandler(site.GetPrioritySoC), "Get battery priority soc", nil, 0.0},
+		"setPrioritySoC":   {[]string{"POST", "OPTIONS"}, "/prioritysoc", floatHandler(site.SetPrioritySoC, site.GetPrioritySoC), "Set battery priority soc", floatValue{}, 0.0},
+		"prioritysoc":      {[]string{"POST", "OPTIONS"}, "/prioritysoc/{value:[0-9.]+}", floatHandler(site.SetPrioritySoC, site.GetPrioritySoC), "Set battery priority soc", nil, 0.0},
+		"getResidualPower": {[]string{"GET"}, "/residualpower", getHandler(site.GetResidualPower), "Get residual power", nil, 0.0},
+		"setResidualPower": {[]string{"POST", "OPTIONS"}, "/residualpower", floatHandler(site.SetResidualPower, site.GetResidualPower), "Set residual power", floatValue{}, 0.0},
+		"residualpower":    {[]string{"POST", "OPTIONS"}, "/residualpower/{value:[-0-9.]+}", floatHandler(site.SetResidualPower, site.GetResidualPower), "Set residual power", nil, 0.0},
+		"getPowerLimit":    {[]string{"GET"}, "/powerlimit", getHandler(site.GetPowerLimit), "Get effective loadpoint power limit", nil, 0.0},
+		"getVehicles":      {[]string{"GET"}, "/vehicles", vehiclesHandler(site), "List configured vehicles", nil, []string{}},
+		"getLoadpoints":    {[]string{"GET"}, "/loadpoints", loadpointsHandler(site), "List loadpoints and their settings", nil, []loadpointSettings{}},
 	}
 
 	router := mux.NewRouter().StrictSlash(true)
 
+	// authentication applies to all api routes including those added later
+	index := indexHandler(site)
+	if auth.Enabled() {
+		router.Use(auth.Middleware)
+		index = auth.indexGuard(index)
+	}
+
 	// websocket
-	router.HandleFunc("/ws", socketHandler(hub))
+	router.HandleFunc("/ws", socketHandler(hub, auth))
 
 	// static - individual handlers per root and folders
 	static := router.PathPrefix("/").Subrouter()
 	static.Use(handlers.CompressHandler)
 
-	static.HandleFunc("/", indexHandler(site))
+	static.HandleFunc("/", index)
+	static.HandleFunc("/login", loginPageHandler)
 	for _, dir := range []string{"assets", "meta"} {
 		static.PathPrefix("/" + dir).Handler(http.FileServer(http.FS(Assets)))
 	}
 
 	// api
+	cors := []handlers.CORSOption{
+		handlers.AllowedHeaders([]string{"Content-Type", "Authorization"}),
+	}
+	if origins := auth.Origins(); len(origins) > 0 {
+		cors = append(cors, handlers.AllowedOrigins(origins), handlers.AllowCredentials())
+	}
+
 	api := router.PathPrefix("/api").Subrouter()
 	api.Use(jsonHandler)
 	api.Use(handlers.CompressHandler)
-	api.Use(handlers.CORS(
-		handlers.AllowedHeaders([]string{"Content-Type"}),
-	))
-
-	// site api
-	for _, r := range routes {
-		api.Methods(r.Methods...).Path(r.Pattern).Handler(r.HandlerFunc)
-	}
+	api.Use(handlers.CORS(cors...))
 
-	// loadpoint api
-	for id, lp := range site.LoadPoints() {
-		lpAPI := api.PathPrefix(fmt.Sprintf("/loadpoints/%d", id)).Subrouter()
-
-		routes := map[string]route{
-			"mode":          {[]string{"POST", "OPTIONS"}, "/mode/{value:[a-z]+}", chargeModeHandler(lp)},
-			"targetsoc":     {[]string{"POST", "OPTIONS"}, "/targetsoc/{value:[0-9]+}", intHandler(pass(lp.SetTargetSoC), lp.GetTargetSoC)},
-			"minsoc":        {[]string{"POST", "OPTIONS"}, "/minsoc/{value:[0-9]+}", intHandler(pass(lp.SetMinSoC), lp.GetMinSoC)},
-			"mincurrent":    {[]string{"POST", "OPTIONS"}, "/mincurrent/{value:[0-9]+}", floatHandler(pass(lp.SetMinCurrent), lp.GetMinCurrent)},
-			"maxcurrent":    {[]string{"POST", "OPTIONS"}, "/maxcurrent/{value:[0-9]+}", floatHandler(pass(lp.SetMaxCurrent), lp.GetMaxCurrent)},
-			"phases":        {[]string{"POST", "OPTIONS"}, "/phases/{value:[0-9]+}", phasesHandler(lp)},
-			"targetcharge":  {[]string{"POST", "OPTIONS"}, "/targetcharge/{soc:[0-9]+}/{time:[0-9TZ:.-]+}", targetChargeHandler(lp)},
-			"targetcharge2": {[]string{"DELETE", "OPTIONS"}, "/targetcharge", targetChargeRemoveHandler(lp)},
-			"vehicle":       {[]string{"POST", "OPTIONS"}, "/vehicle/{vehicle:[0-9]+}", vehicleHandler(site, lp)},
-			"vehicle2":      {[]string{"DELETE", "OPTIONS"}, "/vehicle", vehicleRemoveHandler(lp)},
-			"vehicleDetect": {[]string{"PATCH", "OPTIONS"}, "/vehicle", vehicleDetectHandler(lp)},
-			"remotedemand":  {[]string{"POST", "OPTIONS"}, "/remotedemand/{demand:[a-z]+}/{source::[0-9a-zA-Z_-]+}", remoteDemandHandler(lp)},
-		}
-
-		for _, r := range routes {
-			lpAPI.Methods(r.Methods...).Path(r.Pattern).Handler(r.HandlerFunc)
-		}
+	// authentication api
+	if auth != nil {
+		api.Methods("POST").Path("/auth/login").HandlerFunc(auth.loginHandler)
+		api.Methods("POST").Path("/auth/logout").HandlerFunc(auth.logoutHandler)
+		api.Methods("GET").Path("/auth/status").HandlerFunc(auth.statusHandler)
 	}
 
 	srv := &HTTPd{
@@ -112,12 +125,81 @@ func NewHTTPd(addr string, site site.API, hub *SocketHub, cache *util.Cache) *HT
 			IdleTimeout:  120 * time.Second,
 			ErrorLog:     log.ERROR,
 		},
+		api:    api,
+		routes: make(map[string]Route),
 	}
 	srv.SetKeepAlivesEnabled(true)
 
+	// site api
+	srv.AddRoutes(routes)
+
+	// loadpoint api
+	for id, lp := range site.LoadPoints() {
+		api.Methods("GET").Path(fmt.Sprintf("/loadpoints/%d", id)).Handler(loadpointHandler(id, lp))
+
+		lpAPI := api.PathPrefix(fmt.Sprintf("/loadpoints/%d", id)).Subrouter()
+
+		srv.lpRoutes = loadpointRoutes(site, lp)
+		for _, r := range srv.lpRoutes {
+			lpAPI.Methods(r.Methods...).Path(r.Pattern).Handler(r.HandlerFunc)
+		}
+	}
+
+	// api documentation including routes added later
+	api.Methods("GET").Path("/openapi.json").Handler(getHandler(func() interface{} {
+		return openAPI(srv.routes, srv.lpRoutes)
+	}))
+
+	// json errors for unmatched api routes, set on root router to not shadow api routes added later
+	router.NotFoundHandler = apiErrorHandler(http.StatusNotFound, http.NotFoundHandler())
+	router.MethodNotAllowedHandler = apiErrorHandler(http.StatusMethodNotAllowed, nil)
+
 	return srv
 }
 
+// AddRoutes adds site api routes and their documentation. Routes must be added before the server is started.
+func (s *HTTPd) AddRoutes(routes map[string]Route) {
+	for name, r := range routes {
+		s.routes[name] = r
+		s.api.Methods(r.Methods...).Path(r.Pattern).Handler(r.HandlerFunc)
+	}
+}
+
+// loadpointRoutes returns the loadpoint api routes relative to the loadpoint's path
+func loadpointRoutes(site site.API, lp loadpoint.API) map[string]Route {
+	return map[string]Route{
+		"getMode":         {[]string{"GET"}, "/mode", getHandler(lp.GetMode), "Get charge mode", nil, api.ModePV},
+		"setMode":         {[]string{"POST", "OPTIONS"}, "/mode", chargeModeHandler(lp), "Set charge mode", modeValue{}, api.ModePV},
+		"mode":            {[]string{"POST", "OPTIONS"}, "/mode/{value:[a-z]+}", chargeModeHandler(lp), "Set charge mode", nil, api.ModePV},
+		"getTargetSoC":    {[]string{"GET"}, "/targetsoc", getHandler(lp.GetTargetSoC), "Get target soc", nil, 0},
+		"setTargetSoC":    {[]string{"POST", "OPTIONS"}, "/targetsoc", intHandler(pass(lp.SetTargetSoC), lp.GetTargetSoC), "Set target soc", intValue{}, 0},
+		"targetsoc":       {[]string{"POST", "OPTIONS"}, "/targetsoc/{value:[0-9]+}", intHandler(pass(lp.SetTargetSoC), lp.GetTargetSoC), "Set target soc", nil, 0},
+		"getMinSoC":       {[]string{"GET"}, "/minsoc", getHandler(lp.GetMinSoC), "Get minimum soc", nil, 0},
+		"setMinSoC":       {[]string{"POST", "OPTIONS"}, "/minsoc", intHandler(pass(lp.SetMinSoC), lp.GetMinSoC), "Set minimum soc", intValue{}, 0},
+		"minsoc":          {[]string{"POST", "OPTIONS"}, "/minsoc/{value:[0-9]+}", intHandler(pass(lp.SetMinSoC), lp.GetMinSoC), "Set minimum soc", nil, 0},
+		"getMinCurrent":   {[]string{"GET"}, "/mincurrent", getHandler(lp.GetMinCurrent), "Get minimum current", nil, 0.0},
+		"setMinCurrent":   {[]string{"POST", "OPTIONS"}, "/mincurrent", floatHandler(pass(lp.SetMinCurrent), lp.GetMinCurrent), "Set minimum current", floatValue{}, 0.0},
+		"mincurrent":      {[]string{"POST", "OPTIONS"}, "/mincurrent/{value:[0-9]+}", floatHandler(pass(lp.SetMinCurrent), lp.GetMinCurrent), "Set minimum current", nil, 0.0},
+		"getMaxCurrent":   {[]string{"GET"}, "/maxcurrent", getHandler(lp.GetMaxCurrent), "Get maximum current", nil, 0.0},
+		"setMaxCurrent":   {[]string{"POST", "OPTIONS"}, "/maxcurrent", floatHandler(pass(lp.SetMaxCurrent), lp.GetMaxCurrent), "Set maximum current", floatValue{}, 0.0},
+		"maxcurrent":      {[]string{"POST", "OPTIONS"}, "/maxcurrent/{value:[0-9]+}", floatHandler(pass(lp.SetMaxCurrent), lp.GetMaxCurrent), "Set maximum current", nil, 0.0},
+		"getPhases":       {[]string{"GET"}, "/phases", getHandler(lp.GetPhases), "Get enabled phases", nil, 0},
+		"setPhases":       {[]string{"POST", "OPTIONS"}, "/phases", phasesHandler(lp), "Set enabled phases", intValue{}, 0},
+		"phases":          {[]string{"POST", "OPTIONS"}, "/phases/{value:[0-9]+}", phasesHandler(lp), "Set enabled phases", nil, 0},
+		"getTargetCharge": {[]string{"GET"}, "/targetcharge", targetChargeGetHandler(lp), "Get target charging soc and time", nil, targetCharge{}},
+		"setTargetCharge": {[]string{"POST", "OPTIONS"}, "/targetcharge", targetChargeHandler(lp), "Set target charging soc and time", targetCharge{}, targetCharge{}},
+		"targetcharge":    {[]string{"POST", "OPTIONS"}, "/targetcharge/{soc:[0-9]+}/{time:[0-9TZ:.-]+}", targetChargeHandler(lp), "Set target charging soc and time", nil, targetCharge{}},
+		"targetcharge2":   {[]string{"DELETE", "OPTIONS"}, "/targetcharge", targetChargeRemoveHandler(lp), "Remove target charging", nil, struct{}{}},
+		"getVehicle":      {[]string{"GET"}, "/vehicle", vehicleGetHandler(lp), "Get active vehicle", nil, vehicle{}},
+		"setVehicle":      {[]string{"POST", "OPTIONS"}, "/vehicle", vehicleHandler(site, lp), "Set active vehicle by index", vehicleIndex{}, vehicle{}},
+		"vehicle":         {[]string{"POST", "OPTIONS"}, "/vehicle/{vehicle:[0-9]+}", vehicleHandler(site, lp), "Set active vehicle by index", nil, vehicle{}},
+		"vehicle2":        {[]string{"DELETE", "OPTIONS"}, "/vehicle", vehicleRemoveHandler(lp), "Remove active vehicle", nil, struct{}{}},
+		"vehicleDetect":   {[]string{"PATCH", "OPTIONS"}, "/vehicle", vehicleDetectHandler(lp), "Start vehicle detection", nil, struct{}{}},
+		"setRemoteDemand": {[]string{"POST", "OPTIONS"}, "/remotedemand", remoteDemandHandler(lp), "Set remote demand", remoteDemand{}, remoteDemand{}},
+		"remotedemand":    {[]string{"POST", "OPTIONS"}, "/remotedemand/{demand:[a-z]+}/{source::[0-9a-zA-Z_-]+}", remoteDemandHandler(lp), "Set remote demand", nil, remoteDemand{}},
+	}
+}
+
 // Router returns the main router
 func (s *HTTPd) Router() *mux.Router {
 	return s.Handler.(*mux.Router)
@@ -0,0 +1,149 @@
+package server
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"mime"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/evcc-io/evcc/util/templates"
+	"github.com/gorilla/mux"
+)
+
+// ConfigEditor changes the configured devices and loadpoints.
+// Changes are saved and applied, the result of applying them is returned.
+type ConfigEditor interface {
+	Devices(class string) ([]map[string]interface{}, error)
+	AddDevice(class string, conf map[string]interface{}) (interface{}, error)
+	UpdateDevice(class, name string, conf map[string]interface{}) (interface{}, error)
+	DeleteDevice(class, name string) (interface{}, error)
+	TestDevice(class string, conf map[string]interface{}) error
+	Loadpoints() ([]map[string]interface{}, error)
+	AddLoadpoint(conf map[string]interface{}) (interface{}, error)
+	UpdateLoadpoint(id int, conf map[string]interface{}) (interface{}, error)
+	DeleteLoadpoint(id int) (interface{}, error)
+}
+
+const deviceClasses = "{class:meter|charger|vehicle}"
+
+// ConfigEditorRoutes returns the template and configuration editor api routes
+func ConfigEditorRoutes(editor ConfigEditor) map[string]Route {
+	devices := "/config/devices/" + deviceClasses
+
+	return map[string]Route{
+		"getTemplates": {[]string{"GET"}, "/config/templates/" + deviceClasses, templatesHandler, "List device templates", nil, []templates.Form{}},
+		"getTemplate":  {[]string{"GET"}, "/config/templates/" + deviceClasses + "/{template}", templateHandler, "Get device template", nil, templates.Form{}},
+		"getDevices": {[]string{"GET"}, devices, func(w http.ResponseWriter, r *http.Request) {
+			res, err := editor.Devices(mux.Vars(r)["class"])
+			configResult(w, res, err)
+		}, "List configured devices", nil, []map[string]interface{}{}},
+		"addDevice": {[]string{"POST", "OPTIONS"}, devices, configBodyHandler(func(r *http.Request, conf map[string]interface{}) (interface{}, error) {
+			return editor.AddDevice(mux.Vars(r)["class"], conf)
+		}), "Add device", map[string]interface{}{}, nil},
+		"updateDevice": {[]string{"PUT", "OPTIONS"}, devices + "/{name}", configBodyHandler(func(r *http.Request, conf map[string]interface{}) (interface{}, error) {
+			vars := mux.Vars(r)
+			return editor.UpdateDevice(vars["class"], vars["name"], conf)
+		}), "Update device", map[string]interface{}{}, nil},
+		"deleteDevice": {[]string{"DELETE", "OPTIONS"}, devices + "/{name}", func(w http.ResponseWriter, r *http.Request) {
+			vars := mux.Vars(r)
+			res, err := editor.DeleteDevice(vars["class"], vars["name"])
+			configResult(w, res, err)
+		}, "Delete device", nil, nil},
+		"testDevice": {[]string{"POST", "OPTIONS"}, "/config/test/" + deviceClasses, configBodyHandler(func(r *http.Request, conf map[string]interface{}) (interface{}, error) {
+			return "ok", editor.TestDevice(mux.Vars(r)["class"], conf)
+		}), "Test device configuration", map[string]interface{}{}, "ok"},
+		"getLoadpointConfigs": {[]string{"GET"}, "/config/loadpoints", func(w http.ResponseWriter, r *http.Request) {
+			res, err := editor.Loadpoints()
+			configResult(w, res, err)
+		}, "List configured loadpoints", nil, []map[string]interface{}{}},
+		"addLoadpoint": {[]string{"POST", "OPTIONS"}, "/config/loadpoints", configBodyHandler(func(r *http.Request, conf map[string]interface{}) (interface{}, error) {
+			return editor.AddLoadpoint(conf)
+		}), "Add loadpoint", map[string]interface{}{}, nil},
+		"updateLoadpoint": {[]string{"PUT", "OPTIONS"}, "/config/loadpoints/{id:[0-9]+}", configBodyHandler(func(r *http.Request, conf map[string]interface{}) (interface{}, error) {
+			id, _ := strconv.Atoi(mux.Vars(r)["id"])
+			return editor.UpdateLoadpoint(id, conf)
+		}), "Update loadpoint", map[string]interface{}{}, nil},
+		"deleteLoadpoint": {[]string{"DELETE", "OPTIONS"}, "/config/loadpoints/{id:[0-9]+}", func(w http.ResponseWriter, r *http.Request) {
+			id, _ := strconv.Atoi(mux.Vars(r)["id"])
+			res, err := editor.DeleteLoadpoint(id)
+			configResult(w, res, err)
+		}, "Delete loadpoint", nil, nil},
+	}
+}
+
+func configResult(w http.ResponseWriter, res interface{}, err error) {
+	if err != nil {
+		jsonError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	jsonResult(w, res)
+}
+
+// configBodyHandler decodes the json configuration from the request body.
+// Other content types are rejected as browsers send them cross-site without preflight.
+func configBodyHandler(fn func(r *http.Request, conf map[string]interface{}) (interface{}, error)) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if mt, _, _ := mime.ParseMediaType(r.Header.Get("Content-Type")); mt != "application/json" {
+			jsonError(w, http.StatusUnsupportedMediaType, errors.New("content type must be application/json"))
+			return
+		}
+
+		var conf map[string]interface{}
+		if err := json.NewDecoder(r.Body).Decode(&conf); err != nil {
+			jsonError(w, http.StatusBadRequest, fmt.Errorf("invalid request body: %w", err))
+			return
+		}
+
+		res, err := fn(r, conf)
+		configResult(w, res, err)
+	}
+}
+
+// language returns the lang query parameter or the preferred request language
+func language(r *http.Request) string {
+	if lang := r.URL.Query().Get("lang"); lang != "" {
+		return lang
+	}
+
+	if accept := r.Header.Get("Accept-Language"); len(accept) >= 2 {
+		return strings.ToLower(accept[:2])
+	}
+
+	return "en"
+}
+
+// templatesHandler returns the device templates of the class without params
+func templatesHandler(w http.ResponseWriter, r *http.Request) {
+	lang := language(r)
+
+	res := []templates.Form{}
+	for _, tmpl := range templates.ByClass(mux.Vars(r)["class"]) {
+		form := tmpl.Form(lang)
+		form.Params, form.Modbus = nil, nil
+		res = append(res, form)
+	}
+
+	sort.SliceStable(res, func(i, j int) bool {
+		return strings.ToLower(res[i].Template) < strings.ToLower(res[j].Template)
+	})
+
+	jsonResult(w, res)
+}
+
+// templateHandler returns the device template including the params
+func templateHandler(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+
+	tmpl, err := templates.ByName(vars["template"], vars["class"])
+	if err != nil {
+		jsonError(w, http.StatusNotFound, err)
+		return
+	}
+
+	jsonResult(w, tmpl.Form(language(r)))
+}
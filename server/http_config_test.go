@@ -0,0 +1,95 @@
+package server
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/evcc-io/evcc/util/templates"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testEditor struct {
+	ConfigEditor
+	added map[string]interface{}
+}
+
+func (e *testEditor) AddDevice(class string, conf map[string]interface{}) (interface{}, error) {
+	if conf["name"] == nil {
+		return nil, errors.New("missing name")
+	}
+	e.added = conf
+	return "added " + class, nil
+}
+
+func (e *testEditor) DeleteLoadpoint(id int) (interface{}, error) {
+	return id, nil
+}
+
+func TestConfigEditorRoutes(t *testing.T) {
+	editor := new(testEditor)
+
+	httpd := NewHTTPd(":0", &httpSite{lp: &httpLoadpoint{}}, nil, nil, nil)
+	httpd.AddRoutes(ConfigEditorRoutes(editor))
+	router := httpd.Router()
+
+	contentType := "application/json"
+
+	request := func(method, path, body string) (int, map[string]interface{}) {
+		req := httptest.NewRequest(method, path, strings.NewReader(body))
+		req.Header.Set("Content-Type", contentType)
+		rr := httptest.NewRecorder()
+		router.ServeHTTP(rr, req)
+
+		var res map[string]interface{}
+		require.NoError(t, json.NewDecoder(rr.Body).Decode(&res))
+
+		return rr.Code, res
+	}
+
+	code, res := request(http.MethodPost, "/api/config/devices/meter", `{"name":"grid","type":"demo"}`)
+	assert.Equal(t, http.StatusOK, code)
+	assert.Equal(t, "added meter", res["result"])
+	assert.Equal(t, map[string]interface{}{"name": "grid", "type": "demo"}, editor.added)
+
+	code, res = request(http.MethodPost, "/api/config/devices/meter", `{"type":"demo"}`)
+	assert.Equal(t, http.StatusBadRequest, code)
+	assert.Equal(t, "missing name", res["error"])
+
+	code, _ = request(http.MethodPost, "/api/config/devices/meter", `invalid`)
+	assert.Equal(t, http.StatusBadRequest, code)
+
+	// cross-site form posts are rejected
+	contentType = "text/plain"
+	code, _ = request(http.MethodPost, "/api/config/devices/meter", `{"name":"pv","type":"demo"}`)
+	assert.Equal(t, http.StatusUnsupportedMediaType, code)
+	assert.Equal(t, "grid", editor.added["name"])
+	contentType = "application/json; charset=utf-8"
+
+	code, res = request(http.MethodDelete, "/api/config/loadpoints/1", "")
+	assert.Equal(t, http.StatusOK, code)
+	assert.Equal(t, 1.0, res["result"])
+
+	code, res = request(http.MethodGet, "/api/config/templates/charger/easee?lang=en", "")
+	assert.Equal(t, http.StatusOK, code)
+
+	var form templates.Form
+	b, _ := json.Marshal(res["result"])
+	require.NoError(t, json.Unmarshal(b, &form))
+	assert.Equal(t, "easee", form.Template)
+	assert.Equal(t, []string{"Easee Home"}, form.Products)
+	require.Len(t, form.Params, 3)
+	assert.Equal(t, "Password", form.Params[1].Description)
+	assert.True(t, form.Params[1].Mask)
+
+	code, _ = request(http.MethodGet, "/api/config/templates/charger/unknown", "")
+	assert.Equal(t, http.StatusNotFound, code)
+
+	code, res = request(http.MethodGet, "/api/openapi.json", "")
+	assert.Equal(t, http.StatusOK, code)
+	assert.Contains(t, res["result"].(map[string]interface{})["paths"], "/config/devices/{class}/{name}")
+}
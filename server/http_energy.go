@@ -0,0 +1,41 @@
+package server
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/evcc-io/evcc/core/energy"
+)
+
+// EnergyHandler returns the energy totals by period.
+// Query parameters are period (day or month, default day), from and to (period keys, e.g. 2022-10-01 or 2022-10) and format (json or csv).
+func EnergyHandler(report *energy.Report) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+
+		period := energy.Day
+		if s := q.Get("period"); s != "" {
+			var err error
+			if period, err = energy.ParsePeriod(s); err != nil {
+				jsonError(w, http.StatusBadRequest, err)
+				return
+			}
+		}
+
+		entries := report.Entries(period, q.Get("from"), q.Get("to"))
+
+		if q.Get("format") == "csv" {
+			w.Header().Set("Content-Type", "text/csv; charset=UTF-8")
+			w.Header().Set("Content-Disposition", fmt.Sprintf(`attachment; filename="energy-%s-%s.csv"`, period, time.Now().Format("20060102")))
+
+			if err := energy.WriteCSV(w, entries); err != nil {
+				log.ERROR.Printf("energy: %v", err)
+			}
+
+			return
+		}
+
+		jsonResult(w, entries)
+	}
+}
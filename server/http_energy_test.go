@@ -0,0 +1,54 @@
+package server
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/evcc-io/evcc/core/energy"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEnergyHandler(t *testing.T) {
+	report, err := energy.NewReport("")
+	require.NoError(t, err)
+
+	ts := time.Date(2022, 10, 1, 12, 0, 0, 0, time.Local)
+	report.Add(energy.Measurement{Time: ts, PVPower: 6000})
+	report.Add(energy.Measurement{Time: ts.Add(time.Minute), PVPower: 6000})
+
+	h := EnergyHandler(report)
+
+	request := func(query string) *httptest.ResponseRecorder {
+		rr := httptest.NewRecorder()
+		h.ServeHTTP(rr, httptest.NewRequest(http.MethodGet, "/api/energy?"+query, nil))
+		return rr
+	}
+
+	rr := request("period=month")
+	require.Equal(t, http.StatusOK, rr.Code)
+
+	var res struct {
+		Result []energy.Entry
+	}
+	require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &res), rr.Body.String())
+	require.Len(t, res.Result, 1)
+	assert.Equal(t, "2022-10", res.Result[0].Period)
+	assert.InDelta(t, 0.1, res.Result[0].PV, 1e-9)
+
+	rr = request("from=2022-10-02")
+	require.Equal(t, http.StatusOK, rr.Code)
+	assert.JSONEq(t, `{"result":[]}`, rr.Body.String())
+
+	rr = request("format=csv")
+	require.Equal(t, http.StatusOK, rr.Code)
+	assert.True(t, strings.HasPrefix(rr.Header().Get("Content-Type"), "text/csv"))
+	assert.True(t, strings.HasPrefix(rr.Body.String(), "period,pv,"))
+
+	rr = request("period=year")
+	assert.Equal(t, http.StatusBadRequest, rr.Code)
+}
@@ -2,10 +2,12 @@ package server
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io/fs"
 	"net/http"
 	"strconv"
+	"strings"
 	"text/template"
 	"time"
 
@@ -62,9 +64,69 @@ func jsonResult(w http.ResponseWriter, res interface{}) {
 	jsonWrite(w, map[string]interface{}{"result": res})
 }
 
+// apiError is the error object returned by all api endpoints
+type apiError struct {
+	Error  string `json:"error"`
+	Status int    `json:"status"`
+}
+
 func jsonError(w http.ResponseWriter, status int, err error) {
 	w.WriteHeader(status)
-	jsonWrite(w, map[string]interface{}{"error": err.Error()})
+	jsonWrite(w, apiError{Error: err.Error(), Status: status})
+}
+
+// apiErrorHandler returns a json error for unmatched api routes and uses the fallback handler otherwise
+func apiErrorHandler(status int, fallback http.Handler) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if fallback != nil && !strings.HasPrefix(r.URL.Path, "/api/") {
+			fallback.ServeHTTP(w, r)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+		jsonError(w, status, errors.New(strings.ToLower(http.StatusText(status))))
+	}
+}
+
+// valueParam returns the value path parameter or the value of a {"value": ...} json request body
+func valueParam(r *http.Request) (string, error) {
+	if s, ok := mux.Vars(r)["value"]; ok {
+		return s, nil
+	}
+
+	var req struct {
+		Value interface{} `json:"value"`
+	}
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		return "", fmt.Errorf("invalid request body: %w", err)
+	}
+
+	if req.Value == nil {
+		return "", errors.New("missing value")
+	}
+
+	return fmt.Sprint(req.Value), nil
+}
+
+// getHandler returns the api value
+func getHandler[T any](get func() T) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		jsonResult(w, get())
+	}
+}
+
+// ReloadHandler reloads the configuration and returns the result
+func ReloadHandler[T any](reload func() (T, error)) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		res, err := reload()
+		if err != nil {
+			jsonError(w, http.StatusBadRequest, err)
+			return
+		}
+
+		jsonResult(w, res)
+	}
 }
 
 // healthHandler returns current charge mode
@@ -91,9 +153,12 @@ func pass[T any](f func(T)) func(T) error {
 // floatHandler updates float-param api
 func floatHandler(set func(float64) error, get func() float64) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
-		vars := mux.Vars(r)
+		var val float64
 
-		val, err := strconv.ParseFloat(vars["value"], 64)
+		s, err := valueParam(r)
+		if err == nil {
+			val, err = strconv.ParseFloat(s, 64)
+		}
 		if err == nil {
 			err = set(val)
 		}
@@ -110,9 +175,12 @@ func floatHandler(set func(float64) error, get func() float64) http.HandlerFunc
 // intHandler updates int-param api
 func intHandler(set func(int) error, get func() int) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
-		vars := mux.Vars(r)
+		var val int
 
-		val, err := strconv.Atoi(vars["value"])
+		s, err := valueParam(r)
+		if err == nil {
+			val, err = strconv.Atoi(s)
+		}
 		if err == nil {
 			err = set(val)
 		}
@@ -140,9 +208,13 @@ func stateHandler(cache *util.Cache) http.HandlerFunc {
 // chargeModeHandler updates charge mode
 func chargeModeHandler(lp loadpoint.API) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
-		vars := mux.Vars(r)
+		var mode api.ChargeMode
+
+		s, err := valueParam(r)
+		if err == nil {
+			mode, err = api.ChargeModeString(s)
+		}
 
-		mode, err := api.ChargeModeString(vars["value"])
 		if err != nil {
 			jsonError(w, http.StatusBadRequest, err)
 			return
@@ -157,9 +229,12 @@ func chargeModeHandler(lp loadpoint.API) http.HandlerFunc {
 // phasesHandler updates minimum soc
 func phasesHandler(lp loadpoint.API) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
-		vars := mux.Vars(r)
+		var phases int
 
-		phases, err := strconv.Atoi(vars["value"])
+		s, err := valueParam(r)
+		if err == nil {
+			phases, err = strconv.Atoi(s)
+		}
 		if err == nil {
 			err = lp.SetPhases(phases)
 		}
@@ -173,61 +248,88 @@ func phasesHandler(lp loadpoint.API) http.HandlerFunc {
 	}
 }
 
-// remoteDemandHandler updates minimum soc
+// remoteDemand is the remote demand request and result
+type remoteDemand struct {
+	Demand loadpoint.RemoteDemand `json:"demand"`
+	Source string                 `json:"source"`
+}
+
+// remoteDemandHandler updates remote demand from path parameters or json body
 func remoteDemandHandler(lp loadpoint.API) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			Demand string `json:"demand"`
+			Source string `json:"source"`
+		}
+
 		vars := mux.Vars(r)
+		if demand, ok := vars["demand"]; ok {
+			req.Demand, req.Source = demand, vars["source"]
+		} else if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			jsonError(w, http.StatusBadRequest, fmt.Errorf("invalid request body: %w", err))
+			return
+		}
 
-		source := vars["source"]
-		demand, err := loadpoint.RemoteDemandString(vars["demand"])
+		demand, err := loadpoint.RemoteDemandString(req.Demand)
 		if err != nil {
 			jsonError(w, http.StatusBadRequest, err)
 			return
 		}
 
-		lp.RemoteControl(source, demand)
+		lp.RemoteControl(req.Source, demand)
 
-		res := struct {
-			Demand loadpoint.RemoteDemand `json:"demand"`
-			Source string                 `json:"source"`
-		}{
-			Source: source,
-			Demand: demand,
-		}
-
-		jsonResult(w, res)
+		jsonResult(w, remoteDemand{Demand: demand, Source: req.Source})
 	}
 }
 
-// targetChargeHandler updates target soc
+// targetCharge is the target charge request and result
+type targetCharge struct {
+	SoC  int        `json:"soc"`
+	Time *time.Time `json:"time"`
+}
+
+// targetChargeHandler updates target soc from path parameters or json body
 func targetChargeHandler(loadpoint targetCharger) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
-		vars := mux.Vars(r)
-
-		socS, ok := vars["soc"]
-		socV, err := strconv.Atoi(socS)
+		var req targetCharge
 
-		if !ok || err != nil {
-			w.WriteHeader(http.StatusBadRequest)
+		vars := mux.Vars(r)
+		if socS, ok := vars["soc"]; ok {
+			soc, err := strconv.Atoi(socS)
+			if err != nil {
+				jsonError(w, http.StatusBadRequest, fmt.Errorf("invalid soc: %s", socS))
+				return
+			}
+
+			ts, err := time.Parse(time.RFC3339, vars["time"])
+			if err != nil {
+				jsonError(w, http.StatusBadRequest, err)
+				return
+			}
+
+			req = targetCharge{SoC: soc, Time: &ts}
+		} else if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			jsonError(w, http.StatusBadRequest, fmt.Errorf("invalid request body: %w", err))
 			return
 		}
 
-		timeS, ok := vars["time"]
-		timeV, err := time.Parse(time.RFC3339, timeS)
-
-		if !ok || err != nil {
-			jsonError(w, http.StatusBadRequest, err)
+		if req.Time == nil || req.Time.IsZero() {
+			jsonError(w, http.StatusBadRequest, errors.New("missing time"))
 			return
 		}
 
-		loadpoint.SetTargetCharge(timeV, socV)
+		loadpoint.SetTargetCharge(*req.Time, req.SoC)
 
-		res := struct {
-			SoC  int       `json:"soc"`
-			Time time.Time `json:"time"`
-		}{
-			SoC:  socV,
-			Time: timeV,
+		jsonResult(w, req)
+	}
+}
+
+// targetChargeGetHandler returns target soc and time
+func targetChargeGetHandler(lp loadpoint.API) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		res := targetCharge{SoC: lp.GetTargetSoC()}
+		if ts := lp.GetTargetTime(); !ts.IsZero() {
+			res.Time = &ts
 		}
 
 		jsonResult(w, res)
@@ -243,26 +345,121 @@ func targetChargeRemoveHandler(loadpoint loadpoint.API) http.HandlerFunc {
 	}
 }
 
-// vehicleHandler sets active vehicle
+// vehicle is the active vehicle result
+type vehicle struct {
+	Vehicle string `json:"vehicle"`
+}
+
+// vehicleHandler sets active vehicle by index from path parameter or json body
 func vehicleHandler(site site.API, loadpoint loadpoint.API) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
-		vars := mux.Vars(r)
+		var req struct {
+			Vehicle *int `json:"vehicle"`
+		}
 
-		valS, ok := vars["vehicle"]
-		val, err := strconv.Atoi(valS)
+		if valS, ok := mux.Vars(r)["vehicle"]; ok {
+			val, err := strconv.Atoi(valS)
+			if err != nil {
+				jsonError(w, http.StatusBadRequest, fmt.Errorf("invalid vehicle: %s", valS))
+				return
+			}
+			req.Vehicle = &val
+		} else if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			jsonError(w, http.StatusBadRequest, fmt.Errorf("invalid request body: %w", err))
+			return
+		}
 
 		vehicles := site.GetVehicles()
-		if !ok || val >= len(vehicles) || err != nil {
-			w.WriteHeader(http.StatusBadRequest)
+		if req.Vehicle == nil || *req.Vehicle < 0 || *req.Vehicle >= len(vehicles) {
+			jsonError(w, http.StatusBadRequest, errors.New("invalid vehicle"))
 			return
 		}
 
-		loadpoint.SetVehicle(vehicles[val])
+		v := vehicles[*req.Vehicle]
+		loadpoint.SetVehicle(v)
+
+		jsonResult(w, vehicle{Vehicle: v.Title()})
+	}
+}
+
+// vehicleGetHandler returns the active vehicle
+func vehicleGetHandler(lp loadpoint.API) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var res *vehicle
+		if v := lp.GetVehicle(); v != nil {
+			res = &vehicle{Vehicle: v.Title()}
+		}
+
+		jsonResult(w, res)
+	}
+}
+
+// vehiclesHandler returns the configured vehicles' titles
+func vehiclesHandler(site site.API) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		jsonResult(w, vehicleTitles(site.GetVehicles()))
+	}
+}
+
+func vehicleTitles(vehicles []api.Vehicle) []string {
+	res := make([]string, 0, len(vehicles))
+	for _, v := range vehicles {
+		res = append(res, v.Title())
+	}
+	return res
+}
+
+// loadpointSettings are the loadpoint's settings
+type loadpointSettings struct {
+	ID         int            `json:"id"`
+	Name       string         `json:"name"`
+	Mode       api.ChargeMode `json:"mode"`
+	TargetSoC  int            `json:"targetSoC"`
+	MinSoC     int            `json:"minSoC"`
+	MinCurrent float64        `json:"minCurrent"`
+	MaxCurrent float64        `json:"maxCurrent"`
+	Phases     int            `json:"phases"`
+	TargetTime *time.Time     `json:"targetTime"`
+	Vehicle    *string        `json:"vehicle"`
+}
 
-		res := struct {
-			Vehicle string `json:"vehicle"`
-		}{
-			Vehicle: vehicles[val].Title(),
+func settings(id int, lp loadpoint.API) loadpointSettings {
+	res := loadpointSettings{
+		ID:         id,
+		Name:       lp.Name(),
+		Mode:       lp.GetMode(),
+		TargetSoC:  lp.GetTargetSoC(),
+		MinSoC:     lp.GetMinSoC(),
+		MinCurrent: lp.GetMinCurrent(),
+		MaxCurrent: lp.GetMaxCurrent(),
+		Phases:     lp.GetPhases(),
+	}
+
+	if ts := lp.GetTargetTime(); !ts.IsZero() {
+		res.TargetTime = &ts
+	}
+
+	if v := lp.GetVehicle(); v != nil {
+		title := v.Title()
+		res.Vehicle = &title
+	}
+
+	return res
+}
+
+// loadpointHandler returns the loadpoint's settings
+func loadpointHandler(id int, lp loadpoint.API) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		jsonResult(w, settings(id, lp))
+	}
+}
+
+// loadpointsHandler returns all loadpoints' settings
+func loadpointsHandler(site site.API) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		res := make([]loadpointSettings, 0, len(site.LoadPoints()))
+		for id, lp := range site.LoadPoints() {
+			res = append(res, settings(id, lp))
 		}
 
 		jsonResult(w, res)
@@ -288,8 +485,13 @@ func vehicleDetectHandler(loadpoint loadpoint.API) http.HandlerFunc {
 }
 
 // socketHandler attaches websocket handler to uri
-func socketHandler(hub *SocketHub) http.HandlerFunc {
+func socketHandler(hub *SocketHub, auth *Auth) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
+		if !auth.CheckOrigin(r) {
+			jsonError(w, http.StatusForbidden, errors.New("origin not allowed"))
+			return
+		}
+
 		ServeWebsocket(hub, w, r)
 	}
 }
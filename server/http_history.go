@@ -0,0 +1,73 @@
+package server
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/evcc-io/evcc/server/history"
+)
+
+// parseTime parses RFC3339 or unix timestamps
+func parseTime(s string) (time.Time, error) {
+	if ts, err := strconv.ParseInt(s, 10, 64); err == nil {
+		return time.Unix(ts, 0), nil
+	}
+	return time.Parse(time.RFC3339, s)
+}
+
+// HistoryHandler returns the recorded values by key.
+// Query parameters are keys (comma-separated, default all), from and to (RFC3339 or unix, default last 24h) and step (duration).
+func HistoryHandler(store *history.Store) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+
+		to := time.Now()
+		if s := q.Get("to"); s != "" {
+			var err error
+			if to, err = parseTime(s); err != nil {
+				jsonError(w, http.StatusBadRequest, fmt.Errorf("to: %w", err))
+				return
+			}
+		}
+
+		from := to.Add(-24 * time.Hour)
+		if s := q.Get("from"); s != "" {
+			var err error
+			if from, err = parseTime(s); err != nil {
+				jsonError(w, http.StatusBadRequest, fmt.Errorf("from: %w", err))
+				return
+			}
+		}
+
+		if !from.Before(to) {
+			jsonError(w, http.StatusBadRequest, errors.New("from must be before to"))
+			return
+		}
+
+		var step time.Duration
+		if s := q.Get("step"); s != "" {
+			var err error
+			if step, err = time.ParseDuration(s); err != nil || step < 0 {
+				jsonError(w, http.StatusBadRequest, fmt.Errorf("invalid step: %s", s))
+				return
+			}
+		}
+
+		keys := store.Keys()
+		if s := q.Get("keys"); s != "" {
+			keys = strings.Split(s, ",")
+		}
+
+		res := make(map[string][]history.Sample, len(keys))
+		for _, key := range keys {
+			key = strings.TrimSpace(key)
+			res[key] = store.Query(key, from, to, step)
+		}
+
+		jsonResult(w, res)
+	}
+}
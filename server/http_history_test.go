@@ -0,0 +1,53 @@
+package server
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/evcc-io/evcc/server/history"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHistoryHandler(t *testing.T) {
+	store, err := history.New("")
+	require.NoError(t, err)
+
+	now := time.Now().Truncate(time.Second)
+	store.Record("gridPower", now.Add(-time.Hour), 1000)
+	store.Record("pvPower", now.Add(-time.Hour), 2000)
+
+	h := HistoryHandler(store)
+
+	request := func(query string) (int, map[string]json.RawMessage) {
+		rr := httptest.NewRecorder()
+		h.ServeHTTP(rr, httptest.NewRequest(http.MethodGet, "/api/history?"+query, nil))
+
+		var res map[string]json.RawMessage
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &res), rr.Body.String())
+
+		return rr.Code, res
+	}
+
+	code, res := request("keys=gridPower")
+	require.Equal(t, http.StatusOK, code)
+
+	var result map[string][]history.Sample
+	require.NoError(t, json.Unmarshal(res["result"], &result))
+	require.Len(t, result["gridPower"], 1)
+	assert.Equal(t, 1000.0, result["gridPower"][0].Value)
+	assert.NotContains(t, result, "pvPower")
+
+	code, _ = request(fmt.Sprintf("from=%d&to=%s&step=1m", now.Add(-2*time.Hour).Unix(), now.Format(time.RFC3339)))
+	assert.Equal(t, http.StatusOK, code)
+
+	code, _ = request("from=foo")
+	assert.Equal(t, http.StatusBadRequest, code)
+
+	code, _ = request("step=-1m")
+	assert.Equal(t, http.StatusBadRequest, code)
+}
@@ -0,0 +1,166 @@
+package server
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/evcc-io/evcc/api"
+	"github.com/evcc-io/evcc/core/loadpoint"
+	"github.com/evcc-io/evcc/core/site"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type httpSite struct {
+	site.API
+	lp        loadpoint.API
+	bufferSoC float64
+}
+
+func (s *httpSite) LoadPoints() []loadpoint.API    { return []loadpoint.API{s.lp} }
+func (s *httpSite) GetVehicles() []api.Vehicle     { return nil }
+func (s *httpSite) GetBufferSoC() float64          { return s.bufferSoC }
+func (s *httpSite) SetBufferSoC(v float64) error   { s.bufferSoC = v; return nil }
+func (s *httpSite) GetPrioritySoC() float64        { return 0 }
+func (s *httpSite) SetPrioritySoC(float64) error   { return nil }
+func (s *httpSite) GetResidualPower() float64      { return 0 }
+func (s *httpSite) SetResidualPower(float64) error { return nil }
+func (s *httpSite) GetPowerLimit() float64         { return 0 }
+func (s *httpSite) SetPowerLimit(string, float64)  {}
+
+type httpLoadpoint struct {
+	loadpoint.API
+	mode      api.ChargeMode
+	targetSoC int
+}
+
+func (lp *httpLoadpoint) Name() string                { return "Garage" }
+func (lp *httpLoadpoint) GetTargetTime() time.Time    { return time.Time{} }
+func (lp *httpLoadpoint) GetVehicle() api.Vehicle     { return nil }
+func (lp *httpLoadpoint) GetMode() api.ChargeMode     { return lp.mode }
+func (lp *httpLoadpoint) SetMode(mode api.ChargeMode) { lp.mode = mode }
+func (lp *httpLoadpoint) GetTargetSoC() int           { return lp.targetSoC }
+func (lp *httpLoadpoint) SetTargetSoC(soc int)        { lp.targetSoC = soc }
+func (lp *httpLoadpoint) GetMinSoC() int              { return 0 }
+func (lp *httpLoadpoint) SetMinSoC(int)               {}
+func (lp *httpLoadpoint) GetMinCurrent() float64      { return 6 }
+func (lp *httpLoadpoint) SetMinCurrent(float64)       {}
+func (lp *httpLoadpoint) GetMaxCurrent() float64      { return 16 }
+func (lp *httpLoadpoint) SetMaxCurrent(float64)       {}
+func (lp *httpLoadpoint) GetPhases() int              { return 3 }
+
+func TestHTTPApi(t *testing.T) {
+	lp := &httpLoadpoint{mode: api.ModeOff, targetSoC: 100}
+	s := &httpSite{lp: lp}
+
+	router := NewHTTPd(":0", s, nil, nil, nil).Router()
+
+	request := func(method, path, body string) (int, map[string]interface{}) {
+		req := httptest.NewRequest(method, path, strings.NewReader(body))
+		rr := httptest.NewRecorder()
+		router.ServeHTTP(rr, req)
+
+		var res map[string]interface{}
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &res), rr.Body.String())
+
+		return rr.Code, res
+	}
+
+	// get
+	code, res := request(http.MethodGet, "/api/loadpoints/0/targetsoc", "")
+	assert.Equal(t, http.StatusOK, code)
+	assert.Equal(t, float64(100), res["result"])
+
+	// set by json body
+	code, res = request(http.MethodPost, "/api/loadpoints/0/targetsoc", `{"value": 80}`)
+	assert.Equal(t, http.StatusOK, code)
+	assert.Equal(t, float64(80), res["result"])
+	assert.Equal(t, 80, lp.targetSoC)
+
+	// set by path
+	code, _ = request(http.MethodPost, "/api/loadpoints/0/mode/pv", "")
+	assert.Equal(t, http.StatusOK, code)
+	assert.Equal(t, api.ModePV, lp.mode)
+
+	code, _ = request(http.MethodPost, "/api/buffersoc", `{"value": 50}`)
+	assert.Equal(t, http.StatusOK, code)
+	assert.Equal(t, 50.0, s.bufferSoC)
+
+	// errors
+	code, res = request(http.MethodPost, "/api/loadpoints/0/mode", `{"value": "foo"}`)
+	assert.Equal(t, http.StatusBadRequest, code)
+	assert.Equal(t, float64(http.StatusBadRequest), res["status"])
+	assert.NotEmpty(t, res["error"])
+
+	code, res = request(http.MethodPost, "/api/loadpoints/0/targetsoc", `{}`)
+	assert.Equal(t, http.StatusBadRequest, code)
+	assert.Equal(t, "missing value", res["error"])
+
+	code, res = request(http.MethodGet, "/api/foo", "")
+	assert.Equal(t, http.StatusNotFound, code)
+	assert.Equal(t, float64(http.StatusNotFound), res["status"])
+
+	// loadpoint settings
+	code, res = request(http.MethodGet, "/api/loadpoints/0", "")
+	assert.Equal(t, http.StatusOK, code)
+	assert.Equal(t, "pv", res["result"].(map[string]interface{})["mode"])
+
+	// device diagnostics
+	code, res = request(http.MethodGet, "/api/diagnostics", "")
+	assert.Equal(t, http.StatusOK, code)
+	assert.IsType(t, []interface{}{}, res["result"])
+
+	// api documentation
+	code, res = request(http.MethodGet, "/api/openapi.json", "")
+	assert.Equal(t, http.StatusOK, code)
+
+	paths := res["result"].(map[string]interface{})["paths"].(map[string]interface{})
+	ops := paths["/loadpoints/{id}/targetsoc"].(map[string]interface{})
+	assert.Contains(t, ops, "get")
+	assert.Contains(t, ops, "post")
+	assert.Contains(t, paths, "/loadpoints/{id}/targetcharge/{soc}/{time}")
+}
+
+func TestHTTPApiLateRoutes(t *testing.T) {
+	router := NewHTTPd(":0", &httpSite{lp: &httpLoadpoint{}}, nil, nil, nil).Router()
+	router.PathPrefix("/api/update").HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusTeapot)
+	})
+
+	rr := httptest.NewRecorder()
+	router.ServeHTTP(rr, httptest.NewRequest(http.MethodPost, "/api/update", nil))
+	assert.Equal(t, http.StatusTeapot, rr.Code)
+
+	rr = httptest.NewRecorder()
+	router.ServeHTTP(rr, httptest.NewRequest(http.MethodPut, "/api/loadpoints/0/mode", nil))
+	assert.Equal(t, http.StatusMethodNotAllowed, rr.Code)
+	assert.Contains(t, rr.Body.String(), `"status":405`)
+}
+
+func TestHTTPApiAddRoutes(t *testing.T) {
+	httpd := NewHTTPd(":0", &httpSite{lp: &httpLoadpoint{}}, nil, nil, nil)
+	httpd.AddRoutes(map[string]Route{
+		"getFoo": {[]string{"GET"}, "/foo", getHandler(func() string { return "bar" }), "Get foo", nil, ""},
+	})
+
+	request := func(path string) map[string]interface{} {
+		rr := httptest.NewRecorder()
+		httpd.Router().ServeHTTP(rr, httptest.NewRequest(http.MethodGet, path, nil))
+		require.Equal(t, http.StatusOK, rr.Code)
+		assert.Equal(t, "application/json; charset=UTF-8", rr.Header().Get("Content-Type"))
+
+		var res map[string]interface{}
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &res), rr.Body.String())
+
+		return res
+	}
+
+	assert.Equal(t, "bar", request("/api/foo")["result"])
+
+	paths := request("/api/openapi.json")["result"].(map[string]interface{})["paths"].(map[string]interface{})
+	assert.Contains(t, paths, "/foo")
+}
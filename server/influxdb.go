@@ -1,6 +1,8 @@
 package server
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"sync"
 	"time"
@@ -8,50 +10,127 @@ import (
 	"github.com/evcc-io/evcc/core/loadpoint"
 	"github.com/evcc-io/evcc/util"
 	influxdb2 "github.com/influxdata/influxdb-client-go/v2"
+	"github.com/influxdata/influxdb-client-go/v2/api/write"
+	"github.com/influxdata/influxdb-client-go/v2/domain"
 	influxlog "github.com/influxdata/influxdb-client-go/v2/log"
 )
 
+// Influx point tags
+const (
+	InfluxTagLoadpoint = "loadpoint"
+	InfluxTagVehicle   = "vehicle"
+	InfluxTagSite      = "site"
+)
+
 // InfluxConfig is the influx db configuration
 type InfluxConfig struct {
-	URL      string
-	Database string
-	Token    string
-	Org      string
-	User     string
-	Password string
-	Interval time.Duration
+	URL       string
+	Database  string // database (v1) or bucket (v2)
+	Token     string
+	Org       string
+	User      string
+	Password  string
+	Interval  time.Duration
+	Tags      []string      // tags added to points: loadpoint, vehicle, site
+	Fields    []string      // values to write, empty for all
+	Buffer    uint          // max points kept for retrying while the database is unavailable
+	BatchSize uint          // points per write
+	Retry     time.Duration // max time for retrying failed writes
+	Retention time.Duration // bucket retention (v2 only), creates the bucket if missing
+}
+
+// influxDefaults are the defaults applied to unset configuration values
+var influxDefaults = InfluxConfig{
+	Tags:      []string{InfluxTagLoadpoint, InfluxTagVehicle},
+	Buffer:    50000,
+	BatchSize: 100,
+	Retry:     time.Hour,
 }
 
 // Influx is a influx publisher
 type Influx struct {
 	sync.Mutex
-	log      *util.Logger
-	client   influxdb2.Client
-	org      string
-	database string
+	log       *util.Logger
+	client    influxdb2.Client
+	org       string
+	database  string
+	retention time.Duration
+	tags      map[string]bool
+	fields    map[string]bool
+
+	// tag values
+	site     string
+	vehicles map[int]string
 }
 
 // NewInfluxClient creates new publisher for influx
-func NewInfluxClient(url, token, org, user, password, database string) *Influx {
+func NewInfluxClient(cc InfluxConfig) (*Influx, error) {
 	log := util.NewLogger("influx")
 
+	if cc.Tags == nil {
+		cc.Tags = influxDefaults.Tags
+	}
+	if cc.Buffer == 0 {
+		cc.Buffer = influxDefaults.Buffer
+	}
+	if cc.BatchSize == 0 {
+		cc.BatchSize = influxDefaults.BatchSize
+	}
+	if cc.Retry == 0 {
+		cc.Retry = influxDefaults.Retry
+	}
+
+	tags := make(map[string]bool)
+	for _, tag := range cc.Tags {
+		switch tag {
+		case InfluxTagLoadpoint, InfluxTagVehicle, InfluxTagSite:
+			tags[tag] = true
+		default:
+			return nil, fmt.Errorf("invalid tag: %s", tag)
+		}
+	}
+
+	var fields map[string]bool
+	if len(cc.Fields) > 0 {
+		fields = make(map[string]bool)
+		for _, field := range cc.Fields {
+			fields[field] = true
+		}
+	}
+
 	// InfluxDB v1 compatibility
-	if token == "" && user != "" {
-		token = fmt.Sprintf("%s:%s", user, password)
+	token := cc.Token
+	if token == "" && cc.User != "" {
+		token = fmt.Sprintf("%s:%s", cc.User, cc.Password)
+	}
+
+	if cc.Retention > 0 && cc.Token == "" {
+		return nil, errors.New("retention requires InfluxDB 2 token")
 	}
 
-	options := influxdb2.DefaultOptions().SetPrecision(time.Second).SetHTTPRequestTimeout(60)
-	client := influxdb2.NewClientWithOptions(url, token, options)
+	// batches are retried with exponential backoff until the retry time has passed,
+	// the oldest batches are dropped when the buffer is full
+	options := influxdb2.DefaultOptions().SetPrecision(time.Second).SetHTTPRequestTimeout(60).
+		SetBatchSize(cc.BatchSize).
+		SetRetryBufferLimit(cc.Buffer).
+		SetMaxRetries(uint(cc.Retry / (5 * time.Second))).
+		SetMaxRetryTime(uint(cc.Retry.Milliseconds()))
+
+	client := influxdb2.NewClientWithOptions(cc.URL, token, options)
 
 	// handle error logging in writer
 	influxlog.Log = nil
 
 	return &Influx{
-		log:      log,
-		client:   client,
-		org:      org,
-		database: database,
-	}
+		log:       log,
+		client:    client,
+		org:       cc.Org,
+		database:  cc.Database,
+		retention: cc.Retention,
+		tags:      tags,
+		fields:    fields,
+		vehicles:  make(map[int]string),
+	}, nil
 }
 
 // supportedType checks if type can be written as influx value
@@ -72,66 +151,132 @@ func (m *Influx) supportedType(p util.Param) bool {
 	}
 }
 
-// Run Influx publisher
-func (m *Influx) Run(loadPoints []loadpoint.API, in <-chan util.Param) {
-	writer := m.client.WriteAPI(m.org, m.database)
+// ensureBucket creates the bucket or updates its retention
+func (m *Influx) ensureBucket() error {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
 
-	// log errors
-	go func() {
-		for err := range writer.Errors() {
-			// log async as we're part of the logging loop
-			go m.log.ERROR.Println(err)
+	rules := domain.RetentionRules{{
+		Type:         domain.RetentionRuleTypeExpire,
+		EverySeconds: int64(m.retention.Seconds()),
+	}}
+
+	buckets := m.client.BucketsAPI()
+
+	bucket, err := buckets.FindBucketByName(ctx, m.database)
+	if err != nil {
+		org, err := m.client.OrganizationsAPI().FindOrganizationByName(ctx, m.org)
+		if err != nil {
+			return fmt.Errorf("organization: %w", err)
 		}
-	}()
 
-	// track active vehicle per loadpoint
-	vehicles := make(map[int]string)
+		if _, err := buckets.CreateBucketWithName(ctx, org, m.database, rules...); err != nil {
+			return fmt.Errorf("create bucket: %w", err)
+		}
 
-	// add points to batch for async writing
-	for param := range in {
-		// vehicle name
-		if param.LoadPoint != nil {
-			if name, ok := param.Val.(string); ok && param.Key == "vehicleTitle" {
-				vehicles[*param.LoadPoint] = name
-				continue
-			}
+		m.log.INFO.Printf("created bucket %s with %v retention", m.database, m.retention)
+		return nil
+	}
+
+	if len(bucket.RetentionRules) == 1 && bucket.RetentionRules[0].EverySeconds == rules[0].EverySeconds {
+		return nil
+	}
+
+	bucket.RetentionRules = rules
+	if _, err := buckets.UpdateBucket(ctx, bucket); err != nil {
+		return fmt.Errorf("update bucket: %w", err)
+	}
+
+	m.log.INFO.Printf("updated bucket %s retention to %v", m.database, m.retention)
+	return nil
+}
+
+// point creates the point for the param or returns nil if the param is not written
+func (m *Influx) point(loadPoints []loadpoint.API, param util.Param, ts time.Time) *write.Point {
+	// tag values
+	if name, ok := param.Val.(string); ok {
+		switch {
+		case param.LoadPoint == nil && param.Key == "siteTitle":
+			m.site = name
+		case param.LoadPoint != nil && param.Key == "vehicleTitle":
+			m.vehicles[*param.LoadPoint] = name
 		}
+		return nil
+	}
 
-		if !m.supportedType(param) {
-			continue
+	if m.fields != nil && !m.fields[param.Key] {
+		return nil
+	}
+
+	if !m.supportedType(param) {
+		return nil
+	}
+
+	tags := map[string]string{}
+	if m.tags[InfluxTagSite] {
+		tags[InfluxTagSite] = m.site
+	}
+	if param.LoadPoint != nil {
+		if m.tags[InfluxTagLoadpoint] {
+			tags[InfluxTagLoadpoint] = loadPoints[*param.LoadPoint].Name()
+		}
+		if m.tags[InfluxTagVehicle] {
+			tags[InfluxTagVehicle] = m.vehicles[*param.LoadPoint]
 		}
+	}
+
+	fields := map[string]interface{}{}
+
+	// array to slice
+	val := param.Val
+	if v, ok := val.([3]float64); ok {
+		val = v[:]
+	}
 
-		tags := map[string]string{}
-		if param.LoadPoint != nil {
-			tags["loadpoint"] = loadPoints[*param.LoadPoint].Name()
-			tags["vehicle"] = vehicles[*param.LoadPoint]
+	// add slice as phase values
+	if phases, ok := val.([]float64); ok {
+		var total float64
+		for i, v := range phases {
+			total += v
+			fields[fmt.Sprintf("l%d", i+1)] = v
 		}
 
-		fields := map[string]interface{}{}
+		// add total as "value"
+		val = total
+	}
+
+	fields["value"] = val
 
-		// array to slice
-		val := param.Val
-		if v, ok := val.([3]float64); ok {
-			val = v[:]
+	return influxdb2.NewPoint(param.Key, tags, fields, ts)
+}
+
+// Run Influx publisher
+func (m *Influx) Run(loadPoints []loadpoint.API, in <-chan util.Param) {
+	if m.retention > 0 {
+		if err := m.ensureBucket(); err != nil {
+			m.log.ERROR.Println(err)
 		}
+	}
 
-		// add slice as phase values
-		if phases, ok := val.([]float64); ok {
-			var total float64
-			for i, v := range phases {
-				total += v
-				fields[fmt.Sprintf("l%d", i+1)] = v
-			}
+	writer := m.client.WriteAPI(m.org, m.database)
 
-			// add total as "value"
-			val = total
+	// log errors
+	go func() {
+		for err := range writer.Errors() {
+			// log async as we're part of the logging loop
+			go m.log.ERROR.Println(err)
 		}
+	}()
 
-		fields["value"] = val
+	// add points to batch for async writing
+	for param := range in {
+		p := m.point(loadPoints, param, time.Now())
+		if p == nil {
+			continue
+		}
 
 		// write asynchronously
-		m.log.TRACE.Printf("write %s=%v (%v)", param.Key, param.Val, tags)
-		p := influxdb2.NewPoint(param.Key, tags, fields, time.Now())
+		m.log.TRACE.Printf("write %s=%v", param.Key, param.Val)
 		writer.WritePoint(p)
 	}
 
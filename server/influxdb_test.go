@@ -0,0 +1,66 @@
+package server
+
+import (
+	"testing"
+	"time"
+
+	"github.com/evcc-io/evcc/core/loadpoint"
+	"github.com/evcc-io/evcc/util"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInfluxPoint(t *testing.T) {
+	m, err := NewInfluxClient(InfluxConfig{
+		URL:    "http://localhost:8086",
+		Tags:   []string{InfluxTagLoadpoint, InfluxTagSite},
+		Fields: []string{"chargePower", "chargeCurrents"},
+	})
+	require.NoError(t, err)
+
+	lps := []loadpoint.API{&httpLoadpoint{}}
+	lp := 0
+	ts := time.Now()
+
+	tags := func(p util.Param) map[string]string {
+		point := m.point(lps, p, ts)
+		if point == nil {
+			return nil
+		}
+
+		res := make(map[string]string)
+		for _, tag := range point.TagList() {
+			res[tag.Key] = tag.Value
+		}
+		return res
+	}
+
+	assert.Nil(t, m.point(lps, util.Param{Key: "siteTitle", Val: "Home"}, ts))
+	assert.Nil(t, m.point(lps, util.Param{LoadPoint: &lp, Key: "vehicleTitle", Val: "eGolf"}, ts))
+
+	// filtered
+	assert.Nil(t, m.point(lps, util.Param{Key: "gridPower", Val: 1000.0}, ts))
+
+	assert.Equal(t, map[string]string{
+		"site":      "Home",
+		"loadpoint": "Garage",
+	}, tags(util.Param{LoadPoint: &lp, Key: "chargePower", Val: 11000.0}))
+
+	// phase values
+	p := m.point(lps, util.Param{LoadPoint: &lp, Key: "chargeCurrents", Val: []float64{1, 2, 3}}, ts)
+	require.NotNil(t, p)
+
+	fields := make(map[string]interface{})
+	for _, f := range p.FieldList() {
+		fields[f.Key] = f.Value
+	}
+	assert.Equal(t, map[string]interface{}{"l1": 1.0, "l2": 2.0, "l3": 3.0, "value": 6.0}, fields)
+}
+
+func TestInfluxConfig(t *testing.T) {
+	_, err := NewInfluxClient(InfluxConfig{URL: "http://localhost:8086", Tags: []string{"foo"}})
+	assert.Error(t, err)
+
+	_, err = NewInfluxClient(InfluxConfig{URL: "http://localhost:8086", User: "user", Retention: time.Hour})
+	assert.Error(t, err)
+}
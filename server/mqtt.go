@@ -1,7 +1,9 @@
 package server
 
 import (
+	"encoding/json"
 	"fmt"
+	"math"
 	"strconv"
 	"strings"
 	"time"
@@ -15,8 +17,11 @@ import (
 
 // MQTT is the MQTT server. It uses the MQTT client for publishing.
 type MQTT struct {
-	Handler *mqtt.Client
-	root    string
+	Handler         *mqtt.Client
+	root            string
+	discoveryPrefix string      // Home Assistant discovery prefix, empty if disabled
+	discovered      []string    // vehicle titles of the published discovery configs
+	cache           *util.Cache // full state source, nil if disabled
 }
 
 // NewMQTT creates MQTT server
@@ -27,6 +32,18 @@ func NewMQTT(root string) *MQTT {
 	}
 }
 
+// WithState enables publishing the full site and loadpoint state as json
+func (m *MQTT) WithState(cache *util.Cache) *MQTT {
+	m.cache = cache
+	return m
+}
+
+// WithDiscovery enables Home Assistant discovery using the given prefix
+func (m *MQTT) WithDiscovery(prefix string) *MQTT {
+	m.discoveryPrefix = strings.TrimSuffix(prefix, "/")
+	return m
+}
+
 func (m *MQTT) encode(v interface{}) string {
 	// nil should erase the value
 	if v == nil {
@@ -82,75 +99,110 @@ func (m *MQTT) publish(topic string, retained bool, payload interface{}) {
 		}
 	}
 
+	// publish values by name, e.g. energy totals
+	if values, ok := payload.(map[string]float64); ok {
+		for k, v := range values {
+			m.publishSingleValue(fmt.Sprintf("%s/%s", topic, k), retained, v)
+		}
+		return
+	}
+
 	m.publishSingleValue(topic, retained, payload)
 }
 
-func (m *MQTT) listenSetters(topic string, site site.API, lp loadpoint.API) {
-	m.Handler.ListenSetter(topic+"/mode/set", func(payload string) {
-		lp.SetMode(api.ChargeMode(payload))
-	})
-	m.Handler.ListenSetter(topic+"/minSoC/set", func(payload string) {
-		if soc, err := strconv.Atoi(payload); err == nil {
-			lp.SetMinSoC(soc)
-		}
-	})
-	m.Handler.ListenSetter(topic+"/targetSoC/set", func(payload string) {
-		if soc, err := strconv.Atoi(payload); err == nil {
-			lp.SetTargetSoC(soc)
-		}
+// ack publishes the result of a set request to the ack topic
+func (m *MQTT) ack(topic string, val interface{}, err error) {
+	res := struct {
+		Value interface{} `json:"value,omitempty"`
+		Error string      `json:"error,omitempty"`
+	}{
+		Value: val,
+	}
+
+	if err != nil {
+		res.Value = nil
+		res.Error = err.Error()
+	}
+
+	b, _ := json.Marshal(res)
+	m.publishSingleValue(topic+"/ack", false, string(b))
+}
+
+// setter listens for set requests and acknowledges each request on the <topic>/ack topic
+func (m *MQTT) setter(topic string, set func(string) (interface{}, error)) {
+	m.Handler.ListenSetter(topic, func(payload string) {
+		val, err := set(payload)
+		m.ack(topic, val, err)
 	})
-	m.Handler.ListenSetter(topic+"/minCurrent/set", func(payload string) {
-		if current, err := strconv.ParseFloat(payload, 64); err == nil {
-			lp.SetMinCurrent(current)
+}
+
+func intSetter(set func(int) error) func(string) (interface{}, error) {
+	return func(payload string) (interface{}, error) {
+		val, err := strconv.Atoi(payload)
+		if err == nil {
+			err = set(val)
 		}
-	})
-	m.Handler.ListenSetter(topic+"/maxCurrent/set", func(payload string) {
-		if current, err := strconv.ParseFloat(payload, 64); err == nil {
-			lp.SetMaxCurrent(current)
+		return val, err
+	}
+}
+
+func floatSetter(set func(float64) error) func(string) (interface{}, error) {
+	return func(payload string) (interface{}, error) {
+		val, err := strconv.ParseFloat(payload, 64)
+		if err == nil {
+			err = set(val)
 		}
-	})
-	m.Handler.ListenSetter(topic+"/phases/set", func(payload string) {
-		if phases, err := strconv.Atoi(payload); err == nil {
-			_ = lp.SetPhases(phases)
+		return val, err
+	}
+}
+
+func (m *MQTT) listenSetters(topic string, site site.API, lp loadpoint.API) {
+	m.setter(topic+"/mode/set", func(payload string) (interface{}, error) {
+		mode, err := api.ChargeModeString(payload)
+		if err == nil {
+			lp.SetMode(mode)
 		}
+		return mode, err
 	})
-	m.Handler.ListenSetter(topic+"/vehicle/set", func(payload string) {
-		if vehicle, err := strconv.Atoi(payload); err == nil {
-			vehicles := site.GetVehicles()
-			if vehicle < len(vehicles) {
-				lp.SetVehicle(vehicles[vehicle])
-			}
+	m.setter(topic+"/minSoC/set", intSetter(func(soc int) error {
+		lp.SetMinSoC(soc)
+		return nil
+	}))
+	m.setter(topic+"/targetSoC/set", intSetter(func(soc int) error {
+		lp.SetTargetSoC(soc)
+		return nil
+	}))
+	m.setter(topic+"/minCurrent/set", floatSetter(func(current float64) error {
+		lp.SetMinCurrent(current)
+		return nil
+	}))
+	m.setter(topic+"/maxCurrent/set", floatSetter(func(current float64) error {
+		lp.SetMaxCurrent(current)
+		return nil
+	}))
+	m.setter(topic+"/phases/set", intSetter(lp.SetPhases))
+	m.setter(topic+"/vehicle/set", intSetter(func(vehicle int) error {
+		vehicles := site.GetVehicles()
+		if vehicle < 0 || vehicle >= len(vehicles) {
+			return fmt.Errorf("invalid vehicle: %d", vehicle)
 		}
-	})
+		lp.SetVehicle(vehicles[vehicle])
+		return nil
+	}))
 }
 
 // Run starts the MQTT publisher for the MQTT API
 func (m *MQTT) Run(site site.API, in <-chan util.Param) {
-	// alive
-	topic := fmt.Sprintf("%s/status", m.root)
-	m.publish(topic, true, "online")
+	// alive, the status topic is the last will and reset to offline when the connection is lost
+	m.publish(fmt.Sprintf("%s/status", m.root), true, "online")
 
 	// site setters
-	m.Handler.ListenSetter(fmt.Sprintf("%s/site/prioritySoC/set", m.root), func(payload string) {
-		if soc, err := strconv.Atoi(payload); err == nil {
-			_ = site.SetPrioritySoC(float64(soc))
-		}
-	})
-
-	m.Handler.ListenSetter(fmt.Sprintf("%s/site/bufferSoC/set", m.root), func(payload string) {
-		if soc, err := strconv.Atoi(payload); err == nil {
-			_ = site.SetBufferSoC(float64(soc))
-		}
-	})
-
-	m.Handler.ListenSetter(fmt.Sprintf("%s/site/residualPower/set", m.root), func(payload string) {
-		if soc, err := strconv.Atoi(payload); err == nil {
-			_ = site.SetResidualPower(float64(soc))
-		}
-	})
+	m.setter(fmt.Sprintf("%s/site/prioritySoC/set", m.root), floatSetter(site.SetPrioritySoC))
+	m.setter(fmt.Sprintf("%s/site/bufferSoC/set", m.root), floatSetter(site.SetBufferSoC))
+	m.setter(fmt.Sprintf("%s/site/residualPower/set", m.root), floatSetter(site.SetResidualPower))
 
 	// number of loadpoints
-	topic = fmt.Sprintf("%s/loadpoints", m.root)
+	topic := fmt.Sprintf("%s/loadpoints", m.root)
 	m.publish(topic, true, len(site.LoadPoints()))
 
 	// loadpoint setters
@@ -159,6 +211,11 @@ func (m *MQTT) Run(site site.API, in <-chan util.Param) {
 		m.listenSetters(topic, site, lp)
 	}
 
+	// home assistant discovery
+	if m.discoveryPrefix != "" {
+		m.publishDiscovery(site)
+	}
+
 	// remove deprecated topics
 	for id := range site.LoadPoints() {
 		topic := fmt.Sprintf("%s/loadpoints/%d", m.root, id+1)
@@ -172,16 +229,25 @@ func (m *MQTT) Run(site site.API, in <-chan util.Param) {
 
 	// publish
 	for p := range in {
+		// vehicle selection follows vehicle changes on reload
+		if m.rediscover(p) {
+			m.publishDiscovery(site)
+		}
+
 		topic := fmt.Sprintf("%s/site", m.root)
 		if p.LoadPoint != nil {
 			id := *p.LoadPoint + 1
 			topic = fmt.Sprintf("%s/loadpoints/%d", m.root, id)
 		}
 
-		// alive indicator
+		// alive indicator and full state
 		if time.Since(updated) > time.Second {
 			updated = time.Now()
 			m.publish(fmt.Sprintf("%s/updated", m.root), true, updated.Unix())
+
+			if m.cache != nil {
+				m.publishState()
+			}
 		}
 
 		// value
@@ -189,3 +255,61 @@ func (m *MQTT) Run(site site.API, in <-chan util.Param) {
 		m.publish(topic, true, p.Val)
 	}
 }
+
+// stateValue converts values to their json state representation consistent with the single value topics
+func stateValue(v interface{}) interface{} {
+	switch val := v.(type) {
+	case time.Time:
+		if val.IsZero() {
+			return nil
+		}
+		return val.Unix()
+	case time.Duration:
+		return int64(val.Seconds())
+	case float64:
+		if math.IsNaN(val) || math.IsInf(val, 0) {
+			return nil
+		}
+	}
+
+	return v
+}
+
+func stateValues(state map[string]interface{}) map[string]interface{} {
+	res := make(map[string]interface{}, len(state))
+	for k, v := range state {
+		res[k] = stateValue(v)
+	}
+	return res
+}
+
+// publishState publishes the cached site and loadpoint state as json documents
+func (m *MQTT) publishState() {
+	state := m.cache.State()
+
+	loadpoints, _ := state["loadpoints"].([]map[string]interface{})
+	delete(state, "loadpoints")
+
+	publish := func(topic string, state map[string]interface{}) {
+		b, err := json.Marshal(stateValues(state))
+		if err != nil {
+			log.ERROR.Printf("mqtt: %s: %v", topic, err)
+			return
+		}
+
+		m.publishSingleValue(topic, true, string(b))
+	}
+
+	publish(fmt.Sprintf("%s/site/state", m.root), state)
+
+	for id, lp := range loadpoints {
+		publish(fmt.Sprintf("%s/loadpoints/%d/state", m.root, id+1), lp)
+	}
+}
+
+// Shutdown marks the publisher offline on graceful shutdown
+func (m *MQTT) Shutdown() {
+	if err := m.Handler.Publish(fmt.Sprintf("%s/status", m.root), true, "offline"); err != nil {
+		log.ERROR.Printf("mqtt: status: %v", err)
+	}
+}
@@ -0,0 +1,218 @@
+package server
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+
+	paho "github.com/eclipse/paho.mqtt.golang"
+	"github.com/evcc-io/evcc/api"
+	"github.com/evcc-io/evcc/core/site"
+	"github.com/evcc-io/evcc/util"
+	"golang.org/x/exp/slices"
+)
+
+// Home Assistant MQTT discovery, see https://www.home-assistant.io/docs/mqtt/discovery/
+
+type haDevice struct {
+	Identifiers  []string `json:"identifiers"`
+	Name         string   `json:"name"`
+	Manufacturer string   `json:"manufacturer"`
+	Model        string   `json:"model"`
+	SwVersion    string   `json:"sw_version,omitempty"`
+	ViaDevice    string   `json:"via_device,omitempty"`
+}
+
+type haAvailability struct {
+	Topic               string `json:"topic"`
+	PayloadAvailable    string `json:"payload_available"`
+	PayloadNotAvailable string `json:"payload_not_available"`
+}
+
+type haEntity struct {
+	Name              string           `json:"name"`
+	UniqueID          string           `json:"unique_id"`
+	ObjectID          string           `json:"object_id"`
+	StateTopic        string           `json:"state_topic"`
+	CommandTopic      string           `json:"command_topic,omitempty"`
+	CommandTemplate   string           `json:"command_template,omitempty"`
+	UnitOfMeasurement string           `json:"unit_of_measurement,omitempty"`
+	DeviceClass       string           `json:"device_class,omitempty"`
+	StateClass        string           `json:"state_class,omitempty"`
+	Icon              string           `json:"icon,omitempty"`
+	Options           []string         `json:"options,omitempty"`
+	Min               *float64         `json:"min,omitempty"`
+	Max               *float64         `json:"max,omitempty"`
+	Step              float64          `json:"step,omitempty"`
+	PayloadOn         string           `json:"payload_on,omitempty"`
+	PayloadOff        string           `json:"payload_off,omitempty"`
+	Device            haDevice         `json:"device"`
+	Availability      []haAvailability `json:"availability"`
+}
+
+var (
+	haNodeRE    = regexp.MustCompile(`[^a-zA-Z0-9_-]+`)
+	chargeModes = []api.ChargeMode{api.ModeOff, api.ModeNow, api.ModeMinPV, api.ModePV}
+)
+
+// discovery creates the Home Assistant discovery configs by config topic
+func (m *MQTT) discovery(site site.API) map[string]haEntity {
+	node := haNodeRE.ReplaceAllString(m.root, "_")
+	res := make(map[string]haEntity)
+
+	availability := []haAvailability{{
+		Topic:               fmt.Sprintf("%s/status", m.root),
+		PayloadAvailable:    "online",
+		PayloadNotAvailable: "offline",
+	}}
+
+	siteDevice := haDevice{
+		Identifiers:  []string{node + "_site"},
+		Name:         "evcc",
+		Manufacturer: "evcc.io",
+		Model:        "Site",
+		SwVersion:    Version,
+	}
+
+	add := func(component, object, topic string, device haDevice, e haEntity) {
+		e.UniqueID = node + "_" + object
+		e.ObjectID = e.UniqueID
+		e.StateTopic = topic
+		e.Device = device
+		e.Availability = availability
+
+		res[fmt.Sprintf("%s/%s/%s/%s/config", m.discoveryPrefix, component, node, object)] = e
+	}
+
+	sensor := func(name, unit, class, state string) haEntity {
+		return haEntity{Name: name, UnitOfMeasurement: unit, DeviceClass: class, StateClass: state}
+	}
+
+	// site
+	topic := fmt.Sprintf("%s/site", m.root)
+	add("sensor", "site_gridPower", topic+"/gridPower", siteDevice, sensor("Grid power", "W", "power", "measurement"))
+	add("sensor", "site_pvPower", topic+"/pvPower", siteDevice, sensor("PV power", "W", "power", "measurement"))
+	add("sensor", "site_batteryPower", topic+"/batteryPower", siteDevice, sensor("Battery power", "W", "power", "measurement"))
+	add("sensor", "site_homePower", topic+"/homePower", siteDevice, sensor("Home power", "W", "power", "measurement"))
+	add("sensor", "site_batterySoC", topic+"/batterySoC", siteDevice, sensor("Battery SoC", "%", "battery", "measurement"))
+	add("sensor", "site_gridEnergy", topic+"/gridEnergy", siteDevice, sensor("Grid energy", "kWh", "energy", "total_increasing"))
+
+	modes := make([]string, 0, len(chargeModes))
+	for _, mode := range chargeModes {
+		modes = append(modes, string(mode))
+	}
+
+	vehicles := discoveryVehicles(site)
+	vehicleJSON, _ := json.Marshal(vehicles)
+
+	for id, lp := range site.LoadPoints() {
+		topic := fmt.Sprintf("%s/loadpoints/%d", m.root, id+1)
+		prefix := fmt.Sprintf("lp%d_", id+1)
+
+		name := lp.Name()
+		if name == "" {
+			name = fmt.Sprintf("Loadpoint %d", id+1)
+		}
+
+		device := haDevice{
+			Identifiers:  []string{fmt.Sprintf("%s_lp%d", node, id+1)},
+			Name:         name,
+			Manufacturer: "evcc.io",
+			Model:        "Loadpoint",
+			SwVersion:    Version,
+			ViaDevice:    siteDevice.Identifiers[0],
+		}
+
+		add("sensor", prefix+"chargePower", topic+"/chargePower", device, sensor("Charge power", "W", "power", "measurement"))
+		add("sensor", prefix+"chargedEnergy", topic+"/chargedEnergy", device, sensor("Charged energy", "Wh", "energy", "total"))
+		add("sensor", prefix+"vehicleSoC", topic+"/vehicleSoC", device, sensor("Vehicle SoC", "%", "battery", "measurement"))
+		add("sensor", prefix+"vehicleRange", topic+"/vehicleRange", device, sensor("Vehicle range", "km", "distance", "measurement"))
+
+		add("binary_sensor", prefix+"connected", topic+"/connected", device, haEntity{
+			Name: "Connected", DeviceClass: "plug", PayloadOn: "true", PayloadOff: "false",
+		})
+		add("binary_sensor", prefix+"charging", topic+"/charging", device, haEntity{
+			Name: "Charging", DeviceClass: "battery_charging", PayloadOn: "true", PayloadOff: "false",
+		})
+
+		add("select", prefix+"mode", topic+"/mode", device, haEntity{
+			Name: "Mode", CommandTopic: topic + "/mode/set", Options: modes, Icon: "mdi:ev-station",
+		})
+
+		if len(vehicles) > 0 {
+			// vehicles are selected by index
+			add("select", prefix+"vehicle", topic+"/vehicleTitle", device, haEntity{
+				Name:            "Vehicle",
+				CommandTopic:    topic + "/vehicle/set",
+				CommandTemplate: fmt.Sprintf("{{ %s.index(value) }}", vehicleJSON),
+				Options:         vehicles,
+				Icon:            "mdi:car",
+			})
+		}
+
+		for _, n := range []struct {
+			key, name, unit string
+			min, max        float64
+		}{
+			{"minSoC", "Min SoC", "%", 0, 100},
+			{"targetSoC", "Target SoC", "%", 0, 100},
+			{"minCurrent", "Min current", "A", 1, 64},
+			{"maxCurrent", "Max current", "A", 1, 64},
+		} {
+			min, max := n.min, n.max
+			add("number", prefix+n.key, topic+"/"+n.key, device, haEntity{
+				Name: n.name, UnitOfMeasurement: n.unit, Min: &min, Max: &max, Step: 1,
+				CommandTopic: topic + "/" + n.key + "/set",
+			})
+		}
+	}
+
+	return res
+}
+
+func discoveryVehicles(site site.API) []string {
+	res := make([]string, 0, len(site.GetVehicles()))
+	for _, v := range site.GetVehicles() {
+		res = append(res, v.Title())
+	}
+	return res
+}
+
+// rediscover returns true if the published vehicles differ from the discovered vehicles
+func (m *MQTT) rediscover(p util.Param) bool {
+	if m.discoveryPrefix == "" || p.LoadPoint != nil || p.Key != "vehicles" {
+		return false
+	}
+
+	vehicles, ok := p.Val.([]string)
+	return ok && !slices.Equal(vehicles, m.discovered)
+}
+
+// publishDiscovery publishes Home Assistant discovery configs and removes stale configs of
+// loadpoints or vehicles that are no longer configured
+func (m *MQTT) publishDiscovery(site site.API) {
+	configs := m.discovery(site)
+	m.discovered = discoveryVehicles(site)
+
+	// retained configs are received on subscription
+	node := haNodeRE.ReplaceAllString(m.root, "_")
+	filter := fmt.Sprintf("%s/+/%s/+/config", m.discoveryPrefix, node)
+
+	token := m.Handler.Client.Subscribe(filter, m.Handler.Qos, func(_ paho.Client, msg paho.Message) {
+		if _, ok := configs[msg.Topic()]; ok || len(msg.Payload()) == 0 {
+			return
+		}
+
+		m.publishSingleValue(msg.Topic(), true, nil)
+	})
+	go m.Handler.WaitForToken(token)
+
+	for topic, config := range configs {
+		b, err := json.Marshal(config)
+		if err != nil {
+			continue
+		}
+
+		m.publishSingleValue(topic, true, string(b))
+	}
+}
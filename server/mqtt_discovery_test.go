@@ -0,0 +1,95 @@
+package server
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/evcc-io/evcc/api"
+	"github.com/evcc-io/evcc/core/loadpoint"
+	"github.com/evcc-io/evcc/core/site"
+	"github.com/evcc-io/evcc/util"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type discoverySite struct {
+	site.API
+	loadpoints []loadpoint.API
+	vehicles   []api.Vehicle
+}
+
+func (s *discoverySite) LoadPoints() []loadpoint.API { return s.loadpoints }
+func (s *discoverySite) GetVehicles() []api.Vehicle  { return s.vehicles }
+
+type discoveryLoadpoint struct {
+	loadpoint.API
+	name string
+}
+
+func (lp *discoveryLoadpoint) Name() string { return lp.name }
+
+type discoveryVehicle struct {
+	api.Vehicle
+	title string
+}
+
+func (v *discoveryVehicle) Title() string { return v.title }
+
+func TestMqttDiscovery(t *testing.T) {
+	m := NewMQTT("evcc/home").WithDiscovery("homeassistant/")
+
+	s := &discoverySite{
+		loadpoints: []loadpoint.API{&discoveryLoadpoint{name: "Garage"}},
+		vehicles:   []api.Vehicle{&discoveryVehicle{title: "Zoe"}, &discoveryVehicle{title: "e-Golf"}},
+	}
+
+	res := m.discovery(s)
+
+	e, ok := res["homeassistant/select/evcc_home/lp1_mode/config"]
+	require.True(t, ok)
+	assert.Equal(t, "evcc/home/loadpoints/1/mode", e.StateTopic)
+	assert.Equal(t, "evcc/home/loadpoints/1/mode/set", e.CommandTopic)
+	assert.Equal(t, []string{"off", "now", "minpv", "pv"}, e.Options)
+	assert.Equal(t, "Garage", e.Device.Name)
+	assert.Equal(t, "evcc_home_site", e.Device.ViaDevice)
+	assert.Equal(t, "evcc/home/status", e.Availability[0].Topic)
+
+	e, ok = res["homeassistant/select/evcc_home/lp1_vehicle/config"]
+	require.True(t, ok)
+	assert.Equal(t, []string{"Zoe", "e-Golf"}, e.Options)
+	assert.Equal(t, `{{ ["Zoe","e-Golf"].index(value) }}`, e.CommandTemplate)
+
+	e, ok = res["homeassistant/number/evcc_home/lp1_targetSoC/config"]
+	require.True(t, ok)
+	assert.Equal(t, "evcc/home/loadpoints/1/targetSoC/set", e.CommandTopic)
+	assert.Equal(t, 100.0, *e.Max)
+
+	_, ok = res["homeassistant/sensor/evcc_home/site_gridPower/config"]
+	assert.True(t, ok)
+
+	b, err := json.Marshal(res["homeassistant/binary_sensor/evcc_home/lp1_charging/config"])
+	require.NoError(t, err)
+	assert.Contains(t, string(b), `"unique_id":"evcc_home_lp1_charging"`)
+
+	// no vehicle select without vehicles
+	s.vehicles = nil
+	_, ok = m.discovery(s)["homeassistant/select/evcc_home/lp1_vehicle/config"]
+	assert.False(t, ok)
+}
+
+func TestMqttRediscover(t *testing.T) {
+	m := NewMQTT("evcc").WithDiscovery("homeassistant")
+
+	s := &discoverySite{vehicles: []api.Vehicle{&discoveryVehicle{title: "Zoe"}}}
+	m.discovered = discoveryVehicles(s)
+
+	lp := 0
+	assert.False(t, m.rediscover(util.Param{Key: "vehicles", Val: []string{"Zoe"}}), "unchanged")
+	assert.True(t, m.rediscover(util.Param{Key: "vehicles", Val: []string{"Zoe", "e-Golf"}}), "added")
+	assert.True(t, m.rediscover(util.Param{Key: "vehicles", Val: []string{}}), "removed")
+	assert.False(t, m.rediscover(util.Param{Key: "vehicles", Val: []string{}, LoadPoint: &lp}), "loadpoint")
+	assert.False(t, m.rediscover(util.Param{Key: "pvPower", Val: 1000.0}), "other key")
+
+	m.discoveryPrefix = ""
+	assert.False(t, m.rediscover(util.Param{Key: "vehicles", Val: []string{}}), "disabled")
+}
@@ -0,0 +1,51 @@
+package server
+
+import (
+	"errors"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMqttStateValues(t *testing.T) {
+	ts := time.Unix(1656662400, 0)
+
+	res := stateValues(map[string]interface{}{
+		"time":     ts,
+		"zero":     time.Time{},
+		"duration": 90 * time.Second,
+		"nan":      math.NaN(),
+		"power":    1.5,
+		"mode":     "pv",
+	})
+
+	assert.Equal(t, map[string]interface{}{
+		"time":     ts.Unix(),
+		"zero":     nil,
+		"duration": int64(90),
+		"nan":      nil,
+		"power":    1.5,
+		"mode":     "pv",
+	}, res)
+}
+
+func TestMqttSetters(t *testing.T) {
+	var got int
+	set := intSetter(func(v int) error {
+		got = v
+		return nil
+	})
+
+	val, err := set("42")
+	assert.NoError(t, err)
+	assert.Equal(t, 42, val)
+	assert.Equal(t, 42, got)
+
+	_, err = set("foo")
+	assert.Error(t, err)
+
+	_, err = floatSetter(func(float64) error { return errors.New("rejected") })("1.5")
+	assert.EqualError(t, err, "rejected")
+}
@@ -0,0 +1,229 @@
+package server
+
+import (
+	"net/http"
+	"reflect"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/evcc-io/evcc/api"
+)
+
+// request body samples for the api documentation
+type (
+	floatValue struct {
+		Value float64 `json:"value"`
+	}
+	intValue struct {
+		Value int `json:"value"`
+	}
+	modeValue struct {
+		Value api.ChargeMode `json:"value"`
+	}
+	vehicleIndex struct {
+		Vehicle int `json:"vehicle"`
+	}
+)
+
+var pathParamRE = regexp.MustCompile(`\{(\w+)(?::([^}]+))?\}`)
+
+// openAPI generates the OpenAPI document from the site and loadpoint routes
+func openAPI(routes, lpRoutes map[string]Route) map[string]interface{} {
+	paths := make(map[string]map[string]interface{})
+
+	add := func(prefix string, params []interface{}, routes map[string]Route) {
+		names := make([]string, 0, len(routes))
+		for name := range routes {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+
+		for _, name := range names {
+			r := routes[name]
+
+			path := prefix + pathParamRE.ReplaceAllString(r.Pattern, "{$1}")
+			if paths[path] == nil {
+				paths[path] = make(map[string]interface{})
+			}
+
+			parameters := append([]interface{}{}, params...)
+			for _, m := range pathParamRE.FindAllStringSubmatch(r.Pattern, -1) {
+				schema := map[string]interface{}{"type": "string"}
+				if m[2] != "" {
+					schema["pattern"] = "^" + m[2] + "$"
+				}
+
+				parameters = append(parameters, map[string]interface{}{
+					"name": m[1], "in": "path", "required": true, "schema": schema,
+				})
+			}
+
+			for _, method := range r.Methods {
+				if method == http.MethodOptions {
+					continue
+				}
+
+				op := map[string]interface{}{
+					"operationId": name,
+					"summary":     r.Summary,
+					"responses":   responses(r.Result),
+				}
+
+				if len(parameters) > 0 {
+					op["parameters"] = parameters
+				}
+
+				if r.Body != nil {
+					op["requestBody"] = map[string]interface{}{
+						"required": true,
+						"content": map[string]interface{}{
+							"application/json": map[string]interface{}{"schema": schema(reflect.TypeOf(r.Body))},
+						},
+					}
+				}
+
+				paths[path][strings.ToLower(method)] = op
+			}
+		}
+	}
+
+	add("", nil, routes)
+
+	if lpRoutes != nil {
+		id := map[string]interface{}{
+			"name": "id", "in": "path", "required": true, "description": "loadpoint index starting at 0",
+			"schema": map[string]interface{}{"type": "integer"},
+		}
+
+		paths["/loadpoints/{id}"] = map[string]interface{}{
+			"get": map[string]interface{}{
+				"operationId": "getLoadpoint",
+				"summary":     "Get loadpoint settings",
+				"parameters":  []interface{}{id},
+				"responses":   responses(loadpointSettings{}),
+			},
+		}
+
+		add("/loadpoints/{id}", []interface{}{id}, lpRoutes)
+	}
+
+	paths["/openapi.json"] = map[string]interface{}{
+		"get": map[string]interface{}{
+			"operationId": "getOpenAPI",
+			"summary":     "OpenAPI document",
+			"responses":   responses(map[string]interface{}{}),
+		},
+	}
+
+	return map[string]interface{}{
+		"openapi": "3.0.3",
+		"info": map[string]interface{}{
+			"title":   "evcc",
+			"version": Version,
+		},
+		"servers": []interface{}{
+			map[string]interface{}{"url": "/api"},
+		},
+		"paths": paths,
+		"components": map[string]interface{}{
+			"schemas": map[string]interface{}{
+				"Error": schema(reflect.TypeOf(apiError{})),
+			},
+		},
+	}
+}
+
+// responses documents the result wrapped as {"result": ...} and the error object
+func responses(result interface{}) map[string]interface{} {
+	res := map[string]interface{}{
+		"200": map[string]interface{}{"description": "OK"},
+		"default": map[string]interface{}{
+			"description": "Error",
+			"content": map[string]interface{}{
+				"application/json": map[string]interface{}{
+					"schema": map[string]interface{}{"$ref": "#/components/schemas/Error"},
+				},
+			},
+		},
+	}
+
+	if result != nil {
+		res["200"] = map[string]interface{}{
+			"description": "OK",
+			"content": map[string]interface{}{
+				"application/json": map[string]interface{}{
+					"schema": map[string]interface{}{
+						"type": "object",
+						"properties": map[string]interface{}{
+							"result": schema(reflect.TypeOf(result)),
+						},
+					},
+				},
+			},
+		}
+	}
+
+	return res
+}
+
+var timeType = reflect.TypeOf(time.Time{})
+
+// schema creates the json schema for the type
+func schema(typ reflect.Type) map[string]interface{} {
+	if typ == timeType {
+		return map[string]interface{}{"type": "string", "format": "date-time"}
+	}
+
+	switch typ.Kind() {
+	case reflect.Ptr:
+		res := schema(typ.Elem())
+		res["nullable"] = true
+		return res
+
+	case reflect.Bool:
+		return map[string]interface{}{"type": "boolean"}
+
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return map[string]interface{}{"type": "integer"}
+
+	case reflect.Float32, reflect.Float64:
+		return map[string]interface{}{"type": "number"}
+
+	case reflect.String:
+		return map[string]interface{}{"type": "string"}
+
+	case reflect.Slice, reflect.Array:
+		return map[string]interface{}{"type": "array", "items": schema(typ.Elem())}
+
+	case reflect.Map:
+		return map[string]interface{}{"type": "object"}
+
+	case reflect.Struct:
+		props := make(map[string]interface{})
+
+		for i := 0; i < typ.NumField(); i++ {
+			f := typ.Field(i)
+			if !f.IsExported() {
+				continue
+			}
+
+			name := strings.Split(f.Tag.Get("json"), ",")[0]
+			if name == "-" {
+				continue
+			}
+			if name == "" {
+				name = f.Name
+			}
+
+			props[name] = schema(f.Type)
+		}
+
+		return map[string]interface{}{"type": "object", "properties": props}
+
+	default:
+		return map[string]interface{}{}
+	}
+}
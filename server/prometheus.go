@@ -0,0 +1,166 @@
+package server
+
+import (
+	"strconv"
+
+	"github.com/evcc-io/evcc/api"
+	"github.com/evcc-io/evcc/core/loadpoint"
+	"github.com/evcc-io/evcc/util"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// Prometheus exports site and loadpoint values as Prometheus metrics
+type Prometheus struct {
+	loadPoints []loadpoint.API
+	site       map[string]prometheus.Gauge
+	lp         map[string]*prometheus.GaugeVec
+	mode       *prometheus.GaugeVec
+	status     *prometheus.GaugeVec
+	vehicle    *prometheus.GaugeVec
+	info       *prometheus.GaugeVec
+}
+
+// lpState tracks the values required for deriving loadpoint status and vehicle
+type lpState struct {
+	connected, charging bool
+	vehicle             string
+}
+
+// NewPrometheus creates the Prometheus exporter and registers its metrics
+func NewPrometheus(reg prometheus.Registerer, loadPoints []loadpoint.API) *Prometheus {
+	gauge := func(subsystem, name, help string) prometheus.GaugeOpts {
+		return prometheus.GaugeOpts{Namespace: "evcc", Subsystem: subsystem, Name: name, Help: help}
+	}
+
+	m := &Prometheus{
+		loadPoints: loadPoints,
+		site: map[string]prometheus.Gauge{
+			"gridPower":    prometheus.NewGauge(gauge("site", "grid_power_watts", "Grid power")),
+			"pvPower":      prometheus.NewGauge(gauge("site", "pv_power_watts", "PV power")),
+			"batteryPower": prometheus.NewGauge(gauge("site", "battery_power_watts", "Battery power")),
+			"homePower":    prometheus.NewGauge(gauge("site", "home_power_watts", "Home power")),
+			"batterySoC":   prometheus.NewGauge(gauge("site", "battery_soc_percent", "Battery state of charge")),
+		},
+		lp: map[string]*prometheus.GaugeVec{
+			"chargePower":   prometheus.NewGaugeVec(gauge("loadpoint", "charge_power_watts", "Charge power"), []string{"loadpoint"}),
+			"chargeCurrent": prometheus.NewGaugeVec(gauge("loadpoint", "charge_current_amperes", "Charge current"), []string{"loadpoint"}),
+			"activePhases":  prometheus.NewGaugeVec(gauge("loadpoint", "phases", "Active phases"), []string{"loadpoint"}),
+			"vehicleSoC":    prometheus.NewGaugeVec(gauge("loadpoint", "vehicle_soc_percent", "Vehicle state of charge"), []string{"loadpoint"}),
+			"chargedEnergy": prometheus.NewGaugeVec(gauge("loadpoint", "session_energy_watthours", "Energy charged in the current session"), []string{"loadpoint"}),
+		},
+		mode:    prometheus.NewGaugeVec(gauge("loadpoint", "mode", "Charge mode, 1 for the active mode"), []string{"loadpoint", "mode"}),
+		status:  prometheus.NewGaugeVec(gauge("loadpoint", "status", "Charger status, 1 for the active status"), []string{"loadpoint", "status"}),
+		vehicle: prometheus.NewGaugeVec(gauge("loadpoint", "vehicle_info", "Active vehicle"), []string{"loadpoint", "vehicle"}),
+		info:    prometheus.NewGaugeVec(gauge("loadpoint", "info", "Loadpoint title"), []string{"loadpoint", "title"}),
+	}
+
+	for _, g := range m.site {
+		reg.MustRegister(g)
+	}
+	for _, g := range m.lp {
+		reg.MustRegister(g)
+	}
+	reg.MustRegister(m.mode, m.status, m.vehicle, m.info)
+
+	return m
+}
+
+// Run exports the values received from the channel
+func (m *Prometheus) Run(in <-chan util.Param) {
+	lps := make([]lpState, len(m.loadPoints))
+
+	for id, lp := range m.loadPoints {
+		m.info.WithLabelValues(strconv.Itoa(id+1), lp.Name()).Set(1)
+	}
+
+	for p := range in {
+		if p.LoadPoint == nil {
+			if g, ok := m.site[p.Key]; ok {
+				if f, ok := metricValue(p.Val); ok {
+					g.Set(f)
+				}
+			}
+			continue
+		}
+
+		id := *p.LoadPoint
+		if id < 0 || id >= len(lps) {
+			continue
+		}
+
+		lp := strconv.Itoa(id + 1)
+		state := &lps[id]
+
+		switch p.Key {
+		case "mode":
+			if mode, ok := p.Val.(api.ChargeMode); ok {
+				for _, mm := range chargeModes {
+					m.mode.WithLabelValues(lp, string(mm)).Set(metricBool(mm == mode))
+				}
+			}
+
+		case "connected", "charging":
+			if b, ok := p.Val.(bool); ok {
+				if p.Key == "connected" {
+					state.connected = b
+				} else {
+					state.charging = b
+				}
+				m.updateStatus(lp, *state)
+			}
+
+		case "vehicleTitle":
+			if title, ok := p.Val.(string); ok && title != state.vehicle {
+				if state.vehicle != "" {
+					m.vehicle.DeleteLabelValues(lp, state.vehicle)
+				}
+				if title != "" {
+					m.vehicle.WithLabelValues(lp, title).Set(1)
+				}
+				state.vehicle = title
+			}
+
+		default:
+			if g, ok := m.lp[p.Key]; ok {
+				if f, ok := metricValue(p.Val); ok {
+					g.WithLabelValues(lp).Set(f)
+				}
+			}
+		}
+	}
+}
+
+// updateStatus derives the charger status from connected and charging state
+func (m *Prometheus) updateStatus(lp string, state lpState) {
+	status := api.StatusA
+	if state.charging {
+		status = api.StatusC
+	} else if state.connected {
+		status = api.StatusB
+	}
+
+	for _, s := range []api.ChargeStatus{api.StatusA, api.StatusB, api.StatusC} {
+		m.status.WithLabelValues(lp, string(s)).Set(metricBool(s == status))
+	}
+}
+
+// metricValue converts numeric values to float
+func metricValue(val interface{}) (float64, bool) {
+	switch v := val.(type) {
+	case float64:
+		return v, true
+	case int:
+		return float64(v), true
+	case int64:
+		return float64(v), true
+	default:
+		return 0, false
+	}
+}
+
+func metricBool(b bool) float64 {
+	if b {
+		return 1
+	}
+	return 0
+}
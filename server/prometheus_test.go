@@ -0,0 +1,57 @@
+package server
+
+import (
+	"testing"
+
+	"github.com/evcc-io/evcc/api"
+	"github.com/evcc-io/evcc/core/loadpoint"
+	"github.com/evcc-io/evcc/util"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPrometheus(t *testing.T) {
+	m := NewPrometheus(prometheus.NewRegistry(), []loadpoint.API{&httpLoadpoint{}})
+
+	lp := 0
+	in := make(chan util.Param)
+	done := make(chan struct{})
+
+	go func() {
+		m.Run(in)
+		close(done)
+	}()
+
+	for _, p := range []util.Param{
+		{Key: "gridPower", Val: 1500.0},
+		{Key: "siteTitle", Val: "Home"},
+		{LoadPoint: &lp, Key: "chargePower", Val: 11000.0},
+		{LoadPoint: &lp, Key: "activePhases", Val: 3},
+		{LoadPoint: &lp, Key: "mode", Val: api.ModePV},
+		{LoadPoint: &lp, Key: "connected", Val: true},
+		{LoadPoint: &lp, Key: "charging", Val: false},
+		{LoadPoint: &lp, Key: "vehicleTitle", Val: "eGolf"},
+		{LoadPoint: &lp, Key: "vehicleTitle", Val: "Model 3"},
+	} {
+		in <- p
+	}
+
+	close(in)
+	<-done
+
+	assert.Equal(t, 1500.0, testutil.ToFloat64(m.site["gridPower"]))
+	assert.Equal(t, 11000.0, testutil.ToFloat64(m.lp["chargePower"].WithLabelValues("1")))
+	assert.Equal(t, 3.0, testutil.ToFloat64(m.lp["activePhases"].WithLabelValues("1")))
+
+	assert.Equal(t, 1.0, testutil.ToFloat64(m.mode.WithLabelValues("1", "pv")))
+	assert.Equal(t, 0.0, testutil.ToFloat64(m.mode.WithLabelValues("1", "now")))
+
+	assert.Equal(t, 1.0, testutil.ToFloat64(m.status.WithLabelValues("1", "B")))
+	assert.Equal(t, 0.0, testutil.ToFloat64(m.status.WithLabelValues("1", "C")))
+
+	assert.Equal(t, 1, testutil.CollectAndCount(m.vehicle))
+	assert.Equal(t, 1.0, testutil.ToFloat64(m.vehicle.WithLabelValues("1", "Model 3")))
+
+	assert.Equal(t, 1.0, testutil.ToFloat64(m.info.WithLabelValues("1", "Garage")))
+}
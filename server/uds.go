@@ -8,6 +8,7 @@ import (
 	"net/http"
 	"os"
 
+	"github.com/evcc-io/evcc/core/metrics"
 	"github.com/evcc-io/evcc/core/site"
 )
 
@@ -39,6 +40,7 @@ func HealthListener(site site.API, exitC <-chan struct{}) {
 	mux := http.NewServeMux()
 	httpd := http.Server{Handler: mux}
 	mux.HandleFunc("/health", healthHandler(site))
+	mux.HandleFunc("/diagnostics", getHandler(metrics.Diagnostics))
 
 	go func() { _ = httpd.Serve(l) }()
 
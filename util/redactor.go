@@ -5,6 +5,8 @@ import (
 	"net/url"
 	"os"
 	"sync"
+
+	"golang.org/x/exp/slices"
 )
 
 var (
@@ -14,8 +16,35 @@ var (
 	// RedactHook is the hook for expanding different representations of
 	// redaction items. Setting to nil will disable redaction.
 	RedactHook = RedactDefaultHook
+
+	// secrets are redacted by all redactors
+	secretsMu sync.Mutex
+	secrets   []string
 )
 
+// secretMinLength is the minimum length of secrets redacted by all redactors.
+// Shorter values like ports or ids would blank unrelated output.
+const secretMinLength = 6
+
+// RedactSecret adds items for redaction by all redactors. Short items are ignored.
+func RedactSecret(redact ...string) {
+	secretsMu.Lock()
+	defer secretsMu.Unlock()
+
+	for _, s := range redact {
+		if RedactHook == nil || len(s) < secretMinLength {
+			continue
+		}
+
+		// secrets are added on each decode
+		for _, r := range RedactHook(s) {
+			if !slices.Contains(secrets, r) {
+				secrets = append(secrets, r)
+			}
+		}
+	}
+}
+
 // Redactor implements a redacting io.Writer
 type Redactor struct {
 	mu     sync.Mutex
@@ -40,6 +69,13 @@ func (l *Redactor) Write(p []byte) (n int, err error) {
 		p = bytes.ReplaceAll(p, []byte(s), []byte(RedactReplacement))
 	}
 	l.mu.Unlock()
+
+	secretsMu.Lock()
+	for _, s := range secrets {
+		p = bytes.ReplaceAll(p, []byte(s), []byte(RedactReplacement))
+	}
+	secretsMu.Unlock()
+
 	return os.Stdout.Write(p)
 }
 
@@ -0,0 +1,65 @@
+package util
+
+import (
+	"fmt"
+	"os"
+	"reflect"
+	"regexp"
+	"strings"
+
+	"github.com/mitchellh/mapstructure"
+)
+
+// secretRef matches ${env:NAME} and ${file:/path} references
+var secretRef = regexp.MustCompile(`\$\{(env|file):([^}]+)\}`)
+
+// HasSecrets returns true if s contains secret references
+func HasSecrets(s string) bool {
+	return secretRef.MatchString(s)
+}
+
+// ResolveSecrets replaces the environment and file references in s with their values.
+// Resolved values are redacted by all loggers.
+func ResolveSecrets(s string) (string, error) {
+	if !strings.Contains(s, "${") {
+		return s, nil
+	}
+
+	var err error
+
+	res := secretRef.ReplaceAllStringFunc(s, func(ref string) string {
+		m := secretRef.FindStringSubmatch(ref)
+
+		var val string
+		switch m[1] {
+		case "env":
+			var ok bool
+			if val, ok = os.LookupEnv(m[2]); !ok && err == nil {
+				err = fmt.Errorf("%s: environment variable not set", ref)
+			}
+		case "file":
+			b, ferr := os.ReadFile(m[2])
+			if ferr != nil && err == nil {
+				err = fmt.Errorf("%s: %w", ref, ferr)
+			}
+			// secret files usually end with a newline
+			val = strings.TrimRight(string(b), "\r\n")
+		}
+
+		RedactSecret(val)
+
+		return val
+	})
+
+	return res, err
+}
+
+// SecretsHookFunc returns a decode hook that resolves secret references in strings
+func SecretsHookFunc() mapstructure.DecodeHookFuncType {
+	return func(from reflect.Type, to reflect.Type, data interface{}) (interface{}, error) {
+		if s, ok := data.(string); ok {
+			return ResolveSecrets(s)
+		}
+		return data, nil
+	}
+}
@@ -0,0 +1,58 @@
+package util
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResolveSecrets(t *testing.T) {
+	t.Setenv("EVCC_TEST_TOKEN", "env-secret")
+
+	file := filepath.Join(t.TempDir(), "password")
+	require.NoError(t, os.WriteFile(file, []byte("file-secret\n"), 0o600))
+
+	s, err := ResolveSecrets("plain")
+	require.NoError(t, err)
+	assert.Equal(t, "plain", s)
+
+	s, err = ResolveSecrets("Bearer ${env:EVCC_TEST_TOKEN}")
+	require.NoError(t, err)
+	assert.Equal(t, "Bearer env-secret", s)
+
+	s, err = ResolveSecrets("${file:" + file + "}")
+	require.NoError(t, err)
+	assert.Equal(t, "file-secret", s)
+
+	assert.Contains(t, secrets, "env-secret")
+	assert.Contains(t, secrets, "file-secret")
+
+	_, err = ResolveSecrets("${env:EVCC_TEST_MISSING}")
+	assert.EqualError(t, err, "${env:EVCC_TEST_MISSING}: environment variable not set")
+
+	_, err = ResolveSecrets("${file:/does/not/exist}")
+	assert.Error(t, err)
+
+	var cc struct {
+		User     string
+		Password string
+		Port     int
+	}
+
+	t.Setenv("EVCC_TEST_PORT", "502")
+	require.NoError(t, DecodeOther(map[string]interface{}{
+		"user":     "${env:EVCC_TEST_TOKEN}",
+		"password": "${file:" + file + "}",
+		"port":     "${env:EVCC_TEST_PORT}",
+	}, &cc))
+
+	assert.Equal(t, "env-secret", cc.User)
+	assert.Equal(t, "file-secret", cc.Password)
+	assert.Equal(t, 502, cc.Port)
+
+	// short values are not redacted globally
+	assert.NotContains(t, secrets, "502")
+}
@@ -0,0 +1,125 @@
+package templates
+
+import (
+	"fmt"
+)
+
+// Form is the language specific description of a template for configuration user interfaces
+type Form struct {
+	Template     string                 `json:"template"`
+	Products     []string               `json:"products"`
+	Group        string                 `json:"group,omitempty"`
+	Capabilities []string               `json:"capabilities,omitempty"`
+	Requirements []string               `json:"requirements,omitempty"`
+	Description  string                 `json:"description,omitempty"` // description of the requirements
+	URI          string                 `json:"uri,omitempty"`         // details about the requirements
+	Params       []FormParam            `json:"params,omitempty"`
+	Modbus       map[string][]FormParam `json:"modbus,omitempty"` // params by modbus interface type
+}
+
+// FormParam is the language specific description of a template param
+type FormParam struct {
+	Name         string       `json:"name"`
+	Description  string       `json:"description,omitempty"`
+	Help         string       `json:"help,omitempty"`
+	Type         string       `json:"type"`
+	Default      string       `json:"default,omitempty"`
+	Example      string       `json:"example,omitempty"`
+	Required     bool         `json:"required,omitempty"`
+	Mask         bool         `json:"mask,omitempty"`
+	Advanced     bool         `json:"advanced,omitempty"`
+	ValidValues  []string     `json:"validValues,omitempty"`
+	Dependencies []Dependency `json:"dependencies,omitempty"`
+}
+
+func formParam(p Param, lang string) FormParam {
+	return FormParam{
+		Name:         p.Name,
+		Description:  p.Description.String(lang),
+		Help:         p.Help.String(lang),
+		Type:         p.ValueType,
+		Default:      p.Default,
+		Example:      p.Example,
+		Required:     p.Required,
+		Mask:         p.Mask,
+		Advanced:     p.Advanced,
+		ValidValues:  append(append([]string{}, p.ValidValues...), p.Choice...),
+		Dependencies: p.Dependencies,
+	}
+}
+
+// Form returns the template description in the given language.
+// Hidden and deprecated params are omitted, modbus params are listed by interface type.
+func (t Template) Form(lang string) Form {
+	// titles are cached per template
+	t.titles = nil
+
+	res := Form{
+		Template:     t.Template,
+		Products:     t.Titles(lang),
+		Group:        t.GroupTitle(),
+		Capabilities: t.Capabilities,
+		Requirements: t.Requirements.EVCC,
+		Description:  t.Requirements.Description.String(lang),
+		URI:          t.Requirements.URI,
+	}
+
+	for _, p := range t.Params {
+		if p.Hidden || p.Deprecated {
+			continue
+		}
+
+		fp := formParam(p, lang)
+
+		if p.Name == ParamModbus {
+			fp.ValidValues = nil
+			res.Modbus = make(map[string][]FormParam)
+
+			for _, choice := range p.Choice {
+				for _, iface := range t.ConfigDefaults.Modbus.Interfaces[choice] {
+					fp.ValidValues = append(fp.ValidValues, iface)
+					res.Modbus[iface] = t.modbusFormParams(iface, p, lang)
+				}
+			}
+		}
+
+		res.Params = append(res.Params, fp)
+	}
+
+	return res
+}
+
+// modbusFormParams returns the interface type's params with the device specific defaults of the modbus param
+func (t Template) modbusFormParams(iface string, modbus Param, lang string) []FormParam {
+	var res []FormParam
+
+	for _, p := range t.ConfigDefaults.Modbus.Types[iface].Params {
+		fp := formParam(p, lang)
+
+		switch {
+		case p.Name == ModbusParamNameId && modbus.ID != 0:
+			fp.Default = fmt.Sprintf("%d", modbus.ID)
+		case p.Name == ModbusParamNamePort && modbus.Port != 0:
+			fp.Default = fmt.Sprintf("%d", modbus.Port)
+		case p.Name == ModbusParamNameBaudrate && modbus.Baudrate != 0:
+			fp.Default = fmt.Sprintf("%d", modbus.Baudrate)
+		case p.Name == ModbusParamNameComset && modbus.Comset != "":
+			fp.Default = modbus.Comset
+		}
+
+		res = append(res, fp)
+	}
+
+	return res
+}
+
+// MaskedParams returns the names of the params whose values must not be shown
+func (t Template) MaskedParams() []string {
+	var res []string
+	for _, p := range t.Params {
+		if p.Mask {
+			res = append(res, p.Name)
+		}
+	}
+	return res
+}
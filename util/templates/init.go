@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"io/fs"
 	"path"
+	"sync"
 
 	"github.com/evcc-io/evcc/templates/definition"
 	"golang.org/x/exp/slices"
@@ -11,6 +12,7 @@ import (
 )
 
 var (
+	mu             sync.Mutex
 	templates      = make(map[string][]Template)
 	configDefaults = ConfigDefaults{}
 )
@@ -51,6 +53,9 @@ func FromBytes(b []byte) (Template, error) {
 }
 
 func loadTemplates(class string) {
+	mu.Lock()
+	defer mu.Unlock()
+
 	if templates[class] != nil {
 		return
 	}